@@ -16,6 +16,7 @@ package actors
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -32,6 +33,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/hostconfig"
 	"github.com/dapr/dapr/pkg/actors/internal/apilevel"
+	"github.com/dapr/dapr/pkg/actors/internal/lifecycle"
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
 	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
 	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
@@ -39,6 +41,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage/statestore"
 	internaltimers "github.com/dapr/dapr/pkg/actors/internal/timers"
 	"github.com/dapr/dapr/pkg/actors/internal/timers/inmemory"
+	"github.com/dapr/dapr/pkg/actors/internal/versions"
 	"github.com/dapr/dapr/pkg/actors/reminders"
 	"github.com/dapr/dapr/pkg/actors/router"
 	actorstate "github.com/dapr/dapr/pkg/actors/state"
@@ -47,6 +50,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets/app"
 	"github.com/dapr/dapr/pkg/actors/timers"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
+	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/modes"
@@ -54,6 +58,7 @@ import (
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/security"
 )
@@ -75,6 +80,8 @@ type Options struct {
 	StateTTLEnabled    bool
 	MaxRequestBodySize int
 	Mode               modes.DaprMode
+	LifecycleEvents    *config.ActorLifecycleEventsSpec
+	PubSubAdapter      rtpubsub.Adapter
 }
 
 type InitOptions struct {
@@ -116,6 +123,8 @@ type actors struct {
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled    bool
 	maxRequestBodySize int
+	lifecycleEvents    *config.ActorLifecycleEventsSpec
+	pubsubAdapter      rtpubsub.Adapter
 
 	reminders       reminders.Interface
 	table           table.Interface
@@ -128,6 +137,7 @@ type actors struct {
 	reminderStore   storage.Interface
 	state           actorstate.Interface
 	reentrancyStore *reentrancystore.Store
+	lifecycle       *lifecycle.Lifecycle
 
 	disabled   *atomic.Pointer[error]
 	readyCh    chan struct{}
@@ -172,6 +182,8 @@ func New(opts Options) Interface {
 		maxRequestBodySize: opts.MaxRequestBodySize,
 		mode:               opts.Mode,
 		reentrancyStore:    reentrancystore.New(),
+		lifecycleEvents:    opts.LifecycleEvents,
+		pubsubAdapter:      opts.PubSubAdapter,
 	}
 }
 
@@ -186,9 +198,17 @@ func (a *actors) Init(opts InitOptions) error {
 		ExecuteFn: a.handleIdleActor,
 	})
 
+	a.lifecycle = lifecycle.New(lifecycle.Options{
+		AppID:     a.appID,
+		Host:      opts.Hostname + ":" + strconv.Itoa(a.port),
+		Spec:      a.lifecycleEvents,
+		Publisher: a.pubsubAdapter,
+	})
+
 	a.table = table.New(table.Options{
 		IdlerQueue:      a.idlerQueue,
 		ReentrancyStore: a.reentrancyStore,
+		Lifecycle:       a.lifecycle,
 	})
 
 	apiLevel := apilevel.New()
@@ -200,6 +220,15 @@ func (a *actors) Init(opts InitOptions) error {
 		Table:   a.table,
 	})
 
+	var pins *versions.Pins
+	if storeEnabled {
+		pins = versions.NewPins(versions.PinsOptions{
+			StoreName:  opts.StateStoreName,
+			CompStore:  a.compStore,
+			Resiliency: a.resiliency,
+		})
+	}
+
 	var err error
 	a.placement, err = placement.New(placement.Options{
 		AppID:     a.appID,
@@ -214,6 +243,7 @@ func (a *actors) Init(opts InitOptions) error {
 		Healthz:   a.healthz,
 		Mode:      a.mode,
 		Scheduler: opts.SchedulerReloader,
+		Pins:      pins,
 	})
 	if err != nil {
 		return err
@@ -228,6 +258,7 @@ func (a *actors) Init(opts InitOptions) error {
 			StateTTLEnabled: a.stateTTLEnabled,
 			Table:           a.table,
 			Placement:       a.placement,
+			Reminders:       a.reminderStore,
 		})
 	}
 
@@ -240,16 +271,22 @@ func (a *actors) Init(opts InitOptions) error {
 		Resiliency:         a.resiliency,
 		IdlerQueue:         a.idlerQueue,
 		Reminders:          a.reminders,
+		State:              a.state,
+		Pins:               pins,
 		MaxRequestBodySize: a.maxRequestBodySize,
 	})
 
 	a.timerStorage = inmemory.New(inmemory.Options{
 		Router: a.router,
 	})
-	a.timers = timers.New(timers.Options{
+	timerOpts := timers.Options{
 		Storage: a.timerStorage,
 		Table:   a.table,
-	})
+	}
+	if a.schedulerReminders {
+		timerOpts.Durable = a.reminderStore
+	}
+	a.timers = timers.New(timerOpts)
 
 	if a.stateReminders != nil {
 		a.stateReminders.SetRouter(a.router)
@@ -300,6 +337,12 @@ func (a *actors) Run(ctx context.Context) error {
 		},
 	)
 
+	if a.lifecycle != nil {
+		if err := mngr.Add(a.lifecycle.Run); err != nil {
+			return err
+		}
+	}
+
 	if err := mngr.AddCloser(
 		a.table,
 		a.timerStorage,
@@ -444,14 +487,28 @@ func (a *actors) RegisterHosted(cfg hostconfig.Config) error {
 	for _, actorType := range cfg.HostedActorTypes {
 		idleTimeout := idleTimeout
 		reentrancy := reentrancy
+		version := cfg.ActorTypeVersion
+		versionRouting := cfg.ActorTypeVersionRouting
 		if c, ok := entityConfigs[actorType]; ok {
 			idleTimeout = c.ActorIdleTimeout
 			reentrancy = c.ReentrancyConfig
+			if len(c.ActorTypeVersion) > 0 {
+				version = c.ActorTypeVersion
+				versionRouting = c.ActorTypeVersionRouting
+			}
+		}
+
+		switch versionRouting {
+		case "", config.ActorVersionRoutingNewest, config.ActorVersionRoutingPinned:
+		default:
+			return fmt.Errorf("invalid actor type version routing for actor type %s: %s", actorType, versionRouting)
 		}
 
 		factories = append(factories, table.ActorTypeFactory{
-			Type:       actorType,
-			Reentrancy: reentrancy,
+			Type:           actorType,
+			Reentrancy:     reentrancy,
+			Version:        version,
+			VersionRouting: versionRouting,
 			Factory: app.Factory(app.Options{
 				ActorType:   actorType,
 				AppChannel:  cfg.AppChannel,
@@ -37,6 +37,8 @@ type EntityConfig struct {
 	DrainRebalancedActors      bool
 	ReentrancyConfig           config.ReentrancyConfig
 	RemindersStoragePartitions int
+	ActorTypeVersion           string
+	ActorTypeVersionRouting    config.ActorVersionRouting
 }
 
 // TranslateEntityConfig converts a user-defined configuration into a
@@ -49,6 +51,8 @@ func TranslateEntityConfig(appConfig config.EntityConfig) EntityConfig {
 		DrainRebalancedActors:      appConfig.DrainRebalancedActors,
 		ReentrancyConfig:           appConfig.Reentrancy,
 		RemindersStoragePartitions: appConfig.RemindersStoragePartitions,
+		ActorTypeVersion:           appConfig.ActorTypeVersion,
+		ActorTypeVersionRouting:    appConfig.ActorTypeVersionRouting,
 	}
 
 	var idleDuration time.Duration
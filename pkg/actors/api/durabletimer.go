@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DurableTimerPrefix is the prefix of the name of the reminder which backs a
+// durable timer.
+const DurableTimerPrefix = "dapr.timer."
+
+// durableTimerData is the payload of the reminder which backs a durable
+// timer. It carries the timer callback and data so that the reminder can be
+// delivered to the actor as a timer.
+type durableTimerData struct {
+	Callback string `json:"callback,omitempty"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+// NewDurableReminder returns the CreateReminderRequest of the reminder which
+// backs the durable timer.
+func (req CreateTimerRequest) NewDurableReminder() (*CreateReminderRequest, error) {
+	td := durableTimerData{
+		Callback: req.Callback,
+	}
+
+	if req.Data != nil {
+		var err error
+		td.Data, err = proto.Marshal(req.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal timer data: %w", err)
+		}
+	}
+
+	b, err := json.Marshal(td)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal timer data: %w", err)
+	}
+
+	data, err := anypb.New(wrapperspb.Bytes(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal timer data: %w", err)
+	}
+
+	return &CreateReminderRequest{
+		Name:      DurableTimerPrefix + req.Name,
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+		Data:      data,
+		DueTime:   req.DueTime,
+		Period:    req.Period,
+		TTL:       req.TTL,
+	}, nil
+}
+
+// DurableReminder returns the DeleteReminderRequest of the reminder which
+// backs the durable timer.
+func (req DeleteTimerRequest) DurableReminder() *DeleteReminderRequest {
+	return &DeleteReminderRequest{
+		Name:      DurableTimerPrefix + req.Name,
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+	}
+}
+
+// IsDurableTimer returns true if the reminder backs a durable timer.
+func (r Reminder) IsDurableTimer() bool {
+	return !r.IsTimer && strings.HasPrefix(r.Name, DurableTimerPrefix)
+}
+
+// DurableTimer returns the timer backed by this reminder.
+func (r Reminder) DurableTimer() (*Reminder, error) {
+	var b wrapperspb.BytesValue
+	if err := r.Data.UnmarshalTo(&b); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal durable timer %s data: %w", r.Key(), err)
+	}
+
+	var td durableTimerData
+	if err := json.Unmarshal(b.GetValue(), &td); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal durable timer %s data: %w", r.Key(), err)
+	}
+
+	timer := r
+	timer.Name = strings.TrimPrefix(r.Name, DurableTimerPrefix)
+	timer.Callback = td.Callback
+	timer.IsTimer = true
+	timer.Data = nil
+
+	if len(td.Data) > 0 {
+		timer.Data = new(anypb.Any)
+		if err := proto.Unmarshal(td.Data, timer.Data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal durable timer %s data: %w", r.Key(), err)
+		}
+	}
+
+	return &timer, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDurableTimer(t *testing.T) {
+	var req CreateTimerRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"dueTime":"1s","period":"2s","callback":"cb","data":{"foo":"bar"},"durable":true}`), &req))
+	req.Name = "mytimer"
+	req.ActorType = "mytype"
+	req.ActorID = "myid"
+	assert.True(t, req.Durable)
+
+	rreq, err := req.NewDurableReminder()
+	require.NoError(t, err)
+	assert.Equal(t, DurableTimerPrefix+"mytimer", rreq.Name)
+	assert.Equal(t, "mytype", rreq.ActorType)
+	assert.Equal(t, "myid", rreq.ActorID)
+	assert.Equal(t, "1s", rreq.DueTime)
+	assert.Equal(t, "2s", rreq.Period)
+
+	reminder := Reminder{
+		Name:      rreq.Name,
+		ActorType: rreq.ActorType,
+		ActorID:   rreq.ActorID,
+		Data:      rreq.Data,
+		DueTime:   rreq.DueTime,
+	}
+	require.True(t, reminder.IsDurableTimer())
+
+	timer, err := reminder.DurableTimer()
+	require.NoError(t, err)
+	assert.True(t, timer.IsTimer)
+	assert.False(t, timer.IsDurableTimer())
+	assert.Equal(t, "mytimer", timer.Name)
+	assert.Equal(t, "cb", timer.Callback)
+	assert.Equal(t, "1s", timer.DueTime)
+
+	var data wrapperspb.BytesValue
+	require.NoError(t, timer.Data.UnmarshalTo(&data))
+	assert.JSONEq(t, `{"foo":"bar"}`, string(data.GetValue()))
+
+	t.Run("reminder is not a durable timer", func(t *testing.T) {
+		assert.False(t, Reminder{Name: "myreminder"}.IsDurableTimer())
+	})
+
+	t.Run("delete durable reminder", func(t *testing.T) {
+		dreq := DeleteTimerRequest{Name: "mytimer", ActorType: "mytype", ActorID: "myid"}.DurableReminder()
+		assert.Equal(t, DurableTimerPrefix+"mytimer", dreq.Name)
+		assert.Equal(t, "mytype", dreq.ActorType)
+		assert.Equal(t, "myid", dreq.ActorID)
+	})
+}
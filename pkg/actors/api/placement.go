@@ -31,3 +31,13 @@ type LookupActorResponse struct {
 	AppID   string
 	Local   bool
 }
+
+// MigrateActorRequest is the request to migrate an actor to a version of its
+// actor type.
+type MigrateActorRequest struct {
+	ActorType string
+	ActorID   string
+	// Version is the version to migrate the actor to. The newest registered
+	// version is used if empty.
+	Version string
+}
@@ -109,6 +109,9 @@ type CreateTimerRequest struct {
 	TTL       string     `json:"ttl"`
 	Callback  string     `json:"callback"`
 	Data      *anypb.Any `json:"data"`
+	// Durable timers are persisted in the scheduler and survive actor
+	// deactivation, host restarts and rebalancing.
+	Durable bool `json:"durable"`
 }
 
 // ActorKey returns the key of the actor for this timer.
@@ -218,6 +221,8 @@ func (req DeleteReminderRequest) Key() string {
 
 type ListRemindersRequest struct {
 	ActorType string
+	// ActorID optionally restricts the list to the reminders of one actor.
+	ActorID string
 }
 
 // DeleteTimerRequest is a request object for deleting a timer.
@@ -225,6 +230,8 @@ type DeleteTimerRequest struct {
 	Name      string
 	ActorType string
 	ActorID   string
+	// Durable deletes the durable timer of the given name from the scheduler.
+	Durable bool
 }
 
 // ActorKey returns the key of the actor for this timer.
@@ -236,3 +243,15 @@ func (req DeleteTimerRequest) ActorKey() string {
 func (req DeleteTimerRequest) Key() string {
 	return req.ActorType + DaprSeparator + req.ActorID + DaprSeparator + req.Name
 }
+
+// DeleteAllTimersRequest is a request object for deleting all durable timers
+// of an actor.
+type DeleteAllTimersRequest struct {
+	ActorType string
+	ActorID   string
+}
+
+// ActorKey returns the key of the actor.
+func (req DeleteAllTimersRequest) ActorKey() string {
+	return req.ActorType + DaprSeparator + req.ActorID
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package callchain carries the actor call chain across service invocation
+// hops.
+//
+// The call chain is sent to the app with every actor invocation in the
+// Dapr-Actor-Call-Chain header. Like the reentrancy ID, the app propagates it
+// by sending the header it received on the actor and service invocations it
+// makes while handling the request. daprd never infers the call chain of a
+// call made by the app, as concurrent requests handled by the app, for example
+// the branches of a fan-out, share their trace but not their call chain.
+package callchain
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// Header is the metadata key of the actor call chain. The value is a comma
+// separated list of actors, oldest first, each encoded as the query escaped
+// actor type and actor ID separated by a '/'.
+const Header = "Dapr-Actor-Call-Chain"
+
+// Get returns the values of the call chain in the metadata.
+func Get(md map[string]*internalv1pb.ListStringValue) []string {
+	vals := md[Header].GetValues()
+	if len(vals) == 0 {
+		// gRPC metadata keys are always lower case.
+		vals = md[strings.ToLower(Header)].GetValues()
+	}
+	return vals
+}
+
+// Propagate sets the call chain of an actor invocation made by the app over
+// gRPC to the call chain the app sent in the gRPC metadata of the call, if the
+// request carries no call chain in its own metadata. Actor invocations made
+// over HTTP carry the headers of the call in their metadata already.
+func Propagate(ctx context.Context, req *internalv1pb.InternalInvokeRequest) {
+	if req == nil || len(Get(req.GetMetadata())) > 0 {
+		return
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	vals := md.Get(Header)
+	if len(vals) == 0 {
+		return
+	}
+
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue)
+	}
+	req.Metadata[Header] = &internalv1pb.ListStringValue{Values: vals}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package callchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func request(md map[string]string) *internalv1pb.InternalInvokeRequest {
+	req := &internalv1pb.InternalInvokeRequest{
+		Metadata: make(map[string]*internalv1pb.ListStringValue),
+	}
+	for k, v := range md {
+		req.Metadata[k] = &internalv1pb.ListStringValue{Values: []string{v}}
+	}
+	return req
+}
+
+// incoming returns the context of a gRPC call made by the app with the given
+// metadata.
+func incoming(md ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+}
+
+func TestPropagate(t *testing.T) {
+	t.Run("propagates the call chain sent by the app", func(t *testing.T) {
+		out := request(nil)
+		Propagate(incoming("traceparent", traceparent, Header, "a/1"), out)
+		assert.Equal(t, []string{"a/1"}, Get(out.GetMetadata()))
+	})
+
+	t.Run("call chain is not inferred from the trace", func(t *testing.T) {
+		out := request(map[string]string{"traceparent": traceparent})
+		Propagate(incoming("traceparent", traceparent), out)
+		assert.Empty(t, Get(out.GetMetadata()))
+
+		out = request(nil)
+		Propagate(context.Background(), out)
+		assert.Empty(t, Get(out.GetMetadata()))
+	})
+
+	t.Run("explicit call chain is kept", func(t *testing.T) {
+		out := request(map[string]string{"dapr-actor-call-chain": "c/3"})
+		Propagate(incoming(Header, "a/1"), out)
+		assert.Equal(t, []string{"c/3"}, Get(out.GetMetadata()))
+	})
+
+	t.Run("concurrent branches of one trace keep their own call chain", func(t *testing.T) {
+		// Actor a/1 fans out to b/2 and c/3, which both call d/4 on the same
+		// trace. Neither branch may get the call chain of the other, and calls
+		// without a call chain must not get one.
+		const n = 100
+		var wg sync.WaitGroup
+		errs := make(chan error, 3*n)
+		for range n {
+			for _, chain := range []string{"a/1,b/2", "a/1,c/3", ""} {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					md := []string{"traceparent", traceparent}
+					if chain != "" {
+						md = append(md, Header, chain)
+					}
+					out := request(map[string]string{"traceparent": traceparent})
+					Propagate(incoming(md...), out)
+
+					var want []string
+					if chain != "" {
+						want = []string{chain}
+					}
+					if got := Get(out.GetMetadata()); !assert.ObjectsAreEqual(want, got) {
+						errs <- fmt.Errorf("branch %q got call chain %v", chain, got)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+	})
+}
@@ -29,6 +29,8 @@ type Config struct {
 	HostedActorTypes           []string
 	DefaultIdleTimeout         string
 	Reentrancy                 config.ReentrancyConfig
+	ActorTypeVersion           string
+	ActorTypeVersionRouting    config.ActorVersionRouting
 }
 
 type HostConfig struct {
@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	contribcontenttype "github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/actors/internal/key"
+	"github.com/dapr/dapr/pkg/config"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+var log = logger.NewLogger("dapr.runtime.actors.lifecycle")
+
+// eventBufferSize is the number of lifecycle events which can be queued for
+// publishing before new events are dropped. Publishing is done
+// asynchronously so that actor activation and deactivation are never blocked
+// on the pub/sub component.
+const eventBufferSize = 1024
+
+// EventType is the type of actor lifecycle event.
+type EventType string
+
+const (
+	EventTypeActivated   EventType = "activated"
+	EventTypeDeactivated EventType = "deactivated"
+)
+
+// Reason is the reason an actor was deactivated.
+type Reason string
+
+const (
+	// ReasonIdleTimeout is used when the actor was deactivated after exceeding
+	// its idle timeout.
+	ReasonIdleTimeout Reason = "idle-timeout"
+	// ReasonRebalance is used when the actor was deactivated because it moved
+	// to another host after a placement table rebalance.
+	ReasonRebalance Reason = "rebalance"
+	// ReasonHalted is used when all actors on this host were halted, for
+	// example on shutdown or on losing connection to placement.
+	ReasonHalted Reason = "halted"
+	// ReasonUnregistered is used when the actor type was unregistered from
+	// this host.
+	ReasonUnregistered Reason = "unregistered"
+	// ReasonMigrated is used when the actor was deactivated because it was
+	// migrated to another version of its actor type.
+	ReasonMigrated Reason = "migrated"
+)
+
+// Event is the payload of an actor lifecycle event.
+type Event struct {
+	Type      EventType `json:"type"`
+	Reason    Reason    `json:"reason,omitempty"`
+	Host      string    `json:"host"`
+	AppID     string    `json:"appID"`
+	ActorType string    `json:"actorType"`
+	ActorID   string    `json:"actorID"`
+	Time      time.Time `json:"time"`
+	// Lifetime is the duration the actor was active for. Only set on
+	// deactivation events.
+	Lifetime string `json:"lifetime,omitempty"`
+}
+
+type Options struct {
+	AppID     string
+	Host      string
+	Spec      *config.ActorLifecycleEventsSpec
+	Publisher rtpubsub.Adapter
+}
+
+// Lifecycle publishes actor activation and deactivation events to a pub/sub
+// topic. A nil Lifecycle is valid and discards all events.
+type Lifecycle struct {
+	appID      string
+	host       string
+	pubsubName string
+	topic      string
+	actorTypes map[string]struct{}
+	publisher  rtpubsub.Adapter
+
+	activations sync.Map
+	eventCh     chan *Event
+	clock       clock.Clock
+}
+
+// New returns a new Lifecycle. Returns nil if lifecycle events are not
+// enabled.
+func New(opts Options) *Lifecycle {
+	if !opts.Spec.Enabled() || opts.Publisher == nil {
+		return nil
+	}
+
+	var actorTypes map[string]struct{}
+	if len(opts.Spec.ActorTypes) > 0 {
+		actorTypes = make(map[string]struct{}, len(opts.Spec.ActorTypes))
+		for _, actorType := range opts.Spec.ActorTypes {
+			actorTypes[actorType] = struct{}{}
+		}
+	}
+
+	return &Lifecycle{
+		appID:      opts.AppID,
+		host:       opts.Host,
+		pubsubName: opts.Spec.PubsubName,
+		topic:      opts.Spec.Topic,
+		actorTypes: actorTypes,
+		publisher:  opts.Publisher,
+		eventCh:    make(chan *Event, eventBufferSize),
+		clock:      clock.RealClock{},
+	}
+}
+
+// Run publishes queued lifecycle events until the context is cancelled.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-l.eventCh:
+			if err := l.publish(ctx, ev); err != nil {
+				log.Errorf("Failed to publish actor %s event for actor %s: %s", ev.Type, key.ConstructComposite(ev.ActorType, ev.ActorID), err)
+			}
+		}
+	}
+}
+
+// Activated records the activation of the given actor.
+func (l *Lifecycle) Activated(actorType, actorID string) {
+	if !l.enabled(actorType) {
+		return
+	}
+
+	now := l.clock.Now()
+	l.activations.Store(key.ConstructComposite(actorType, actorID), now)
+	l.enqueue(&Event{
+		Type:      EventTypeActivated,
+		Host:      l.host,
+		AppID:     l.appID,
+		ActorType: actorType,
+		ActorID:   actorID,
+		Time:      now,
+	})
+}
+
+// Deactivated records the deactivation of the given actor for the given
+// reason.
+func (l *Lifecycle) Deactivated(actorType, actorID string, reason Reason) {
+	if !l.enabled(actorType) {
+		return
+	}
+
+	now := l.clock.Now()
+	ev := &Event{
+		Type:      EventTypeDeactivated,
+		Reason:    reason,
+		Host:      l.host,
+		AppID:     l.appID,
+		ActorType: actorType,
+		ActorID:   actorID,
+		Time:      now,
+	}
+
+	if activated, ok := l.activations.LoadAndDelete(key.ConstructComposite(actorType, actorID)); ok {
+		ev.Lifetime = now.Sub(activated.(time.Time)).String()
+	}
+
+	l.enqueue(ev)
+}
+
+func (l *Lifecycle) enabled(actorType string) bool {
+	if l == nil {
+		return false
+	}
+	if l.actorTypes == nil {
+		return true
+	}
+	_, ok := l.actorTypes[actorType]
+	return ok
+}
+
+func (l *Lifecycle) enqueue(ev *Event) {
+	select {
+	case l.eventCh <- ev:
+	default:
+		log.Warnf("Actor lifecycle event buffer full, dropping %s event for actor %s", ev.Type, key.ConstructComposite(ev.ActorType, ev.ActorID))
+	}
+}
+
+func (l *Lifecycle) publish(ctx context.Context, ev *Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	ce := contribpubsub.NewCloudEventsEnvelope("", l.appID, "dapr.io.actors."+string(ev.Type),
+		key.ConstructComposite(ev.ActorType, ev.ActorID), l.topic, l.pubsubName,
+		contribcontenttype.JSONContentType, data, "", "",
+	)
+
+	b, err := json.Marshal(ce)
+	if err != nil {
+		return err
+	}
+
+	return l.publisher.Publish(ctx, &contribpubsub.PublishRequest{
+		PubsubName:  l.pubsubName,
+		Topic:       l.topic,
+		Data:        b,
+		ContentType: ptr.Of(contribcontenttype.CloudEventContentType),
+	})
+}
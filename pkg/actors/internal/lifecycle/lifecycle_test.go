@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("nil spec returns nil", func(t *testing.T) {
+		assert.Nil(t, New(Options{Publisher: fake.New()}))
+	})
+
+	t.Run("missing topic returns nil", func(t *testing.T) {
+		assert.Nil(t, New(Options{
+			Spec:      &config.ActorLifecycleEventsSpec{PubsubName: "mypubsub"},
+			Publisher: fake.New(),
+		}))
+	})
+
+	t.Run("nil lifecycle discards events", func(t *testing.T) {
+		var l *Lifecycle
+		assert.NotPanics(t, func() {
+			l.Activated("type", "id")
+			l.Deactivated("type", "id", ReasonHalted)
+		})
+	})
+}
+
+func TestLifecycle(t *testing.T) {
+	reqCh := make(chan *contribpubsub.PublishRequest, 10)
+	l := New(Options{
+		AppID: "myapp",
+		Host:  "localhost:50002",
+		Spec: &config.ActorLifecycleEventsSpec{
+			PubsubName: "mypubsub",
+			Topic:      "actor-events",
+			ActorTypes: []string{"mytype"},
+		},
+		Publisher: fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			reqCh <- req
+			return nil
+		}),
+	})
+	require.NotNil(t, l)
+
+	clock := clocktesting.NewFakeClock(time.Now())
+	l.clock = clock
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- l.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	recv := func(t *testing.T) Event {
+		t.Helper()
+		select {
+		case req := <-reqCh:
+			assert.Equal(t, "mypubsub", req.PubsubName)
+			assert.Equal(t, "actor-events", req.Topic)
+			var ce map[string]any
+			require.NoError(t, json.Unmarshal(req.Data, &ce))
+			assert.Equal(t, "myapp", ce[contribpubsub.SourceField])
+			assert.Equal(t, "mytype||myid", ce[contribpubsub.SubjectField])
+			b, err := json.Marshal(ce[contribpubsub.DataField])
+			require.NoError(t, err)
+			var ev Event
+			require.NoError(t, json.Unmarshal(b, &ev))
+			return ev
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timed out waiting for event")
+			return Event{}
+		}
+	}
+
+	l.Activated("othertype", "myid")
+	l.Activated("mytype", "myid")
+	ev := recv(t)
+	assert.Equal(t, EventTypeActivated, ev.Type)
+	assert.Empty(t, ev.Reason)
+	assert.Equal(t, "localhost:50002", ev.Host)
+	assert.Equal(t, "myapp", ev.AppID)
+	assert.Equal(t, "mytype", ev.ActorType)
+	assert.Equal(t, "myid", ev.ActorID)
+	assert.Empty(t, ev.Lifetime)
+
+	clock.Step(time.Minute)
+	l.Deactivated("othertype", "myid", ReasonRebalance)
+	l.Deactivated("mytype", "myid", ReasonIdleTimeout)
+	ev = recv(t)
+	assert.Equal(t, EventTypeDeactivated, ev.Type)
+	assert.Equal(t, ReasonIdleTimeout, ev.Reason)
+	assert.Equal(t, "1m0s", ev.Lifetime)
+
+	select {
+	case req := <-reqCh:
+		assert.Fail(t, "unexpected event", "%v", req)
+	default:
+	}
+}
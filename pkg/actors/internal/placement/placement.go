@@ -16,6 +16,7 @@ package placement
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -26,8 +27,10 @@ import (
 	"github.com/dapr/dapr/pkg/actors/internal/apilevel"
 	"github.com/dapr/dapr/pkg/actors/internal/placement/client"
 	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
+	"github.com/dapr/dapr/pkg/actors/internal/versions"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/actors/targets"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/messages"
@@ -58,6 +61,7 @@ type Interface interface {
 	Ready() bool
 	Lock(context.Context) (context.Context, context.CancelFunc, error)
 	LookupActor(ctx context.Context, req *api.LookupActorRequest) (*api.LookupActorResponse, error)
+	PinActor(ctx context.Context, req *api.MigrateActorRequest) error
 }
 
 type Options struct {
@@ -74,6 +78,10 @@ type Options struct {
 	Reminders storage.Interface
 	Healthz   healthz.Healthz
 	Mode      modes.DaprMode
+
+	// Pins stores the versions actors of pinned actor types are pinned to.
+	// Actors are routed to the newest version of their type if nil.
+	Pins *versions.Pins
 }
 
 type placement struct {
@@ -85,6 +93,8 @@ type placement struct {
 
 	scheduler         schedclient.Reloader
 	hashTable         *hashing.ConsistentHashTables
+	versions          *versions.Resolver
+	pins              *versions.Pins
 	virtualNodesCache *hashing.VirtualNodesCache
 
 	lock          *lock.OuterCancel
@@ -135,6 +145,7 @@ func New(opts Options) (Interface, error) {
 			Entries: make(map[string]*hashing.Consistent),
 		},
 		reminders:     opts.Reminders,
+		pins:          opts.Pins,
 		appID:         opts.AppID,
 		port:          strconv.Itoa(opts.Port),
 		namespace:     opts.Namespace,
@@ -218,7 +229,12 @@ func (p *placement) Run(ctx context.Context) error {
 // LookupActor returns the address of the actor.
 // Placement _must_ be locked before calling this method.
 func (p *placement) LookupActor(ctx context.Context, req *api.LookupActorRequest) (*api.LookupActorResponse, error) {
-	table, ok := p.hashTable.Entries[req.ActorType]
+	entity, err := p.lookupEntity(ctx, req.ActorType, req.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	table, ok := p.hashTable.Entries[entity]
 	if !ok {
 		return nil, messages.ErrActorNoAddress
 	}
@@ -235,12 +251,83 @@ func (p *placement) LookupActor(ctx context.Context, req *api.LookupActorRequest
 	}, nil
 }
 
+// lookupEntity returns the placement entity of the version of the actor type
+// the actor should be routed to.
+func (p *placement) lookupEntity(ctx context.Context, actorType, actorID string) (string, error) {
+	if p.pins == nil || !p.versions.Pinned(actorType) {
+		return p.versions.Entity(actorType), nil
+	}
+
+	version, err := p.pins.Get(ctx, actorType, actorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pinned version of actor %s/%s: %w", actorType, actorID, err)
+	}
+	if entity, ok := p.versions.VersionEntity(actorType, version); ok {
+		return entity, nil
+	}
+
+	// The actor is not pinned yet, or the version it was pinned to is no
+	// longer registered, so pin it to the newest version. Another instance
+	// which sees a different newest version may pin it first, in which case
+	// its pin wins.
+	newest, _, _ := p.versions.Newest(actorType)
+	version, err = p.pins.Claim(ctx, actorType, actorID, newest)
+	if err != nil {
+		return "", fmt.Errorf("failed to pin actor %s/%s to version %s: %w", actorType, actorID, newest, err)
+	}
+	entity, ok := p.versions.VersionEntity(actorType, version)
+	if !ok {
+		return "", fmt.Errorf("actor %s/%s is pinned to version %s which is not registered", actorType, actorID, version)
+	}
+	return entity, nil
+}
+
+// drainEntity returns the placement entity of the version of the actor type
+// an active actor is routed to, like lookupEntity, but without pinning the
+// actor, as actors are drained while the placement tables are updated. An
+// actor which isn't pinned to a registered version is routed to the newest
+// version, which it is pinned to on its next call.
+func (p *placement) drainEntity(ctx context.Context, actorType, actorID string) (string, error) {
+	if p.pins == nil || !p.versions.Pinned(actorType) {
+		return p.versions.Entity(actorType), nil
+	}
+
+	version, err := p.pins.Peek(ctx, actorType, actorID)
+	if err != nil {
+		return "", err
+	}
+	if entity, ok := p.versions.VersionEntity(actorType, version); ok {
+		return entity, nil
+	}
+
+	_, entity, _ := p.versions.Newest(actorType)
+	return entity, nil
+}
+
+// PinActor pins the actor to the requested version of its actor type.
+// Placement _must_ be locked before calling this method.
+func (p *placement) PinActor(ctx context.Context, req *api.MigrateActorRequest) error {
+	if p.pins == nil || !p.versions.Pinned(req.ActorType) {
+		return fmt.Errorf("actor type %s does not use %s version routing", req.ActorType, config.ActorVersionRoutingPinned)
+	}
+
+	version := req.Version
+	if len(version) == 0 {
+		version, _, _ = p.versions.Newest(req.ActorType)
+	} else if _, ok := p.versions.VersionEntity(req.ActorType, version); !ok {
+		return fmt.Errorf("version %s of actor type %s is not registered", version, req.ActorType)
+	}
+
+	return p.pins.Set(ctx, req.ActorType, req.ActorID, version)
+}
+
 func (p *placement) Ready() bool {
 	return p.client.Ready()
 }
 
 func (p *placement) sendHost(ctx context.Context, actorTypes []string) error {
-	if err := p.client.Send(ctx, actorTypes); err != nil {
+	entities := versions.Entities(actorTypes, p.actorTable.TypeVersion)
+	if err := p.client.Send(ctx, entities); err != nil {
 		diag.DefaultMonitoring.ActorStatusReportFailed("send", "status")
 		return err
 	}
@@ -281,6 +368,7 @@ func (p *placement) handleLockOperation(ctx context.Context) {
 	lockVersion := p.lockVersion.Add(1)
 
 	clear(p.hashTable.Entries)
+	p.versions = nil
 
 	// If we don't receive an unlock in 10 seconds, unlock the table.
 	p.wg.Add(1)
@@ -294,6 +382,7 @@ func (p *placement) handleLockOperation(ctx context.Context) {
 			if p.updateVersion.Load() < lockVersion {
 				p.updateVersion.Store(lockVersion)
 				clear(p.hashTable.Entries)
+				p.versions = nil
 				if p.tableUnlock != nil {
 					p.tableUnlock()
 					p.tableUnlock = nil
@@ -307,8 +396,11 @@ func (p *placement) handleUpdateOperation(ctx context.Context, in *v1pb.Placemen
 	p.apiLevel.Set(in.GetApiLevel())
 
 	entries := make(map[string]*hashing.Consistent)
+	entities := make([]string, 0, len(in.GetEntries()))
 
 	for k, v := range in.GetEntries() {
+		entities = append(entities, k)
+
 		loadMap := make(map[string]*hashing.Host, len(v.GetLoadMap()))
 		for lk, lv := range v.GetLoadMap() {
 			loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
@@ -320,22 +412,32 @@ func (p *placement) handleUpdateOperation(ctx context.Context, in *v1pb.Placemen
 	clear(p.hashTable.Entries)
 	p.hashTable.Version = in.GetVersion()
 	p.hashTable.Entries = entries
+	p.versions = versions.NewResolver(entities)
 
 	if p.reminders != nil {
 		p.reminders.DrainRebalancedReminders()
 	}
 
 	err := p.actorTable.Drain(func(target targets.Interface) bool {
-		lar, err := p.LookupActor(ctx, &api.LookupActorRequest{
-			ActorType: target.Type(),
-			ActorID:   target.ID(),
-		})
+		entity, err := p.drainEntity(ctx, target.Type(), target.ID())
 		if err != nil {
-			log.Errorf("failed to lookup actor %s/%s: %s", target.Type(), target.ID(), err)
+			log.Errorf("Failed to resolve the version of actor %s/%s, keeping it active: %s", target.Type(), target.ID(), err)
+			return false
+		}
+
+		// Actors of types which are no longer placed are deactivated.
+		table, ok := p.hashTable.Entries[entity]
+		if !ok {
 			return true
 		}
 
-		return lar != nil && !p.isActorLocal(lar.Address, p.hostname, p.port)
+		host, err := table.GetHost(target.ID())
+		if err != nil {
+			log.Errorf("Failed to lookup actor %s/%s, keeping it active: %s", target.Type(), target.ID(), err)
+			return false
+		}
+
+		return !p.isActorLocal(host.Name, p.hostname, p.port)
 	})
 	if err != nil {
 		log.Errorf("Error draining actors: %s", err)
@@ -252,6 +252,7 @@ func (s *scheduler) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 				Type: &schedulerv1pb.JobTargetMetadata_Actor{
 					Actor: &schedulerv1pb.TargetActorReminder{
 						Type: req.ActorType,
+						Id:   req.ActorID,
 					},
 				},
 			},
@@ -242,9 +242,12 @@ func (r *Statestore) List(ctx context.Context, req *api.ListRemindersRequest) ([
 		return nil, err
 	}
 
-	reminders := make([]*api.Reminder, len(list))
-	for i, r := range list {
-		reminders[i] = &api.Reminder{
+	reminders := make([]*api.Reminder, 0, len(list))
+	for _, r := range list {
+		if req.ActorID != "" && r.Reminder.ActorID != req.ActorID {
+			continue
+		}
+		reminders = append(reminders, &api.Reminder{
 			Name:           r.Reminder.Name,
 			ActorID:        r.Reminder.ActorID,
 			ActorType:      r.Reminder.ActorType,
@@ -254,7 +257,7 @@ func (r *Statestore) List(ctx context.Context, req *api.ListRemindersRequest) ([
 			RegisteredTime: r.Reminder.RegisteredTime,
 			ExpirationTime: r.Reminder.ExpirationTime,
 			Callback:       r.Reminder.Callback,
-		}
+		})
 	}
 
 	return reminders, nil
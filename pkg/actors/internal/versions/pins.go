@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"k8s.io/utils/clock"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// pinKeyPrefix is the prefix of the actor state store keys holding the
+	// version actor IDs are pinned to. Keys are not prefixed with the app ID,
+	// as the versions of an actor type may be hosted by different apps.
+	pinKeyPrefix = "dapr.actorversion"
+
+	// MigrateReminderName is the name of the internal reminder sent to the
+	// host of an actor to deactivate it after it was migrated to another
+	// version.
+	MigrateReminderName = "dapr.internal.actor.migrate"
+
+	pinCacheSize = 4096
+	// pinCacheTTL bounds how long a Dapr instance may keep routing an actor to
+	// the version it was pinned to before it was migrated by another instance.
+	pinCacheTTL = 5 * time.Second
+
+	// pinTTL is the time-to-live of pins in state stores supporting TTL. Pins
+	// are refreshed when they are read after half of it, so only the pins of
+	// actors which are not called for that long expire. These actors are
+	// pinned to the newest version again on their next call.
+	pinTTL = 30 * 24 * time.Hour
+
+	metadataTTLInSeconds = "ttlInSeconds"
+)
+
+// ErrPinStoreNotConfigured is returned when pinning an actor without an actor
+// state store.
+var ErrPinStoreNotConfigured = errors.New("actor state store is required to pin actors to a version")
+
+var log = logger.NewLogger("dapr.runtime.actors.versions")
+
+type PinsOptions struct {
+	StoreName  string
+	CompStore  *compstore.ComponentStore
+	Resiliency resiliency.Provider
+}
+
+// Pins stores the version every actor ID of a pinned actor type is pinned to.
+type Pins struct {
+	storeName  string
+	compStore  *compstore.ComponentStore
+	resiliency resiliency.Provider
+	cache      *expirable.LRU[string, pin]
+	clock      clock.Clock
+}
+
+// pin is the state store value of the pin of an actor.
+type pin struct {
+	Version  string    `json:"version"`
+	PinnedAt time.Time `json:"pinnedAt"`
+
+	etag *string
+}
+
+func NewPins(opts PinsOptions) *Pins {
+	return &Pins{
+		storeName:  opts.StoreName,
+		compStore:  opts.CompStore,
+		resiliency: opts.Resiliency,
+		cache:      expirable.NewLRU[string, pin](pinCacheSize, nil, pinCacheTTL),
+		clock:      clock.RealClock{},
+	}
+}
+
+// PinKey returns the actor state store key of the pin of an actor.
+func PinKey(actorType, actorID string) string {
+	return pinKeyPrefix + api.DaprSeparator + actorType + api.DaprSeparator + actorID
+}
+
+// Get returns the version the actor is pinned to, or an empty string if the
+// actor is not pinned.
+func (p *Pins) Get(ctx context.Context, actorType, actorID string) (string, error) {
+	key := PinKey(actorType, actorID)
+	if cached, ok := p.cache.Get(key); ok {
+		return cached.Version, nil
+	}
+
+	store, ok := p.compStore.GetStateStore(p.storeName)
+	if !ok {
+		return "", nil
+	}
+
+	current, err := p.read(ctx, store, key)
+	if err != nil {
+		return "", err
+	}
+
+	if current.Version != "" && p.expires(store) && p.clock.Since(current.PinnedAt) > pinTTL/2 {
+		// A failed refresh is retried on the next read of the pin, and a
+		// concurrent change of the pin refreshed it already.
+		if err = p.write(ctx, store, key, current.Version, current.etag); err != nil {
+			log.Debugf("Failed to refresh version pin of actor %s: %s", key, err)
+		}
+	}
+
+	return current.Version, nil
+}
+
+// Peek returns the version the actor is pinned to, like Get, but never
+// writes to the state store, so that pins can be resolved while the placement
+// tables are updated.
+func (p *Pins) Peek(ctx context.Context, actorType, actorID string) (string, error) {
+	key := PinKey(actorType, actorID)
+	if cached, ok := p.cache.Get(key); ok {
+		return cached.Version, nil
+	}
+
+	store, ok := p.compStore.GetStateStore(p.storeName)
+	if !ok {
+		return "", nil
+	}
+
+	current, err := p.read(ctx, store, key)
+	if err != nil {
+		return "", err
+	}
+	return current.Version, nil
+}
+
+// Claim pins the actor to the given version, unless its pin was changed by
+// another Dapr instance since it was last read. In that case, the version of
+// the other instance wins and is returned.
+func (p *Pins) Claim(ctx context.Context, actorType, actorID, version string) (string, error) {
+	store, ok := p.compStore.GetStateStore(p.storeName)
+	if !ok {
+		return "", ErrPinStoreNotConfigured
+	}
+
+	key := PinKey(actorType, actorID)
+	current, ok := p.cache.Get(key)
+	if !ok {
+		var err error
+		current, err = p.read(ctx, store, key)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err := p.write(ctx, store, key, version, current.etag)
+	if err == nil {
+		return version, nil
+	}
+
+	// Not every state store reports a conflicting first write as an etag
+	// mismatch, so the pin is read again to find out whether it changed.
+	p.cache.Remove(key)
+	winner, rerr := p.read(ctx, store, key)
+	if rerr == nil && winner.Version != "" && !sameETag(winner.etag, current.etag) {
+		return winner.Version, nil
+	}
+	return "", err
+}
+
+// Set pins the actor to the given version, overriding its current pin.
+func (p *Pins) Set(ctx context.Context, actorType, actorID, version string) error {
+	store, ok := p.compStore.GetStateStore(p.storeName)
+	if !ok {
+		return ErrPinStoreNotConfigured
+	}
+
+	key := PinKey(actorType, actorID)
+	policyRunner := resiliency.NewRunner[struct{}](ctx,
+		p.resiliency.ComponentOutboundPolicy(p.storeName, resiliency.Statestore),
+	)
+	value := pin{Version: version, PinnedAt: p.clock.Now()}
+	_, err := policyRunner(func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, store.Set(ctx, &contribstate.SetRequest{
+			Key:      key,
+			Value:    value,
+			Metadata: p.metadata(store),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	p.cache.Remove(key)
+	return nil
+}
+
+// Forget drops the cached pin of the actor, so that the next lookup reads it
+// from the state store.
+func (p *Pins) Forget(actorType, actorID string) {
+	p.cache.Remove(PinKey(actorType, actorID))
+}
+
+func (p *Pins) read(ctx context.Context, store contribstate.Store, key string) (pin, error) {
+	policyRunner := resiliency.NewRunner[*contribstate.GetResponse](ctx,
+		p.resiliency.ComponentOutboundPolicy(p.storeName, resiliency.Statestore),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*contribstate.GetResponse, error) {
+		return store.Get(ctx, &contribstate.GetRequest{Key: key})
+	})
+	if err != nil {
+		return pin{}, err
+	}
+
+	var current pin
+	if resp != nil && len(resp.Data) > 0 {
+		if err = json.Unmarshal(resp.Data, &current); err != nil {
+			return pin{}, fmt.Errorf("failed to decode version pin of actor %s: %w", key, err)
+		}
+		current.etag = resp.ETag
+	}
+	p.cache.Add(key, current)
+	return current, nil
+}
+
+// write pins the actor to the given version if its pin still has the given
+// etag, or if it is not pinned yet when the etag is nil. Write conflicts are
+// not retried, as the pin of the other writer wins.
+func (p *Pins) write(ctx context.Context, store contribstate.Store, key, version string, etag *string) error {
+	err := store.Set(ctx, &contribstate.SetRequest{
+		Key:      key,
+		Value:    pin{Version: version, PinnedAt: p.clock.Now()},
+		ETag:     etag,
+		Metadata: p.metadata(store),
+		Options: contribstate.SetStateOption{
+			Concurrency: contribstate.FirstWrite,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	p.cache.Remove(key)
+	return nil
+}
+
+func (p *Pins) expires(store contribstate.Store) bool {
+	return contribstate.FeatureTTL.IsPresent(store.Features())
+}
+
+func (p *Pins) metadata(store contribstate.Store) map[string]string {
+	if !p.expires(store) {
+		return nil
+	}
+	return map[string]string{
+		metadataTTLInSeconds: strconv.Itoa(int(pinTTL.Seconds())),
+	}
+}
+
+func sameETag(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+func TestPins(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+
+	newPins := func() *Pins {
+		return NewPins(PinsOptions{
+			StoreName:  "store",
+			CompStore:  compStore,
+			Resiliency: resiliency.New(logger.NewLogger("test")),
+		})
+	}
+	p := newPins()
+
+	version, err := p.Get(t.Context(), "mytype", "myid")
+	require.NoError(t, err)
+	assert.Empty(t, version)
+
+	require.NoError(t, p.Set(t.Context(), "mytype", "myid", "2"))
+	version, err = p.Get(t.Context(), "mytype", "myid")
+	require.NoError(t, err)
+	assert.Equal(t, "2", version)
+
+	t.Run("pins are shared between instances", func(t *testing.T) {
+		version, err := newPins().Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+	})
+
+	t.Run("forget drops the cached pin", func(t *testing.T) {
+		require.NoError(t, store.Set(t.Context(), &contribstate.SetRequest{
+			Key:   PinKey("mytype", "myid"),
+			Value: pin{Version: "3"},
+		}))
+
+		version, err := p.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+
+		p.Forget("mytype", "myid")
+		version, err = p.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Equal(t, "3", version)
+	})
+
+	t.Run("no state store", func(t *testing.T) {
+		p := NewPins(PinsOptions{
+			StoreName:  "missing",
+			CompStore:  compStore,
+			Resiliency: resiliency.New(logger.NewLogger("test")),
+		})
+		version, err := p.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Empty(t, version)
+		require.ErrorIs(t, p.Set(t.Context(), "mytype", "myid", "1"), ErrPinStoreNotConfigured)
+	})
+}
+
+func TestPinsClaim(t *testing.T) {
+	store := newFirstWriteStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+
+	newPins := func() *Pins {
+		return NewPins(PinsOptions{
+			StoreName:  "store",
+			CompStore:  compStore,
+			Resiliency: resiliency.New(logger.NewLogger("test")),
+		})
+	}
+
+	t.Run("the first claim wins", func(t *testing.T) {
+		p1, p2 := newPins(), newPins()
+
+		// Both instances see the actor unpinned, and disagree on the newest
+		// version.
+		version, err := p1.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Empty(t, version)
+		version, err = p2.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Empty(t, version)
+
+		version, err = p1.Claim(t.Context(), "mytype", "myid", "2")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+		version, err = p2.Claim(t.Context(), "mytype", "myid", "1")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+
+		version, err = newPins().Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+	})
+
+	t.Run("a claim replaces the pin it read", func(t *testing.T) {
+		p := newPins()
+		version, err := p.Get(t.Context(), "mytype", "myid")
+		require.NoError(t, err)
+		assert.Equal(t, "2", version)
+
+		version, err = p.Claim(t.Context(), "mytype", "myid", "3")
+		require.NoError(t, err)
+		assert.Equal(t, "3", version)
+	})
+
+	t.Run("pins expire and are refreshed", func(t *testing.T) {
+		store.ttl = true
+		clock := clocktesting.NewFakeClock(time.Now())
+		p := newPins()
+		p.clock = clock
+
+		version, err := p.Claim(t.Context(), "mytype", "other", "1")
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, "2592000", store.metadata(PinKey("mytype", "other"))[metadataTTLInSeconds])
+		writes := store.writes
+
+		// Reading the pin doesn't write it until half of its TTL passed.
+		version, err = p.Get(t.Context(), "mytype", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, writes, store.writes)
+
+		clock.Step(pinTTL/2 + time.Second)
+		p.Forget("mytype", "other")
+
+		// Peeking at the pin never writes it.
+		version, err = p.Peek(t.Context(), "mytype", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, writes, store.writes)
+
+		p.Forget("mytype", "other")
+		version, err = p.Get(t.Context(), "mytype", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "1", version)
+		assert.Equal(t, writes+1, store.writes)
+	})
+}
+
+// firstWriteStore is a state store which rejects writes with a stale etag,
+// and first writes of keys which exist.
+type firstWriteStore struct {
+	*daprt.FakeStateStore
+
+	lock    sync.Mutex
+	ttl     bool
+	writes  int
+	etags   map[string]string
+	written map[string]map[string]string
+}
+
+func newFirstWriteStore() *firstWriteStore {
+	return &firstWriteStore{
+		FakeStateStore: daprt.NewFakeStateStore(),
+		etags:          make(map[string]string),
+		written:        make(map[string]map[string]string),
+	}
+}
+
+// metadata returns the metadata of the last write of the key.
+func (s *firstWriteStore) metadata(key string) map[string]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.written[key]
+}
+
+func (s *firstWriteStore) Features() []contribstate.Feature {
+	features := s.FakeStateStore.Features()
+	if s.ttl {
+		features = append(features, contribstate.FeatureTTL)
+	}
+	return features
+}
+
+func (s *firstWriteStore) Get(ctx context.Context, req *contribstate.GetRequest) (*contribstate.GetResponse, error) {
+	resp, err := s.FakeStateStore.Get(ctx, req)
+	if err != nil || len(resp.Data) == 0 {
+		return resp, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	etag := s.etags[req.Key]
+	resp.ETag = &etag
+	return resp, nil
+}
+
+func (s *firstWriteStore) Set(ctx context.Context, req *contribstate.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	etag, exists := s.etags[req.Key]
+	if req.Options.Concurrency == contribstate.FirstWrite {
+		if (req.ETag == nil && exists) || (req.ETag != nil && *req.ETag != etag) {
+			return contribstate.NewETagError(contribstate.ETagMismatch, nil)
+		}
+	}
+
+	if err := s.FakeStateStore.Set(ctx, req); err != nil {
+		return err
+	}
+	s.writes++
+	s.etags[req.Key] = etag + "x"
+	s.written[req.Key] = req.Metadata
+	return nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versions implements versioned actor type registration and routing.
+//
+// A host which hosts a versioned actor type registers the actor type with
+// placement twice: once under the plain actor type name, and once under a
+// versioned entity name which encodes the actor type, its version, and the
+// routing policy of that version. Placement builds a separate hash table for
+// every entity, so every Dapr instance receives the hash tables of every
+// registered version and can resolve which version an actor should be routed
+// to without any changes to the placement service.
+//
+// With the pinned routing policy, every actor ID is pinned to the version it
+// was first activated on, until it is explicitly migrated to another version
+// or the version it is pinned to is no longer registered. Pins are kept in
+// the actor state store so that every Dapr instance routes an actor ID to the
+// same version.
+package versions
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// Entity returns the placement entity name of a versioned actor type.
+func Entity(actorType, version string, routing config.ActorVersionRouting) string {
+	if len(routing) == 0 {
+		routing = config.ActorVersionRoutingNewest
+	}
+	return actorType + api.DaprSeparator + version + api.DaprSeparator + string(routing)
+}
+
+// Parse parses a placement entity name. Returns false if the entity is not a
+// versioned actor type.
+func Parse(entity string) (actorType, version string, routing config.ActorVersionRouting, ok bool) {
+	parts := strings.Split(entity, api.DaprSeparator)
+	if len(parts) != 3 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], config.ActorVersionRouting(parts[2]), true
+}
+
+// Entities returns the placement entities to register for the given actor
+// types. versionFn returns the version and routing policy of an actor type, or
+// an empty version if the actor type is not versioned.
+func Entities(actorTypes []string, versionFn func(string) (string, config.ActorVersionRouting)) []string {
+	entities := make([]string, 0, len(actorTypes))
+	for _, actorType := range actorTypes {
+		entities = append(entities, actorType)
+		if version, routing := versionFn(actorType); len(version) > 0 {
+			entities = append(entities, Entity(actorType, version, routing))
+		}
+	}
+	return entities
+}
+
+type versioned struct {
+	entity  string
+	version string
+	routing config.ActorVersionRouting
+}
+
+type typeVersions struct {
+	// routing is the routing policy of the newest version.
+	routing  config.ActorVersionRouting
+	newest   versioned
+	entities map[string]string
+}
+
+// Resolver resolves the placement entity actors of a given type should be
+// routed to.
+type Resolver struct {
+	types map[string]typeVersions
+}
+
+// NewResolver returns a Resolver for the given set of registered placement
+// entities.
+func NewResolver(entities []string) *Resolver {
+	types := make(map[string]typeVersions)
+	for _, entity := range entities {
+		actorType, version, routing, ok := Parse(entity)
+		if !ok {
+			continue
+		}
+
+		tv, ok := types[actorType]
+		if !ok {
+			tv.entities = make(map[string]string)
+		}
+		tv.entities[version] = entity
+		if !ok || Compare(version, tv.newest.version) > 0 {
+			// The routing policy of the newest version decides where actors
+			// are routed to.
+			tv.newest = versioned{entity: entity, version: version, routing: routing}
+			tv.routing = routing
+		}
+		types[actorType] = tv
+	}
+
+	return &Resolver{types: types}
+}
+
+// Entity returns the placement entity of the newest registered version of the
+// actor type. Returns the actor type itself if no versions of the actor type
+// are registered. A nil Resolver always returns the actor type.
+func (r *Resolver) Entity(actorType string) string {
+	if r == nil {
+		return actorType
+	}
+	if tv, ok := r.types[actorType]; ok {
+		return tv.newest.entity
+	}
+	return actorType
+}
+
+// Pinned returns true if actors of the given type are pinned to the version
+// they were first activated on.
+func (r *Resolver) Pinned(actorType string) bool {
+	if r == nil {
+		return false
+	}
+	tv, ok := r.types[actorType]
+	return ok && tv.routing == config.ActorVersionRoutingPinned
+}
+
+// Newest returns the newest registered version of the actor type and its
+// placement entity.
+func (r *Resolver) Newest(actorType string) (version, entity string, ok bool) {
+	if r == nil {
+		return "", "", false
+	}
+	tv, ok := r.types[actorType]
+	return tv.newest.version, tv.newest.entity, ok
+}
+
+// VersionEntity returns the placement entity of a registered version of the
+// actor type. Returns false if the version is not registered.
+func (r *Resolver) VersionEntity(actorType, version string) (string, bool) {
+	if r == nil || len(version) == 0 {
+		return "", false
+	}
+	entity, ok := r.types[actorType].entities[version]
+	return entity, ok
+}
+
+// Compare compares two version strings. Versions are compared segment by
+// segment, split on '.' and '-', with an optional leading 'v'. Numeric
+// segments are compared numerically, others lexically.
+func Compare(a, b string) int {
+	split := func(v string) []string {
+		v = strings.TrimPrefix(strings.TrimPrefix(v, "v"), "V")
+		return strings.FieldsFunc(v, func(r rune) bool {
+			return r == '.' || r == '-'
+		})
+	}
+
+	as, bs := split(a), split(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aerr := strconv.ParseUint(as[i], 10, 64)
+		bn, berr := strconv.ParseUint(bs[i], 10, 64)
+		switch {
+		case aerr == nil && berr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aerr == nil:
+			// Numeric segments sort after non-numeric ones, so 1.0.0 > 1.0.0-rc.
+			return 1
+		case berr == nil:
+			return -1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(as) == len(bs):
+		return strings.Compare(a, b)
+	case len(as) > len(bs):
+		// A pre-release suffix makes a version older, e.g. 1.0.0-rc < 1.0.0.
+		if _, err := strconv.ParseUint(as[len(bs)], 10, 64); err != nil {
+			return -1
+		}
+		return 1
+	default:
+		if _, err := strconv.ParseUint(bs[len(as)], 10, 64); err != nil {
+			return 1
+		}
+		return -1
+	}
+}
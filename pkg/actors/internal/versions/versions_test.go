@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/config"
+)
+
+func TestEntityParse(t *testing.T) {
+	entity := Entity("mytype", "v2", "")
+	assert.Equal(t, "mytype||v2||newest", entity)
+
+	actorType, version, routing, ok := Parse(entity)
+	assert.True(t, ok)
+	assert.Equal(t, "mytype", actorType)
+	assert.Equal(t, "v2", version)
+	assert.Equal(t, config.ActorVersionRoutingNewest, routing)
+
+	_, _, _, ok = Parse("mytype")
+	assert.False(t, ok)
+	_, _, _, ok = Parse("mytype||||newest")
+	assert.False(t, ok)
+}
+
+func TestEntities(t *testing.T) {
+	entities := Entities([]string{"a", "b"}, func(actorType string) (string, config.ActorVersionRouting) {
+		if actorType == "b" {
+			return "1.2.0", config.ActorVersionRoutingPinned
+		}
+		return "", ""
+	})
+	assert.Equal(t, []string{"a", "b", "b||1.2.0||pinned"}, entities)
+}
+
+func TestResolver(t *testing.T) {
+	t.Run("nil resolver returns actor type", func(t *testing.T) {
+		var r *Resolver
+		assert.Equal(t, "mytype", r.Entity("mytype"))
+	})
+
+	t.Run("unversioned actor types", func(t *testing.T) {
+		r := NewResolver([]string{"a", "b"})
+		assert.Equal(t, "a", r.Entity("a"))
+		assert.Equal(t, "c", r.Entity("c"))
+	})
+
+	t.Run("newest routing", func(t *testing.T) {
+		r := NewResolver([]string{
+			"a",
+			Entity("a", "v1.9.0", config.ActorVersionRoutingNewest),
+			Entity("a", "v1.10.0", config.ActorVersionRoutingNewest),
+			Entity("a", "v1.10.0-rc.1", config.ActorVersionRoutingNewest),
+			"b",
+		})
+		assert.Equal(t, "a||v1.10.0||newest", r.Entity("a"))
+		assert.Equal(t, "b", r.Entity("b"))
+	})
+
+	t.Run("pinned routing", func(t *testing.T) {
+		r := NewResolver([]string{
+			"a",
+			Entity("a", "2", config.ActorVersionRoutingNewest),
+			Entity("a", "3", config.ActorVersionRoutingPinned),
+			"b",
+			Entity("b", "1", config.ActorVersionRoutingNewest),
+		})
+		assert.True(t, r.Pinned("a"))
+		assert.False(t, r.Pinned("b"))
+		assert.False(t, r.Pinned("c"))
+		assert.Equal(t, "a||3||pinned", r.Entity("a"))
+
+		version, entity, ok := r.Newest("a")
+		assert.True(t, ok)
+		assert.Equal(t, "3", version)
+		assert.Equal(t, "a||3||pinned", entity)
+
+		entity, ok = r.VersionEntity("a", "2")
+		assert.True(t, ok)
+		assert.Equal(t, "a||2||newest", entity)
+		_, ok = r.VersionEntity("a", "1")
+		assert.False(t, ok)
+		_, ok = r.VersionEntity("a", "")
+		assert.False(t, ok)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		exp  int
+	}{
+		{"1", "1", 0},
+		{"v1", "1", 1},
+		{"1", "2", -1},
+		{"1.10", "1.9", 1},
+		{"1.0", "1.0.1", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0-rc.1", "1.0.0-rc.2", -1},
+		{"1.0.0-alpha", "1.0.0-beta", -1},
+		{"blue", "green", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.exp, Compare(test.a, test.b))
+			assert.Equal(t, -test.exp, Compare(test.b, test.a))
+		})
+	}
+}
@@ -19,12 +19,26 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
+	"github.com/dapr/dapr/pkg/actors/internal/versions"
+	"github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/table"
 )
 
 // TODO: @joshvanl: move errors package
 var ErrReminderOpActorNotHosted = errors.New("operations on actor reminders are only possible on hosted actor types")
 
+// ErrReminderNameReserved is returned when creating a reminder with the name
+// of an internal reminder, which the runtime would handle instead of the
+// actor.
+var ErrReminderNameReserved = errors.New("actor reminder name is reserved for internal use")
+
+// reservedNames are the names of the internal reminders, which the runtime
+// handles for actors of every type.
+var reservedNames = map[string]struct{}{
+	state.TTLReminderName:        {},
+	versions.MigrateReminderName: {},
+}
+
 type Interface interface {
 	// Get retrieves an actor reminder.
 	Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error)
@@ -62,6 +76,10 @@ func (r *reminders) Get(ctx context.Context, req *api.GetReminderRequest) (*api.
 }
 
 func (r *reminders) Create(ctx context.Context, req *api.CreateReminderRequest) error {
+	if _, ok := reservedNames[req.Name]; ok {
+		return ErrReminderNameReserved
+	}
+
 	if !r.table.IsActorTypeHosted(req.ActorType) {
 		return ErrReminderOpActorNotHosted
 	}
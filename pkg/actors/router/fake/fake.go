@@ -25,6 +25,7 @@ type Fake struct {
 	callFn         func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	callReminderFn func(context.Context, *api.Reminder) error
 	callStreamFn   func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error
+	migrateFn      func(context.Context, *api.MigrateActorRequest) error
 }
 
 func New() *Fake {
@@ -40,6 +41,9 @@ func New() *Fake {
 		callStreamFn: func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error {
 			return nil
 		},
+		migrateFn: func(context.Context, *api.MigrateActorRequest) error {
+			return nil
+		},
 	}
 }
 
@@ -58,6 +62,11 @@ func (f *Fake) WithCallStreamFn(fn func(ctx context.Context, req *internalv1pb.I
 	return f
 }
 
+func (f *Fake) WithMigrateFn(fn func(context.Context, *api.MigrateActorRequest) error) *Fake {
+	f.migrateFn = fn
+	return f
+}
+
 func (f *Fake) Call(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	return f.callFn(ctx, req)
 }
@@ -69,3 +78,7 @@ func (f *Fake) CallReminder(ctx context.Context, reminder *api.Reminder) error {
 func (f *Fake) CallStream(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error {
 	return f.callStreamFn(ctx, req, stream)
 }
+
+func (f *Fake) Migrate(ctx context.Context, req *api.MigrateActorRequest) error {
+	return f.migrateFn(ctx, req)
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
+	"github.com/dapr/dapr/pkg/actors/internal/versions"
 	"github.com/dapr/dapr/pkg/actors/reminders"
+	"github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
@@ -44,6 +46,7 @@ type Interface interface {
 	Call(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	CallReminder(ctx context.Context, reminder *api.Reminder) error
 	CallStream(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error
+	Migrate(ctx context.Context, req *api.MigrateActorRequest) error
 }
 
 type Options struct {
@@ -52,8 +55,10 @@ type Options struct {
 	Placement          placement.Interface
 	Resiliency         resiliency.Provider
 	Reminders          reminders.Interface
+	State              state.Interface
 	GRPC               *manager.Manager
 	IdlerQueue         *queue.Processor[string, targets.Idlable]
+	Pins               *versions.Pins
 	SchedulerReminders bool
 	MaxRequestBodySize int
 }
@@ -66,7 +71,9 @@ type router struct {
 	placement  placement.Interface
 	resiliency resiliency.Provider
 	reminders  reminders.Interface
+	state      state.Interface
 	grpc       *manager.Manager
+	pins       *versions.Pins
 
 	idlerQueue *queue.Processor[string, targets.Idlable]
 
@@ -85,6 +92,8 @@ func New(opts Options) Interface {
 		grpc:               opts.GRPC,
 		idlerQueue:         opts.IdlerQueue,
 		reminders:          opts.Reminders,
+		state:              opts.State,
+		pins:               opts.Pins,
 		clock:              clock.RealClock{},
 		callOptions: []grpc.CallOption{
 			grpc.MaxCallRecvMsgSize(opts.MaxRequestBodySize),
@@ -116,6 +125,19 @@ func (r *router) Call(ctx context.Context, req *internalv1pb.InternalInvokeReque
 }
 
 func (r *router) CallReminder(ctx context.Context, req *api.Reminder) error {
+	// The deletion of the expired state keys of an actor is handled by whichever
+	// host receives it, without activating the actor. Writes to the actor state
+	// are serialized with it by the TTL index of the actor.
+	if r.state != nil && req.Name == state.TTLReminderName {
+		return r.state.DeleteExpired(ctx, req.ActorType, req.ActorID)
+	}
+
+	// Actors migrated to another version are deactivated by the host of the
+	// version they were migrated from.
+	if req.IsRemote && req.Name == versions.MigrateReminderName {
+		return r.deactivateMigrated(req.ActorType, req.ActorID)
+	}
+
 	if req.SkipLock {
 		return r.callReminder(ctx, req)
 	}
@@ -145,6 +167,49 @@ func (r *router) CallStream(ctx context.Context, req *internalv1pb.InternalInvok
 	return err
 }
 
+// Migrate pins the actor to the requested version of its actor type, and
+// deactivates it on the host of the version it was pinned to, so that it is
+// activated on the new version on its next invocation. Other Dapr instances
+// keep routing the actor to the version it was pinned to until their cached
+// pin expires.
+func (r *router) Migrate(ctx context.Context, req *api.MigrateActorRequest) error {
+	ctx, cancel, err := r.placement.Lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	lar, err := r.placement.LookupActor(ctx, &api.LookupActorRequest{
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lookup actor: %w", err)
+	}
+
+	if err = r.placement.PinActor(ctx, req); err != nil {
+		return err
+	}
+
+	if lar.Local {
+		return r.deactivateMigrated(req.ActorType, req.ActorID)
+	}
+
+	return r.callRemoteActorReminder(ctx, lar, &api.Reminder{
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+		Name:      versions.MigrateReminderName,
+		SkipLock:  true,
+	})
+}
+
+func (r *router) deactivateMigrated(actorType, actorID string) error {
+	if r.pins != nil {
+		r.pins.Forget(actorType, actorID)
+	}
+	return r.table.Halt(actorType, actorID)
+}
+
 func (r *router) callReminder(ctx context.Context, req *api.Reminder) error {
 	if !req.SkipLock {
 		var cancel context.CancelFunc
@@ -182,6 +247,13 @@ func (r *router) callReminder(ctx context.Context, req *api.Reminder) error {
 		return backoff.Permanent(err)
 	}
 
+	if req.IsDurableTimer() {
+		req, err = req.DurableTimer()
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+	}
+
 	if req.IsTimer {
 		err = target.InvokeTimer(ctx, req)
 	} else {
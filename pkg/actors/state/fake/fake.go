@@ -23,6 +23,7 @@ type Fake struct {
 	getFn                         func(ctx context.Context, req *api.GetStateRequest, lock bool) (*api.StateResponse, error)
 	getBulkFn                     func(ctx context.Context, req *api.GetBulkStateRequest, lock bool) (api.BulkStateResponse, error)
 	transactionalStateOperationFn func(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error
+	deleteExpiredFn               func(ctx context.Context, actorType, actorID string) error
 }
 
 func New() *Fake {
@@ -36,6 +37,9 @@ func New() *Fake {
 		transactionalStateOperationFn: func(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error {
 			return nil
 		},
+		deleteExpiredFn: func(ctx context.Context, actorType, actorID string) error {
+			return nil
+		},
 	}
 }
 
@@ -54,6 +58,11 @@ func (f *Fake) WithTransactionalStateOperationFn(fn func(ctx context.Context, ig
 	return f
 }
 
+func (f *Fake) WithDeleteExpiredFn(fn func(ctx context.Context, actorType, actorID string) error) *Fake {
+	f.deleteExpiredFn = fn
+	return f
+}
+
 func (f *Fake) Get(ctx context.Context, req *api.GetStateRequest, lock bool) (*api.StateResponse, error) {
 	return f.getFn(ctx, req, lock)
 }
@@ -65,3 +74,7 @@ func (f *Fake) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock b
 func (f *Fake) TransactionalStateOperation(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error {
 	return f.transactionalStateOperationFn(ctx, ignoreHosted, req, lock)
 }
+
+func (f *Fake) DeleteExpired(ctx context.Context, actorType, actorID string) error {
+	return f.deleteExpiredFn(ctx, actorType, actorID)
+}
@@ -19,16 +19,22 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/utils/clock"
+
 	contribstate "github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/internal/key"
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
+	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
 )
 
+var log = logger.NewLogger("dapr.runtime.actors.state")
+
 const (
 	metadataPartitionKey = "partitionKey"
 
@@ -47,6 +53,10 @@ type Interface interface {
 
 	// TransactionalStateOperation performs a transactional state operation with the actor state store.
 	TransactionalStateOperation(ctx context.Context, ignoreHosted bool, req *api.TransactionalRequest, lock bool) error
+
+	// DeleteExpired deletes the expired keys of the given actor when TTL is
+	// emulated for the actor state store.
+	DeleteExpired(ctx context.Context, actorType, actorID string) error
 }
 
 type Backend interface {
@@ -62,6 +72,10 @@ type Options struct {
 	Table      table.Interface
 	Placement  placement.Interface
 
+	// Reminders is used to schedule the deletion of expired keys when TTL is
+	// emulated for state stores without native TTL support.
+	Reminders storage.Interface
+
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	StateTTLEnabled bool
 }
@@ -73,9 +87,12 @@ type state struct {
 	resiliency resiliency.Provider
 	table      table.Interface
 	placement  placement.Interface
+	reminders  storage.Interface
 
 	// TODO: @joshvanl Remove in Dapr 1.12 when ActorStateTTL is finalized.
 	stateTTLEnabled bool
+
+	clock clock.Clock
 }
 
 func New(opts Options) Interface {
@@ -86,7 +103,9 @@ func New(opts Options) Interface {
 		resiliency:      opts.Resiliency,
 		table:           opts.Table,
 		placement:       opts.Placement,
+		reminders:       opts.Reminders,
 		stateTTLEnabled: opts.StateTTLEnabled,
+		clock:           clock.RealClock{},
 	}
 }
 
@@ -131,6 +150,14 @@ func (s *state) Get(ctx context.Context, req *api.GetStateRequest, lock bool) (*
 		return &api.StateResponse{}, nil
 	}
 
+	expired, err := s.filterExpired(ctx, storeName, store, actorKey, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := expired[key]; ok {
+		return &api.StateResponse{}, nil
+	}
+
 	return &api.StateResponse{
 		Data:     resp.Data,
 		Metadata: resp.Metadata,
@@ -175,6 +202,11 @@ func (s *state) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock
 		return nil, err
 	}
 
+	expired, err := s.filterExpired(ctx, storeName, store, actorKey, metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add the dapr separator to baseKey
 	baseKey += api.DaprSeparator
 
@@ -184,6 +216,10 @@ func (s *state) GetBulk(ctx context.Context, req *api.GetBulkStateRequest, lock
 			return nil, fmt.Errorf("failed to retrieve key '%s': %s", r.Key, r.Error)
 		}
 
+		if _, ok := expired[r.Key]; ok {
+			r.Data = nil
+		}
+
 		// Trim the prefix from the key
 		bulkRes[strings.TrimPrefix(r.Key, baseKey)] = r.Data
 	}
@@ -224,15 +260,19 @@ func (s *state) TransactionalStateOperation(ctx context.Context, ignoreHosted bo
 		}
 	}
 
-	return s.executeStateStoreTransaction(ctx, operations, metadata)
-}
-
-func (s *state) executeStateStoreTransaction(ctx context.Context, operations []contribstate.TransactionalStateOperation, metadata map[string]string) error {
 	storeName, store, err := s.stateStore()
 	if err != nil {
 		return err
 	}
 
+	if s.emulateTTL(store) {
+		return s.executeWithTTL(ctx, storeName, store, req.ActorType, req.ActorID, operations, metadata)
+	}
+
+	return s.executeStateStoreTransaction(ctx, storeName, store, operations, metadata)
+}
+
+func (s *state) executeStateStoreTransaction(ctx context.Context, storeName string, store Backend, operations []contribstate.TransactionalStateOperation, metadata map[string]string) error {
 	if maxMulti, ok := store.(contribstate.TransactionalStoreMultiMaxSize); ok {
 		max := maxMulti.MultiMaxSize()
 		if max > 0 && len(operations) > max {
@@ -246,7 +286,7 @@ func (s *state) executeStateStoreTransaction(ctx context.Context, operations []c
 	policyRunner := resiliency.NewRunner[struct{}](ctx,
 		s.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
 	)
-	_, err = policyRunner(func(ctx context.Context) (struct{}, error) {
+	_, err := policyRunner(func(ctx context.Context) (struct{}, error) {
 		return struct{}{}, store.Multi(ctx, stateReq)
 	})
 	return err
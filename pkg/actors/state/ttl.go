@@ -0,0 +1,349 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	contribstate "github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/key"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	metadataTTLInSeconds = "ttlInSeconds"
+
+	// ttlIndexKey is the name of the per actor index of expiring keys, which is
+	// used to emulate TTL for state stores without native TTL support. The
+	// index is written in the same transaction as the keys it indexes, which a
+	// per actor type index couldn't be. The cost is one reminder per actor with
+	// expiring keys, see API-013 in docs/decision_records.
+	ttlIndexKey = "dapr.ttl"
+
+	// TTLReminderName is the name of the actor reminder which deletes the
+	// expired keys of the actor. It is due at the earliest expiry of the index.
+	TTLReminderName = "dapr.state.ttl"
+
+	// ttlIndexMaxAttempts is the number of attempts made to write a transaction
+	// when the index is concurrently updated.
+	ttlIndexMaxAttempts = 5
+)
+
+// ttlIndex is the expiry of every expiring key of an actor.
+type ttlIndex map[string]time.Time
+
+// emulateTTL returns true if TTL is emulated for the given state store.
+func (s *state) emulateTTL(store Backend) bool {
+	return s.stateTTLEnabled && !contribstate.FeatureTTL.IsPresent(store.Features())
+}
+
+// ttlIndexStoreKey returns the state store key of the TTL index of the actor.
+// The empty key segment keeps the index out of the key space of the actor's
+// state keys.
+func (s *state) ttlIndexStoreKey(actorKey string) string {
+	return key.ConstructComposite(s.appID, actorKey, "", ttlIndexKey)
+}
+
+// stripTTL removes the TTL metadata from the given operations. It returns the
+// expiry of every key written with a TTL, and nil for every other key written
+// or deleted, which no longer expires.
+func (s *state) stripTTL(operations []contribstate.TransactionalStateOperation) (map[string]*time.Time, error) {
+	expiries := make(map[string]*time.Time, len(operations))
+	now := s.clock.Now()
+	for i, op := range operations {
+		set, ok := op.(contribstate.SetRequest)
+		if !ok {
+			expiries[op.GetKey()] = nil
+			continue
+		}
+
+		expiries[set.Key] = nil
+
+		v, ok := set.Metadata[metadataTTLInSeconds]
+		if !ok {
+			continue
+		}
+
+		ttl, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", metadataTTLInSeconds, v, err)
+		}
+
+		// The metadata map may be shared between operations.
+		set.Metadata = maps.Clone(set.Metadata)
+		delete(set.Metadata, metadataTTLInSeconds)
+		operations[i] = set
+
+		// A negative TTL means the key never expires.
+		if ttl >= 0 {
+			expiries[set.Key] = ptr.Of(now.Add(time.Duration(ttl) * time.Second))
+		}
+	}
+
+	return expiries, nil
+}
+
+// executeWithTTL executes the transaction of an actor together with the
+// update of the actor's TTL index, so that keys and their expiry are always
+// written atomically. The transaction is retried if the index is concurrently
+// updated.
+func (s *state) executeWithTTL(ctx context.Context, storeName string, store Backend, actorType, actorID string, operations []contribstate.TransactionalStateOperation, metadata map[string]string) error {
+	expiries, err := s.stripTTL(operations)
+	if err != nil {
+		return err
+	}
+
+	indexKey := s.ttlIndexStoreKey(key.ConstructComposite(actorType, actorID))
+	for range ttlIndexMaxAttempts {
+		var index ttlIndex
+		var etag *string
+		index, etag, err = s.getTTLIndex(ctx, storeName, store, indexKey, metadata)
+		if err != nil {
+			return err
+		}
+
+		next := maps.Clone(index)
+		for k, expiry := range expiries {
+			if expiry == nil {
+				delete(next, k)
+			} else {
+				next[k] = *expiry
+			}
+		}
+
+		ops := operations
+		if !maps.EqualFunc(index, next, time.Time.Equal) {
+			var op contribstate.TransactionalStateOperation
+			op, err = ttlIndexOperation(indexKey, next, etag, metadata)
+			if err != nil {
+				return err
+			}
+			ops = append(slices.Clip(operations), op)
+		}
+
+		err = s.executeStateStoreTransaction(ctx, storeName, store, ops, metadata)
+		if err == nil {
+			s.scheduleTTL(ctx, actorType, actorID, index, next)
+			return nil
+		}
+		if !isETagMismatch(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// DeleteExpired deletes the expired keys of the given actor from a state store
+// with emulated TTL. Keys are deleted in the same transaction as they are
+// removed from the TTL index of the actor.
+func (s *state) DeleteExpired(ctx context.Context, actorType, actorID string) error {
+	storeName, store, err := s.stateStore()
+	if err != nil {
+		return err
+	}
+
+	if !s.emulateTTL(store) {
+		return nil
+	}
+
+	actorKey := key.ConstructComposite(actorType, actorID)
+	metadata := map[string]string{metadataPartitionKey: key.ConstructComposite(s.appID, actorKey)}
+	indexKey := s.ttlIndexStoreKey(actorKey)
+
+	// Leave room for the index operation in every transaction. Expired keys
+	// which don't fit are deleted by the next run, which is due immediately.
+	maxOps := -1
+	if maxMulti, ok := store.(contribstate.TransactionalStoreMultiMaxSize); ok && maxMulti.MultiMaxSize() > 1 {
+		maxOps = maxMulti.MultiMaxSize() - 1
+	}
+
+	for range ttlIndexMaxAttempts {
+		var index ttlIndex
+		var etag *string
+		index, etag, err = s.getTTLIndex(ctx, storeName, store, indexKey, metadata)
+		if err != nil {
+			return err
+		}
+
+		now := s.clock.Now()
+		next := maps.Clone(index)
+		var ops []contribstate.TransactionalStateOperation
+		for k, expiry := range index {
+			if expiry.After(now) {
+				continue
+			}
+			if len(ops) == maxOps {
+				break
+			}
+			ops = append(ops, contribstate.DeleteRequest{Key: k, Metadata: metadata})
+			delete(next, k)
+		}
+
+		if len(ops) == 0 {
+			s.scheduleTTL(ctx, actorType, actorID, nil, next)
+			return nil
+		}
+
+		var op contribstate.TransactionalStateOperation
+		op, err = ttlIndexOperation(indexKey, next, etag, metadata)
+		if err != nil {
+			return err
+		}
+
+		err = s.executeStateStoreTransaction(ctx, storeName, store, append(ops, op), metadata)
+		if err == nil {
+			log.Debugf("Deleted %d expired state keys of actor %s", len(ops), actorKey)
+			s.scheduleTTL(ctx, actorType, actorID, nil, next)
+			return nil
+		}
+		if !isETagMismatch(err) {
+			return fmt.Errorf("failed to delete expired state keys of actor %s: %w", actorKey, err)
+		}
+	}
+
+	return fmt.Errorf("failed to delete expired state keys of actor %s: %w", actorKey, err)
+}
+
+// filterExpired returns the keys of the given actor which have expired but are
+// not deleted yet. It returns nil if TTL is not emulated for the state store.
+func (s *state) filterExpired(ctx context.Context, storeName string, store Backend, actorKey string, metadata map[string]string) (map[string]struct{}, error) {
+	if !s.emulateTTL(store) {
+		return nil, nil
+	}
+
+	index, _, err := s.getTTLIndex(ctx, storeName, store, s.ttlIndexStoreKey(actorKey), metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	var expired map[string]struct{}
+	for k, expiry := range index {
+		if expiry.After(now) {
+			continue
+		}
+		if expired == nil {
+			expired = make(map[string]struct{})
+		}
+		expired[k] = struct{}{}
+	}
+
+	return expired, nil
+}
+
+func (s *state) getTTLIndex(ctx context.Context, storeName string, store Backend, indexKey string, metadata map[string]string) (ttlIndex, *string, error) {
+	resp, err := resiliency.NewRunner[*contribstate.GetResponse](ctx,
+		s.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)(func(ctx context.Context) (*contribstate.GetResponse, error) {
+		return store.Get(ctx, &contribstate.GetRequest{
+			Key:      indexKey,
+			Metadata: metadata,
+		})
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get TTL index %s: %w", indexKey, err)
+	}
+
+	index := make(ttlIndex)
+	if resp == nil || len(resp.Data) == 0 {
+		return index, nil, nil
+	}
+
+	if err = json.Unmarshal(resp.Data, &index); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode TTL index %s: %w", indexKey, err)
+	}
+
+	return index, resp.ETag, nil
+}
+
+// ttlIndexOperation returns the transaction operation which writes the given
+// TTL index, or deletes it when empty. A new index is only created if it
+// doesn't exist yet, so that concurrent writers are detected.
+func ttlIndexOperation(indexKey string, index ttlIndex, etag *string, metadata map[string]string) (contribstate.TransactionalStateOperation, error) {
+	if len(index) == 0 {
+		return contribstate.DeleteRequest{Key: indexKey, ETag: etag, Metadata: metadata}, nil
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode TTL index %s: %w", indexKey, err)
+	}
+
+	req := contribstate.SetRequest{
+		Key:      indexKey,
+		Value:    data,
+		ETag:     etag,
+		Metadata: metadata,
+	}
+	if etag == nil {
+		req.Options.Concurrency = contribstate.FirstWrite
+	}
+
+	return req, nil
+}
+
+// scheduleTTL schedules the reminder which deletes the expired keys of the
+// actor when the earliest expiry of its TTL index changes.
+func (s *state) scheduleTTL(ctx context.Context, actorType, actorID string, prev, next ttlIndex) {
+	nextExpiry, ok := earliestExpiry(next)
+	if !ok {
+		return
+	}
+	if prevExpiry, ok := earliestExpiry(prev); ok && prevExpiry.Equal(nextExpiry) {
+		return
+	}
+
+	if s.reminders == nil {
+		log.Warnf("Unable to schedule deletion of expired state keys of actor %s: actor reminders are not available", key.ConstructComposite(actorType, actorID))
+		return
+	}
+
+	// Expired keys are filtered on read, so failing to schedule the deletion
+	// only delays reclaiming their storage until the next write. The due time
+	// is rounded up, so the reminder never fires before the earliest expiry.
+	err := s.reminders.Create(ctx, &api.CreateReminderRequest{
+		Name:      TTLReminderName,
+		ActorType: actorType,
+		ActorID:   actorID,
+		DueTime:   nextExpiry.Truncate(time.Second).Add(time.Second).UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		log.Errorf("Failed to schedule deletion of expired state keys of actor %s: %s", key.ConstructComposite(actorType, actorID), err)
+	}
+}
+
+func earliestExpiry(index ttlIndex) (time.Time, bool) {
+	var earliest time.Time
+	for _, expiry := range index {
+		if earliest.IsZero() || expiry.Before(earliest) {
+			earliest = expiry
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
+
+func isETagMismatch(err error) bool {
+	var etagErr *contribstate.ETagError
+	return errors.As(err, &etagErr) && etagErr.Kind() == contribstate.ETagMismatch
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/logger"
+)
+
+type fakeReminders struct {
+	storage.Interface
+	created []*api.CreateReminderRequest
+}
+
+func (f *fakeReminders) Create(_ context.Context, req *api.CreateReminderRequest) error {
+	f.created = append(f.created, req)
+	return nil
+}
+
+func TestEmulatedTTL(t *testing.T) {
+	store := daprt.NewFakeStateStore()
+	compStore := compstore.New()
+	compStore.AddStateStore("store", store)
+	reminders := new(fakeReminders)
+	clock := clocktesting.NewFakeClock(time.Now())
+
+	s := New(Options{
+		AppID:           "myapp",
+		StoreName:       "store",
+		CompStore:       compStore,
+		Resiliency:      resiliency.New(logger.NewLogger("test")),
+		Reminders:       reminders,
+		StateTTLEnabled: true,
+	}).(*state)
+	s.clock = clock
+
+	require.NoError(t, s.TransactionalStateOperation(t.Context(), true, &api.TransactionalRequest{
+		ActorType: "mytype",
+		ActorID:   "myid",
+		Operations: []api.TransactionalOperation{
+			{
+				Operation: api.Upsert,
+				Request: api.TransactionalUpsert{
+					Key:      "expiring",
+					Value:    "foo",
+					Metadata: map[string]string{"ttlInSeconds": "10"},
+				},
+			},
+			{
+				Operation: api.Upsert,
+				Request: api.TransactionalUpsert{
+					Key:   "permanent",
+					Value: "bar",
+				},
+			},
+		},
+	}, false))
+
+	items := store.GetItems()
+	require.Contains(t, items, "myapp||mytype||myid||expiring")
+	require.Contains(t, items, "myapp||mytype||myid||permanent")
+	require.Contains(t, items, "myapp||mytype||myid||||dapr.ttl")
+
+	metadata := map[string]string{metadataPartitionKey: "myapp||mytype||myid"}
+	index, _, err := s.getTTLIndex(t.Context(), "store", store, "myapp||mytype||myid||||dapr.ttl", metadata)
+	require.NoError(t, err)
+	require.Len(t, index, 1)
+	assert.True(t, index["myapp||mytype||myid||expiring"].Equal(clock.Now().Add(10*time.Second)))
+
+	require.Len(t, reminders.created, 1)
+	assert.Equal(t, TTLReminderName, reminders.created[0].Name)
+	assert.Equal(t, "mytype", reminders.created[0].ActorType)
+	assert.Equal(t, "myid", reminders.created[0].ActorID)
+	dueTime, err := time.Parse(time.RFC3339, reminders.created[0].DueTime)
+	require.NoError(t, err)
+	assert.False(t, dueTime.Before(clock.Now().Add(10*time.Second)))
+
+	get := func(t *testing.T, k string) []byte {
+		t.Helper()
+		resp, err := s.Get(t.Context(), &api.GetStateRequest{ActorType: "mytype", ActorID: "myid", Key: k}, false)
+		require.NoError(t, err)
+		return resp.Data
+	}
+
+	t.Run("keys are not deleted before they expire", func(t *testing.T) {
+		require.NoError(t, s.DeleteExpired(t.Context(), "mytype", "myid"))
+		assert.Contains(t, store.GetItems(), "myapp||mytype||myid||expiring")
+		assert.NotEmpty(t, get(t, "expiring"))
+	})
+
+	t.Run("expired keys are not returned before they are deleted", func(t *testing.T) {
+		clock.Step(11 * time.Second)
+
+		assert.Contains(t, store.GetItems(), "myapp||mytype||myid||expiring")
+		assert.Empty(t, get(t, "expiring"))
+		assert.NotEmpty(t, get(t, "permanent"))
+
+		resp, err := s.GetBulk(t.Context(), &api.GetBulkStateRequest{
+			ActorType: "mytype",
+			ActorID:   "myid",
+			Keys:      []string{"expiring", "permanent"},
+		}, false)
+		require.NoError(t, err)
+		assert.Empty(t, resp["expiring"])
+		assert.NotEmpty(t, resp["permanent"])
+	})
+
+	t.Run("expired keys are deleted with the index entry", func(t *testing.T) {
+		require.NoError(t, s.DeleteExpired(t.Context(), "mytype", "myid"))
+
+		items := store.GetItems()
+		assert.NotContains(t, items, "myapp||mytype||myid||expiring")
+		assert.Contains(t, items, "myapp||mytype||myid||permanent")
+		assert.NotContains(t, items, "myapp||mytype||myid||||dapr.ttl")
+	})
+
+	t.Run("writing a key without TTL removes it from the index", func(t *testing.T) {
+		upsert := func(md map[string]string) {
+			require.NoError(t, s.TransactionalStateOperation(t.Context(), true, &api.TransactionalRequest{
+				ActorType: "mytype",
+				ActorID:   "myid",
+				Operations: []api.TransactionalOperation{{
+					Operation: api.Upsert,
+					Request: api.TransactionalUpsert{
+						Key:      "expiring",
+						Value:    "foo",
+						Metadata: md,
+					},
+				}},
+			}, false))
+		}
+
+		upsert(map[string]string{"ttlInSeconds": "10"})
+		require.Contains(t, store.GetItems(), "myapp||mytype||myid||||dapr.ttl")
+
+		upsert(nil)
+		assert.NotContains(t, store.GetItems(), "myapp||mytype||myid||||dapr.ttl")
+
+		clock.Step(11 * time.Second)
+		assert.NotEmpty(t, get(t, "expiring"))
+	})
+}
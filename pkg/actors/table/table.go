@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/api"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/actors/internal/key"
+	"github.com/dapr/dapr/pkg/actors/internal/lifecycle"
 	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
 	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/actors/targets/idler"
@@ -46,12 +47,14 @@ type Interface interface {
 
 	Types() []string
 	IsActorTypeHosted(actorType string) bool
+	TypeVersion(actorType string) (string, config.ActorVersionRouting)
 	HostedTarget(actorType, actorKey string) (targets.Interface, bool)
 	GetOrCreate(actorType, actorID string) (targets.Interface, bool, error)
 	RegisterActorTypes(opts RegisterActorTypeOptions)
 	UnRegisterActorTypes(actorTypes ...string) error
 	SubscribeToTypeUpdates(ctx context.Context) (<-chan []string, []string)
 	HaltAll() error
+	Halt(actorType, actorID string) error
 	HaltIdlable(ctx context.Context, target targets.Idlable) error
 	Drain(fn func(target targets.Interface) bool) error
 	Len() map[string]int
@@ -63,12 +66,18 @@ type Interface interface {
 type Options struct {
 	IdlerQueue      *queue.Processor[string, targets.Idlable]
 	ReentrancyStore *reentrancystore.Store
+	Lifecycle       *lifecycle.Lifecycle
 }
 
 type ActorTypeFactory struct {
 	Type       string
 	Reentrancy config.ReentrancyConfig
 	Factory    targets.Factory
+
+	// Version is the optional version of the actor type implementation, used
+	// for side-by-side deployments of different versions of an actor type.
+	Version        string
+	VersionRouting config.ActorVersionRouting
 }
 
 type ActorHostOptions struct {
@@ -84,6 +93,7 @@ type RegisterActorTypeOptions struct {
 
 type table struct {
 	factories   sync.Map
+	versions    sync.Map
 	table       sync.Map
 	typeUpdates *broadcaster.Broadcaster[[]string]
 
@@ -93,6 +103,7 @@ type table struct {
 	idlerQueue              *queue.Processor[string, targets.Idlable]
 
 	reentrancyStore *reentrancystore.Store
+	lifecycle       *lifecycle.Lifecycle
 
 	lock  sync.RWMutex
 	clock clock.Clock
@@ -107,6 +118,7 @@ func New(opts Options) Interface {
 		typeUpdates:             broadcaster.New[[]string](),
 		idlerQueue:              opts.IdlerQueue,
 		reentrancyStore:         opts.ReentrancyStore,
+		lifecycle:               opts.Lifecycle,
 	}
 }
 
@@ -144,7 +156,7 @@ func (t *table) Len() map[string]int {
 func (t *table) HaltAll() error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	return t.doHaltAll(false, func(targets.Interface) bool { return true })
+	return t.doHaltAll(false, lifecycle.ReasonHalted, func(targets.Interface) bool { return true })
 }
 
 // Drain will gracefully drain all actors in the table that match the given
@@ -152,10 +164,10 @@ func (t *table) HaltAll() error {
 func (t *table) Drain(fn func(target targets.Interface) bool) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	return t.doHaltAll(true, fn)
+	return t.doHaltAll(true, lifecycle.ReasonRebalance, fn)
 }
 
-func (t *table) doHaltAll(drain bool, fn func(target targets.Interface) bool) error {
+func (t *table) doHaltAll(drain bool, reason lifecycle.Reason, fn func(target targets.Interface) bool) error {
 	var (
 		wg   sync.WaitGroup
 		errs = slice.New[error]()
@@ -165,7 +177,7 @@ func (t *table) doHaltAll(drain bool, fn func(target targets.Interface) bool) er
 		go func(target targets.Interface) {
 			defer wg.Done()
 			if fn(target) {
-				errs.Append(t.haltSingle(target, drain))
+				errs.Append(t.haltSingle(target, drain, reason))
 			}
 		}(target.(targets.Interface))
 		return true
@@ -181,6 +193,18 @@ func (t *table) IsActorTypeHosted(actorType string) bool {
 	return ok
 }
 
+// TypeVersion returns the version and version routing policy of the given
+// hosted actor type. Returns an empty version if the actor type is not
+// versioned.
+func (t *table) TypeVersion(actorType string) (string, config.ActorVersionRouting) {
+	v, ok := t.versions.Load(actorType)
+	if !ok {
+		return "", ""
+	}
+	factory := v.(ActorTypeFactory)
+	return factory.Version, factory.VersionRouting
+}
+
 func (t *table) HostedTarget(actorType, actorID string) (targets.Interface, bool) {
 	v, ok := t.table.Load(key.ConstructComposite(actorType, actorID))
 	if ok {
@@ -212,6 +236,8 @@ func (t *table) GetOrCreate(actorType, actorID string) (targets.Interface, bool,
 		// simply deactivate the one we made and push the struct on the cache pool
 		// via this Deactivate.
 		target.Deactivate(context.Background())
+	} else {
+		t.lifecycle.Activated(actorType, actorID)
 	}
 
 	return got.(targets.Interface), true, nil
@@ -230,6 +256,11 @@ func (t *table) RegisterActorTypes(opts RegisterActorTypeOptions) {
 	for _, opt := range opts.Factories {
 		t.reentrancyStore.Store(opt.Type, opt.Reentrancy)
 		t.factories.Store(opt.Type, opt.Factory)
+		if len(opt.Version) > 0 {
+			t.versions.Store(opt.Type, opt)
+		} else {
+			t.versions.Delete(opt.Type)
+		}
 	}
 
 	t.typeUpdates.Broadcast(t.Types())
@@ -241,10 +272,11 @@ func (t *table) UnRegisterActorTypes(actorTypes ...string) error {
 
 	for _, atype := range actorTypes {
 		t.factories.Delete(atype)
+		t.versions.Delete(atype)
 		t.reentrancyStore.Delete(atype)
 	}
 
-	err := t.doHaltAll(false, func(target targets.Interface) bool {
+	err := t.doHaltAll(false, lifecycle.ReasonUnregistered, func(target targets.Interface) bool {
 		return slices.Contains(actorTypes, target.Type())
 	})
 
@@ -253,8 +285,18 @@ func (t *table) UnRegisterActorTypes(actorTypes ...string) error {
 	return err
 }
 
+// Halt gracefully deactivates the given actor if it is active, respecting the
+// drainRebalancedActors configuration.
+func (t *table) Halt(actorType, actorID string) error {
+	target, ok := t.HostedTarget(actorType, actorID)
+	if !ok {
+		return nil
+	}
+	return t.haltSingle(target, true, lifecycle.ReasonMigrated)
+}
+
 func (t *table) HaltIdlable(ctx context.Context, target targets.Idlable) error {
-	return t.haltSingle(target, false)
+	return t.haltSingle(target, false, lifecycle.ReasonIdleTimeout)
 }
 
 func (t *table) DeleteFromTableIn(actor targets.Interface, in time.Duration) {
@@ -273,7 +315,7 @@ func (t *table) SubscribeToTypeUpdates(ctx context.Context) (<-chan []string, []
 	return ch, t.Types()
 }
 
-func (t *table) haltSingle(target targets.Interface, drain bool) error {
+func (t *table) haltSingle(target targets.Interface, drain bool, reason lifecycle.Reason) error {
 	key := target.Key()
 
 	if drain {
@@ -303,5 +345,7 @@ func (t *table) haltSingle(target targets.Interface, drain bool) error {
 		cancel()
 	}
 
+	defer t.lifecycle.Deactivated(target.Type(), target.ID(), reason)
+
 	return got.(targets.Interface).Deactivate(ctx)
 }
@@ -15,16 +15,22 @@ package table_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
+	"github.com/dapr/dapr/pkg/actors/internal/lifecycle"
 	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/actors/targets/fake"
+	"github.com/dapr/dapr/pkg/config"
+	pubsubfake "github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
 	"github.com/dapr/kit/concurrency/slice"
 	"github.com/dapr/kit/events/queue"
 )
@@ -128,3 +134,153 @@ func Test_GetOrCreate_NotRegistered(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, actorerrors.ErrCreatingActor)
 }
+
+func Test_LifecycleEvents(t *testing.T) {
+	queue := queue.NewProcessor[string, targets.Idlable](queue.Options[string, targets.Idlable]{})
+
+	reqCh := make(chan *contribpubsub.PublishRequest, 10)
+	lc := lifecycle.New(lifecycle.Options{
+		AppID: "myapp",
+		Host:  "localhost:50002",
+		Spec: &config.ActorLifecycleEventsSpec{
+			PubsubName: "mypubsub",
+			Topic:      "actor-events",
+		},
+		Publisher: pubsubfake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			reqCh <- req
+			return nil
+		}),
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- lc.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	tble := table.New(table.Options{
+		IdlerQueue:      queue,
+		ReentrancyStore: reentrancystore.New(),
+		Lifecycle:       lc,
+	})
+
+	tble.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{
+			{Type: "test1", Factory: fake.New("test1")},
+			{Type: "test2", Factory: fake.New("test2")},
+		},
+	})
+
+	recv := func(t *testing.T) lifecycle.Event {
+		t.Helper()
+		select {
+		case req := <-reqCh:
+			var ce struct {
+				Data lifecycle.Event `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(req.Data, &ce))
+			return ce.Data
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timed out waiting for event")
+			return lifecycle.Event{}
+		}
+	}
+
+	_, created, err := tble.GetOrCreate("test1", "1")
+	require.NoError(t, err)
+	assert.True(t, created)
+	ev := recv(t)
+	assert.Equal(t, lifecycle.EventTypeActivated, ev.Type)
+	assert.Equal(t, "test1", ev.ActorType)
+	assert.Equal(t, "1", ev.ActorID)
+
+	_, created, err = tble.GetOrCreate("test1", "1")
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	_, _, err = tble.GetOrCreate("test2", "1")
+	require.NoError(t, err)
+	assert.Equal(t, lifecycle.EventTypeActivated, recv(t).Type)
+
+	require.NoError(t, tble.Drain(func(target targets.Interface) bool {
+		return target.Type() == "test1"
+	}))
+	ev = recv(t)
+	assert.Equal(t, lifecycle.EventTypeDeactivated, ev.Type)
+	assert.Equal(t, lifecycle.ReasonRebalance, ev.Reason)
+	assert.Equal(t, "test1", ev.ActorType)
+	assert.NotEmpty(t, ev.Lifetime)
+
+	require.NoError(t, tble.UnRegisterActorTypes("test2"))
+	ev = recv(t)
+	assert.Equal(t, lifecycle.EventTypeDeactivated, ev.Type)
+	assert.Equal(t, lifecycle.ReasonUnregistered, ev.Reason)
+	assert.Equal(t, "test2", ev.ActorType)
+}
+
+func Test_TypeVersion(t *testing.T) {
+	queue := queue.NewProcessor[string, targets.Idlable](queue.Options[string, targets.Idlable]{})
+
+	tble := table.New(table.Options{
+		IdlerQueue:      queue,
+		ReentrancyStore: reentrancystore.New(),
+	})
+
+	tble.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{
+			{Type: "test1", Factory: fake.New("test1")},
+			{
+				Type:           "test2",
+				Factory:        fake.New("test2"),
+				Version:        "v2",
+				VersionRouting: config.ActorVersionRoutingPinned,
+			},
+		},
+	})
+
+	version, routing := tble.TypeVersion("test1")
+	assert.Empty(t, version)
+	assert.Empty(t, routing)
+
+	version, routing = tble.TypeVersion("test2")
+	assert.Equal(t, "v2", version)
+	assert.Equal(t, config.ActorVersionRoutingPinned, routing)
+
+	require.NoError(t, tble.UnRegisterActorTypes("test2"))
+	version, _ = tble.TypeVersion("test2")
+	assert.Empty(t, version)
+}
+
+func Test_Halt(t *testing.T) {
+	tble := table.New(table.Options{
+		IdlerQueue:      queue.NewProcessor[string, targets.Idlable](queue.Options[string, targets.Idlable]{}),
+		ReentrancyStore: reentrancystore.New(),
+	})
+
+	deactivations := slice.String()
+	tble.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{
+			{
+				Type: "test1",
+				Factory: fake.New("test1", func(f *fake.Fake) {
+					f.WithDeactivate(func(context.Context) error {
+						deactivations.Append(f.Key())
+						return nil
+					})
+				}),
+			},
+		},
+	})
+
+	_, _, err := tble.GetOrCreate("test1", "1")
+	require.NoError(t, err)
+	_, _, err = tble.GetOrCreate("test1", "2")
+	require.NoError(t, err)
+
+	require.NoError(t, tble.Halt("test1", "1"))
+	require.NoError(t, tble.Halt("test1", "3"))
+	assert.Equal(t, []string{"test1||1"}, deactivations.Slice())
+	assert.Equal(t, map[string]int{"test1": 1}, tble.Len())
+}
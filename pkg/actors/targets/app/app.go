@@ -95,6 +95,7 @@ func Factory(opts Options) targets.Factory {
 			clock:       opts.clock,
 			lock: lock.New(lock.Options{
 				ActorType:   opts.ActorType,
+				ActorID:     actorID,
 				ConfigStore: opts.Reentrancy,
 			}),
 		}
@@ -131,6 +132,10 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 		msg.Method = originalMethod
 	}()
 
+	// Add this actor to the call chain sent to the app, so that calls back into
+	// this actor can be detected as deadlocks.
+	defer lock.PushCallChain(req, a.actorType, a.actorID)()
+
 	// Per API contract, actor invocations over HTTP always use PUT as request method
 	if msg.GetHttpExtension() == nil {
 		req.WithHTTPExtension(http.MethodPut, "")
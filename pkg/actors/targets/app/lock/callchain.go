@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/actors/callchain"
+	"github.com/dapr/dapr/pkg/actors/internal/key"
+	"github.com/dapr/dapr/pkg/messages"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// callChain returns the actor keys of the call chain of the request.
+func callChain(req *internalv1pb.InternalInvokeRequest) []string {
+	vals := callchain.Get(req.GetMetadata())
+
+	var chain []string
+	for _, val := range vals {
+		for _, entry := range strings.Split(val, ",") {
+			actorType, actorID, ok := strings.Cut(strings.TrimSpace(entry), "/")
+			if !ok {
+				continue
+			}
+			actorType, err := url.QueryUnescape(actorType)
+			if err != nil {
+				continue
+			}
+			actorID, err = url.QueryUnescape(actorID)
+			if err != nil {
+				continue
+			}
+			chain = append(chain, key.ConstructComposite(actorType, actorID))
+		}
+	}
+
+	return chain
+}
+
+// checkCallChain returns an error if the actor of this lock is already part of
+// the call chain of the request and reentrancy is disabled for the actor type.
+// Such a request can never acquire the lock, since the lock is held by the
+// actor further up the chain which waits on this request.
+func (l *Lock) checkCallChain(req *internalv1pb.InternalInvokeRequest) error {
+	if l.reentrancyEnabled || req == nil || len(l.actorID) == 0 {
+		return nil
+	}
+
+	chain := callChain(req)
+	self := key.ConstructComposite(l.actorType, l.actorID)
+	for _, actorKey := range chain {
+		if actorKey == self {
+			return messages.ErrActorDeadlock.WithFormat(self, strings.Join(append(chain, self), " -> "))
+		}
+	}
+
+	return nil
+}
+
+// PushCallChain appends the given actor to the call chain of the request. The
+// returned function restores the call chain of the request to its previous
+// value, so that the request can be retried.
+func PushCallChain(req *internalv1pb.InternalInvokeRequest, actorType, actorID string) func() {
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue)
+	}
+
+	lowerKey := strings.ToLower(callchain.Header)
+	prev, prevLower := req.Metadata[callchain.Header], req.Metadata[lowerKey]
+
+	var vals []string
+	vals = append(vals, prev.GetValues()...)
+	vals = append(vals, prevLower.GetValues()...)
+	vals = append(vals, url.QueryEscape(actorType)+"/"+url.QueryEscape(actorID))
+
+	delete(req.Metadata, lowerKey)
+	req.Metadata[callchain.Header] = &internalv1pb.ListStringValue{
+		Values: []string{strings.Join(vals, ",")},
+	}
+
+	return func() {
+		delete(req.Metadata, callchain.Header)
+		if prev != nil {
+			req.Metadata[callchain.Header] = prev
+		}
+		if prevLower != nil {
+			req.Metadata[lowerKey] = prevLower
+		}
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/callchain"
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messages"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func Test_PushCallChain(t *testing.T) {
+	t.Parallel()
+
+	req := internalv1pb.NewInternalInvokeRequest("foo")
+	assert.Empty(t, callChain(req))
+
+	restoreA := PushCallChain(req, "typeA", "id,A")
+	assert.Equal(t, []string{"typeA||id,A"}, callChain(req))
+
+	restoreB := PushCallChain(req, "type/B", "idB")
+	assert.Equal(t, []string{"typeA||id,A", "type/B||idB"}, callChain(req))
+	assert.Equal(t, []string{"typeA/id%2CA,type%2FB/idB"}, req.GetMetadata()[callchain.Header].GetValues())
+
+	restoreB()
+	assert.Equal(t, []string{"typeA||id,A"}, callChain(req))
+	restoreA()
+	assert.Empty(t, callChain(req))
+	assert.NotContains(t, req.GetMetadata(), callchain.Header)
+
+	t.Run("lower case gRPC metadata key", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("foo")
+		req.Metadata = map[string]*internalv1pb.ListStringValue{
+			"dapr-actor-call-chain": {Values: []string{"typeA/idA"}},
+		}
+		assert.Equal(t, []string{"typeA||idA"}, callChain(req))
+
+		restore := PushCallChain(req, "typeB", "idB")
+		assert.Equal(t, []string{"typeA||idA", "typeB||idB"}, callChain(req))
+		restore()
+		assert.Equal(t, []string{"typeA||idA"}, callChain(req))
+	})
+}
+
+func Test_CallChainDeadlock(t *testing.T) {
+	t.Parallel()
+
+	req := internalv1pb.NewInternalInvokeRequest("foo")
+	PushCallChain(req, "typeA", "idA")
+	PushCallChain(req, "typeB", "idB")
+
+	t.Run("actor in call chain is rejected", func(t *testing.T) {
+		l := New(Options{
+			ActorType:   "typeA",
+			ActorID:     "idA",
+			ConfigStore: reentrancystore.New(),
+		})
+		_, _, err := l.LockRequest(t.Context(), req)
+		require.ErrorIs(t, err, messages.ErrActorDeadlock)
+		assert.Contains(t, err.Error(), "typeA||idA -> typeB||idB -> typeA||idA")
+	})
+
+	t.Run("actor not in call chain is accepted", func(t *testing.T) {
+		l := New(Options{
+			ActorType:   "typeA",
+			ActorID:     "idC",
+			ConfigStore: reentrancystore.New(),
+		})
+		_, cancel, err := l.LockRequest(t.Context(), req)
+		require.NoError(t, err)
+		cancel()
+	})
+
+	t.Run("reentrant actor in call chain is accepted", func(t *testing.T) {
+		store := reentrancystore.New()
+		store.Store("typeA", config.ReentrancyConfig{Enabled: true})
+		l := New(Options{
+			ActorType:   "typeA",
+			ActorID:     "idA",
+			ConfigStore: store,
+		})
+		_, cancel, err := l.LockRequest(t.Context(), req)
+		require.NoError(t, err)
+		cancel()
+	})
+}
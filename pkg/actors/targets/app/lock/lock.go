@@ -43,6 +43,7 @@ var (
 
 type Options struct {
 	ActorType   string
+	ActorID     string
 	ConfigStore *reentrancystore.Store
 }
 
@@ -56,6 +57,7 @@ type Lock struct {
 	reentrancyEnabled bool
 	maxStackDepth     int
 	actorType         string
+	actorID           string
 
 	inflights *ring.Buffered[inflight]
 	lock      chan struct{}
@@ -79,6 +81,7 @@ func New(opts Options) *Lock {
 	if l == nil {
 		return &Lock{
 			actorType:         opts.ActorType,
+			actorID:           opts.ActorID,
 			reentrancyEnabled: reentrancyEnabled,
 			maxStackDepth:     maxStackDepth,
 			inflights:         ring.NewBuffered[inflight](2, 8),
@@ -88,6 +91,7 @@ func New(opts Options) *Lock {
 	}
 
 	l.actorType = opts.ActorType
+	l.actorID = opts.ActorID
 	l.maxStackDepth = maxStackDepth
 	l.reentrancyEnabled = reentrancyEnabled
 	l.closeCh = make(chan struct{})
@@ -103,6 +107,12 @@ func (l *Lock) Lock(ctx context.Context) (context.Context, context.CancelFunc, e
 }
 
 func (l *Lock) LockRequest(ctx context.Context, msg *internalv1pb.InternalInvokeRequest) (context.Context, context.CancelFunc, error) {
+	// Reject requests which would deadlock immediately rather than waiting for
+	// them to time out.
+	if err := l.checkCallChain(msg); err != nil {
+		return nil, nil, err
+	}
+
 	diag.DefaultMonitoring.ReportActorPendingCalls(l.actorType, 1)
 	defer diag.DefaultMonitoring.ReportActorPendingCalls(l.actorType, -1)
 
@@ -27,6 +27,8 @@ import (
 	"github.com/dapr/dapr/pkg/actors/table"
 	"github.com/dapr/dapr/pkg/actors/targets"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/kit/logger"
 )
@@ -54,10 +56,15 @@ type activity struct {
 	reminders reminders.Interface
 
 	scheduler          todo.ActivityScheduler
+	limiter            *limiter.Limiter
+	payloads           *payload.Store
 	reminderInterval   time.Duration
 	schedulerReminders bool
 
 	lock chan struct{}
+
+	runLock     sync.Mutex
+	cancelRunFn context.CancelCauseFunc
 }
 
 type Options struct {
@@ -68,6 +75,14 @@ type Options struct {
 	Scheduler          todo.ActivityScheduler
 	Actors             actors.Interface
 	SchedulerReminders bool
+
+	// Limiter enforces the limits of the invocations of activities.
+	// Invocations are not limited if nil.
+	Limiter *limiter.Limiter
+
+	// Payloads offloads the large inputs of activities from their reminders.
+	// Payloads are not offloaded if nil.
+	Payloads *payload.Store
 }
 
 func Factory(ctx context.Context, opts Options) (targets.Factory, error) {
@@ -111,6 +126,8 @@ func Factory(ctx context.Context, opts Options) (targets.Factory, error) {
 				state:              state,
 				reminders:          reminders,
 				scheduler:          opts.Scheduler,
+				limiter:            opts.Limiter,
+				payloads:           opts.Payloads,
 				schedulerReminders: opts.SchedulerReminders,
 				lock:               make(chan struct{}, 1),
 			}
@@ -128,6 +145,12 @@ func Factory(ctx context.Context, opts Options) (targets.Factory, error) {
 // returns immediately after creating the reminder, enabling the workflow to continue processing other events
 // in parallel.
 func (a *activity) InvokeMethod(ctx context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	// Heartbeats are received while the activity is running, which holds the
+	// lock.
+	if req.GetMessage().GetMethod() == todo.ActivityHeartbeatMethod {
+		return nil, a.handleHeartbeat(ctx, req)
+	}
+
 	select {
 	case a.lock <- struct{}{}:
 	case <-ctx.Done():
@@ -139,6 +162,11 @@ func (a *activity) InvokeMethod(ctx context.Context, req *internalsv1pb.Internal
 
 // InvokeReminder implements actors.InternalActor and executes the activity logic.
 func (a *activity) InvokeReminder(ctx context.Context, reminder *actorapi.Reminder) error {
+	// Timeouts abort the running activity, which holds the lock.
+	if isTimeoutReminder(reminder.Name) {
+		return a.handleTimeoutReminder(ctx, reminder)
+	}
+
 	if !reminder.SkipLock {
 		select {
 		case a.lock <- struct{}{}:
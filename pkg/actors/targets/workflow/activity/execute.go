@@ -26,6 +26,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	wferrors "github.com/dapr/dapr/pkg/runtime/wfengine/errors"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/backend"
@@ -59,10 +60,20 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 	//       introduce some kind of heartbeat protocol to help identify such cases.
 	callback := make(chan bool, 1)
 	wi.Properties[todo.CallbackChannelProperty] = callback
+
+	// Activities beyond the limits of their name wait here, holding the
+	// reminder, until they are allowed to run. If the context is done first,
+	// the reminder is retried.
+	release, err := a.limiter.Acquire(ctx, limiter.KindActivity, activityName)
+	if err != nil {
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("waiting for the limits of activity '%s': %w", activityName, err))
+	}
+	defer release()
+
 	log.Debugf("Activity actor '%s': scheduling activity '%s' for workflow with instanceId '%s'", a.actorID, name, wi.InstanceID)
 	elapsed := float64(0)
 	start := time.Now()
-	err := a.scheduler(ctx, wi)
+	err = a.scheduler(ctx, wi)
 	elapsed = diag.ElapsedSince(start)
 
 	if errors.Is(err, context.DeadlineExceeded) {
@@ -111,7 +122,7 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 
 	req := internalsv1pb.
 		NewInternalInvokeRequest(todo.AddWorkflowEventMethod).
-		WithActor(a.workflowActorType, workflowID).
+		WithActor(a.workflowActorTypeFor(taskEvent), workflowID).
 		WithData(resultData).
 		WithContentType(invokev1.ProtobufContentType)
 
@@ -130,3 +141,14 @@ func (a *activity) executeActivity(ctx context.Context, name string, taskEvent *
 	}
 	return todo.RunCompletedTrue, nil
 }
+
+// workflowActorTypeFor returns the type of the workflow actor which the result
+// of the activity of the given task scheduled event is reported to. Activities
+// scheduled by a workflow of another app report their result to the workflow
+// actor of that app.
+func (a *activity) workflowActorTypeFor(e *backend.HistoryEvent) string {
+	if source := e.GetRouter().GetSource(); len(source) > 0 && source != a.appID {
+		return todo.ActorTypeForApp(a.workflowActorType, source)
+	}
+	return a.workflowActorType
+}
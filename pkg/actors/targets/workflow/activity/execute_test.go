@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestWorkflowActorTypeFor(t *testing.T) {
+	a := &activity{
+		appID:             "app1",
+		workflowActorType: "dapr.internal.default.app1.workflow",
+	}
+
+	t.Run("activities of local workflows report to the local app", func(t *testing.T) {
+		assert.Equal(t, "dapr.internal.default.app1.workflow", a.workflowActorTypeFor(&backend.HistoryEvent{}))
+		assert.Equal(t, "dapr.internal.default.app1.workflow", a.workflowActorTypeFor(&backend.HistoryEvent{
+			Router: &protos.TaskRouter{Source: "app1", Target: ptr.Of("app1")},
+		}))
+	})
+
+	t.Run("activities of workflows of another app report to that app", func(t *testing.T) {
+		assert.Equal(t, "dapr.internal.default.app2.workflow", a.workflowActorTypeFor(&backend.HistoryEvent{
+			Router: &protos.TaskRouter{Source: "app2", Target: ptr.Of("app1")},
+		}))
+	})
+}
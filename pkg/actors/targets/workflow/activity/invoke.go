@@ -25,6 +25,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	wferrors "github.com/dapr/dapr/pkg/runtime/wfengine/errors"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/durabletask-go/backend"
 )
@@ -50,7 +51,11 @@ func (a *activity) handleInvoke(ctx context.Context, req *internalsv1pb.Internal
 	}
 
 	// The actual execution is triggered by a reminder
-	return nil, a.createReminder(ctx, &his)
+	if err = a.createReminder(ctx, &his); err != nil {
+		return nil, err
+	}
+
+	return nil, a.scheduleTimeouts(ctx, &his, imReq.Metadata())
 }
 
 func (a *activity) handleReminder(ctx context.Context, reminder *actorapi.Reminder) error {
@@ -61,7 +66,42 @@ func (a *activity) handleReminder(ctx context.Context, reminder *actorapi.Remind
 		return fmt.Errorf("failed to decode activity reminder: %w", err)
 	}
 
+	if err := a.payloads.Resolve(ctx, &state); err != nil {
+		// The payload is deleted along with the history of the workflow, so
+		// the workflow execution which scheduled the activity is gone.
+		if errors.Is(err, payload.ErrNotFound) {
+			log.Warnf("%s: dropping activity '%s' of a workflow execution which no longer exists: %v", a.actorID, reminder.Name, err)
+			a.table.DeleteFromTableIn(a, 0)
+			if a.schedulerReminders {
+				return nil
+			}
+			return actorerrors.ErrReminderCanceled
+		}
+		log.Warnf("%s: failed to load the input of activity '%s', will be retried later: %v", a.actorID, reminder.Name, err)
+		if a.schedulerReminders {
+			return err
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	a.setCancelRun(cancel)
+	defer func() {
+		a.setCancelRun(nil)
+		cancel(nil)
+	}()
+
 	completed, err := a.executeActivity(ctx, reminder.Name, &state)
+
+	// The activity was failed by one of its timeouts.
+	if errors.Is(context.Cause(ctx), errTimedOut) {
+		log.Debugf("%s: execution of '%s' was aborted as the activity timed out", a.actorID, reminder.Name)
+		if a.schedulerReminders {
+			return nil
+		}
+		return actorerrors.ErrReminderCanceled
+	}
+
 	if completed == todo.RunCompletedTrue {
 		a.table.DeleteFromTableIn(a, 0)
 	}
@@ -15,6 +15,7 @@ package activity
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -22,9 +23,11 @@ import (
 	"github.com/dapr/durabletask-go/backend"
 )
 
+// runReminderName is the name of the reminder which executes the activity.
+const runReminderName = "run-activity"
+
 func (a *activity) createReminder(ctx context.Context, his *backend.HistoryEvent) error {
-	const reminderName = "run-activity"
-	log.Debugf("Activity actor '%s||%s': creating reminder '%s' for immediate execution", a.actorType, a.actorID, reminderName)
+	log.Debugf("Activity actor '%s||%s': creating reminder '%s' for immediate execution", a.actorType, a.actorID, runReminderName)
 
 	var period string
 	var oneshot bool
@@ -34,6 +37,14 @@ func (a *activity) createReminder(ctx context.Context, his *backend.HistoryEvent
 		period = a.reminderInterval.String()
 	}
 
+	// The input of the activity is offloaded under the key of the workflow
+	// which scheduled it, so that it is the payload referenced by the history
+	// of the workflow, and is deleted along with it.
+	his, err := a.payloads.Offload(ctx, a.workflowID(), his)
+	if err != nil {
+		return err
+	}
+
 	anydata, err := anypb.New(his)
 	if err != nil {
 		return err
@@ -43,9 +54,16 @@ func (a *activity) createReminder(ctx context.Context, his *backend.HistoryEvent
 		ActorType: a.actorType,
 		ActorID:   a.actorID,
 		DueTime:   "0s",
-		Name:      reminderName,
+		Name:      runReminderName,
 		Period:    period,
 		IsOneShot: oneshot,
 		Data:      anydata,
 	})
 }
+
+// workflowID returns the instance ID of the workflow of the activity, which
+// prefixes the activity actor ID.
+func (a *activity) workflowID() string {
+	id, _, _ := strings.Cut(a.actorID, "::")
+	return id
+}
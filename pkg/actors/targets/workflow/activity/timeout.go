@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+const (
+	// ErrorTypeActivityTimeout is the error type of the failure details of an
+	// activity which was failed for exceeding its start to close timeout.
+	ErrorTypeActivityTimeout = "DaprActivityTimeout"
+	// ErrorTypeActivityHeartbeatTimeout is the error type of the failure
+	// details of an activity which was failed for missing a heartbeat.
+	ErrorTypeActivityHeartbeatTimeout = "DaprActivityHeartbeatTimeout"
+
+	timeoutReminderName   = "timeout"
+	heartbeatReminderName = "heartbeat"
+)
+
+var errTimedOut = errors.New("activity timed out")
+
+// isTimeoutReminder returns true if the reminder of the given name fails the
+// activity for exceeding one of its timeouts.
+func isTimeoutReminder(name string) bool {
+	return name == timeoutReminderName || name == heartbeatReminderName
+}
+
+// scheduleTimeouts creates the reminders which fail the activity once its
+// start to close timeout is exceeded or its heartbeat lapses. The timeouts are
+// passed by the workflow in the metadata of the invocation.
+func (a *activity) scheduleTimeouts(ctx context.Context, taskEvent *backend.HistoryEvent, md invokev1.DaprInternalMetadata) error {
+	for _, t := range []struct {
+		name      string
+		key       string
+		errorType string
+		desc      string
+	}{
+		{timeoutReminderName, todo.ActivityStartToCloseTimeoutMetadata, ErrorTypeActivityTimeout, "start to close timeout"},
+		{heartbeatReminderName, todo.ActivityHeartbeatTimeoutMetadata, ErrorTypeActivityHeartbeatTimeout, "heartbeat timeout"},
+	} {
+		values := md[t.key].GetValues()
+		if len(values) == 0 {
+			continue
+		}
+		timeout, err := time.ParseDuration(values[0])
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid activity %s '%s'", t.desc, values[0])
+		}
+
+		// The reminder holds the failure which is reported to the workflow,
+		// routed back to the app of the workflow.
+		failure := &backend.HistoryEvent{
+			EventId: -1,
+			Router:  taskEvent.GetRouter(),
+			EventType: &protos.HistoryEvent_TaskFailed{
+				TaskFailed: &protos.TaskFailedEvent{
+					TaskScheduledId: taskEvent.GetEventId(),
+					FailureDetails: &protos.TaskFailureDetails{
+						ErrorType:    t.errorType,
+						ErrorMessage: fmt.Sprintf("activity exceeded its %s of %s", t.desc, timeout),
+					},
+				},
+			},
+		}
+		if err = a.createTimeoutReminder(ctx, t.name, failure, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *activity) createTimeoutReminder(ctx context.Context, name string, failure *backend.HistoryEvent, timeout time.Duration) error {
+	anydata, err := anypb.New(failure)
+	if err != nil {
+		return err
+	}
+	return a.createTimeoutReminderWithData(ctx, name, anydata, timeout)
+}
+
+func (a *activity) createTimeoutReminderWithData(ctx context.Context, name string, data *anypb.Any, timeout time.Duration) error {
+	var period string
+	var oneshot bool
+	if a.schedulerReminders {
+		oneshot = true
+	} else {
+		period = a.reminderInterval.String()
+	}
+
+	return a.reminders.Create(ctx, &actorapi.CreateReminderRequest{
+		ActorType: a.actorType,
+		ActorID:   a.actorID,
+		DueTime:   timeout.String(),
+		Name:      name,
+		Period:    period,
+		IsOneShot: oneshot,
+		Data:      data,
+	})
+}
+
+// handleHeartbeat records the liveness of the running activity by pushing back
+// the due time of its heartbeat reminder.
+func (a *activity) handleHeartbeat(ctx context.Context, req *internalsv1pb.InternalInvokeRequest) error {
+	var timeout durationpb.Duration
+	if err := proto.Unmarshal(req.GetMessage().GetData().GetValue(), &timeout); err != nil {
+		return fmt.Errorf("failed to decode activity heartbeat: %w", err)
+	}
+
+	reminder, err := a.reminders.Get(ctx, &actorapi.GetReminderRequest{
+		ActorType: a.actorType,
+		ActorID:   a.actorID,
+		Name:      heartbeatReminderName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get heartbeat reminder of activity '%s': %w", a.actorID, err)
+	}
+	if reminder == nil || reminder.Data == nil {
+		return fmt.Errorf("activity '%s' is not running", a.actorID)
+	}
+
+	return a.createTimeoutReminderWithData(ctx, heartbeatReminderName, reminder.Data, timeout.AsDuration())
+}
+
+// handleTimeoutReminder fails the activity for exceeding one of its timeouts.
+// The execution of the activity is aborted and its failure is reported to the
+// workflow, whose retry policy applies.
+func (a *activity) handleTimeoutReminder(ctx context.Context, reminder *actorapi.Reminder) error {
+	var failure backend.HistoryEvent
+	if err := reminder.Data.UnmarshalTo(&failure); err != nil {
+		log.Errorf("Activity actor '%s': failed to decode timeout reminder: %v", a.actorID, err)
+		return actorerrors.ErrReminderCanceled
+	}
+
+	// Timeout reminders are left behind by completed activities.
+	run, err := a.reminders.Get(ctx, &actorapi.GetReminderRequest{
+		ActorType: a.actorType,
+		ActorID:   a.actorID,
+		Name:      runReminderName,
+	})
+	if err != nil {
+		log.Warnf("Activity actor '%s': failed to get reminder '%s' and will be retried later: %v", a.actorID, runReminderName, err)
+		if a.schedulerReminders {
+			return err
+		}
+		return nil
+	}
+	if run == nil || run.Data == nil {
+		log.Debugf("Activity actor '%s': ignoring timeout reminder '%s' of completed activity", a.actorID, reminder.Name)
+		if a.schedulerReminders {
+			return nil
+		}
+		return actorerrors.ErrReminderCanceled
+	}
+
+	log.Infof("Activity actor '%s': activity timed out and is being failed: %s", a.actorID, failure.GetTaskFailed().GetFailureDetails().GetErrorMessage())
+
+	workflowActorType := a.workflowActorTypeFor(&failure)
+	failure.Router = nil
+	failure.Timestamp = timestamppb.Now()
+
+	data, err := proto.Marshal(&failure)
+	if err != nil {
+		return err
+	}
+
+	endIndex := strings.Index(a.actorID, "::")
+	if endIndex < 0 {
+		return fmt.Errorf("invalid activity actor ID: '%s'", a.actorID)
+	}
+
+	if _, err = a.router.Call(ctx, internalsv1pb.
+		NewInternalInvokeRequest(todo.AddWorkflowEventMethod).
+		WithActor(workflowActorType, a.actorID[:endIndex]).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType),
+	); err != nil {
+		log.Warnf("Activity actor '%s': failed to report timeout and will be retried later: %v", a.actorID, err)
+		if a.schedulerReminders {
+			return err
+		}
+		return nil
+	}
+
+	// Stop the execution of the activity. The workflow ignores the result of
+	// an activity which already failed.
+	if err = a.deleteReminder(ctx, runReminderName); err != nil {
+		log.Warnf("Activity actor '%s': failed to delete reminder '%s': %v", a.actorID, runReminderName, err)
+	}
+	a.cancelRun(errTimedOut)
+
+	// The other timeout of the activity no longer applies.
+	other := timeoutReminderName
+	if reminder.Name == timeoutReminderName {
+		other = heartbeatReminderName
+	}
+	if err = a.deleteReminder(ctx, other); err != nil {
+		log.Warnf("Activity actor '%s': failed to delete reminder '%s': %v", a.actorID, other, err)
+	}
+
+	a.table.DeleteFromTableIn(a, 0)
+
+	if a.schedulerReminders {
+		return nil
+	}
+	return actorerrors.ErrReminderCanceled
+}
+
+func (a *activity) deleteReminder(ctx context.Context, name string) error {
+	return a.reminders.Delete(ctx, &actorapi.DeleteReminderRequest{
+		ActorType: a.actorType,
+		ActorID:   a.actorID,
+		Name:      name,
+	})
+}
+
+// setCancelRun sets the function which aborts the running execution of the
+// activity.
+func (a *activity) setCancelRun(cancel context.CancelCauseFunc) {
+	a.runLock.Lock()
+	defer a.runLock.Unlock()
+	a.cancelRunFn = cancel
+}
+
+func (a *activity) cancelRun(cause error) {
+	a.runLock.Lock()
+	defer a.runLock.Unlock()
+	if a.cancelRunFn != nil {
+		a.cancelRunFn(cause)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package limiter implements the limiter actor, which enforces a limit of the
+// invocations of workflows or activities across all the Dapr instances of an
+// app. The ID of the actor is the key of the limit. Since an actor is only
+// active in a single Dapr instance, the leases and the rate of the limit are
+// held in memory; they are reset if the actor is moved to another instance.
+package limiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/clock"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/targets"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wflimiter "github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/kit/logger"
+)
+
+// retryInterval is the duration after which callers retry to acquire a lease
+// when the maximum number of concurrent invocations is reached.
+const retryInterval = time.Millisecond * 250
+
+var log = logger.NewLogger("dapr.runtime.actors.targets.limiter")
+
+type Options struct {
+	ActorType string
+	Limits    []wflimiter.Limit
+	Clock     clock.Clock
+}
+
+type limiter struct {
+	actorType string
+	actorID   string
+	clock     clock.Clock
+
+	maxConcurrent int
+	rate          *rate.Limiter
+
+	lock   sync.Mutex
+	leases map[string]time.Time
+}
+
+func Factory(opts Options) targets.Factory {
+	limits := make(map[string]wflimiter.Limit, len(opts.Limits))
+	for _, l := range opts.Limits {
+		if l.Scope == wflimiter.ScopeCluster {
+			limits[l.Key()] = l
+		}
+	}
+
+	clk := opts.Clock
+	if clk == nil {
+		clk = &clock.RealClock{}
+	}
+
+	return func(actorID string) targets.Interface {
+		l := &limiter{
+			actorType: opts.ActorType,
+			actorID:   actorID,
+			clock:     clk,
+			leases:    make(map[string]time.Time),
+		}
+
+		// Limits unknown to this instance, due to a configuration mismatch
+		// between the instances of the app, are not enforced.
+		if limit, ok := limits[actorID]; ok {
+			l.maxConcurrent = limit.MaxConcurrent
+			if limit.MaxPerSecond > 0 {
+				l.rate = wflimiter.NewRate(limit.MaxPerSecond)
+			}
+		} else {
+			log.Warnf("Limiter actor '%s': limit is not configured, invocations are not limited", actorID)
+		}
+
+		return l
+	}
+}
+
+// InvokeMethod implements targets.Interface and acquires or releases a lease.
+func (l *limiter) InvokeMethod(ctx context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	imReq, err := invokev1.FromInternalInvokeRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create InvokeMethodRequest: %w", err)
+	}
+	defer imReq.Close()
+
+	msg := imReq.Message()
+	var lease wrapperspb.StringValue
+	if err = proto.Unmarshal(msg.GetData().GetValue(), &lease); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lease: %w", err)
+	}
+
+	var res proto.Message
+	switch msg.GetMethod() {
+	case wflimiter.AcquireMethod:
+		res = durationpb.New(l.acquire(lease.GetValue()))
+	case wflimiter.ReleaseMethod:
+		l.release(lease.GetValue())
+		res = &wrapperspb.StringValue{}
+	default:
+		return nil, fmt.Errorf("limiter actor '%s': unknown method '%s'", l.actorID, msg.GetMethod())
+	}
+
+	data, err := anypb.New(res)
+	if err != nil {
+		return nil, err
+	}
+	return &internalsv1pb.InternalInvokeResponse{
+		Status: &internalsv1pb.Status{
+			Code: http.StatusOK,
+		},
+		Message: &commonv1pb.InvokeResponse{
+			Data: data,
+		},
+	}, nil
+}
+
+// acquire grants or renews the lease, and returns 0. If the lease can't be
+// granted yet, returns the duration after which the caller should retry.
+func (l *limiter) acquire(lease string) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	for id, expiry := range l.leases {
+		if !now.Before(expiry) {
+			delete(l.leases, id)
+		}
+	}
+
+	if _, ok := l.leases[lease]; ok {
+		l.leases[lease] = now.Add(wflimiter.LeaseTTL)
+		return 0
+	}
+
+	if l.maxConcurrent > 0 && len(l.leases) >= l.maxConcurrent {
+		return retryInterval
+	}
+
+	if l.rate != nil {
+		r := l.rate.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			return delay
+		}
+	}
+
+	l.leases[lease] = now.Add(wflimiter.LeaseTTL)
+	return 0
+}
+
+func (l *limiter) release(lease string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.leases, lease)
+}
+
+// InvokeReminder implements targets.Interface
+func (l *limiter) InvokeReminder(context.Context, *actorapi.Reminder) error {
+	return errors.New("reminders are not implemented")
+}
+
+// InvokeTimer implements targets.Interface
+func (l *limiter) InvokeTimer(context.Context, *actorapi.Reminder) error {
+	return errors.New("timers are not implemented")
+}
+
+// InvokeStream implements targets.Interface
+func (l *limiter) InvokeStream(context.Context, *internalsv1pb.InternalInvokeRequest, chan<- *internalsv1pb.InternalInvokeResponse) error {
+	return errors.New("not implemented")
+}
+
+// Deactivate implements targets.Interface
+func (l *limiter) Deactivate(context.Context) error {
+	log.Debugf("Limiter actor '%s': deactivated", l.actorID)
+	return nil
+}
+
+// Key returns the key for this unique actor.
+func (l *limiter) Key() string {
+	return l.actorType + actorapi.DaprSeparator + l.actorID
+}
+
+// Type returns the type of actor.
+func (l *limiter) Type() string {
+	return l.actorType
+}
+
+// ID returns the ID of the actor.
+func (l *limiter) ID() string {
+	return l.actorID
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wflimiter "github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+)
+
+func TestAcquire(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	factory := Factory(Options{
+		ActorType: "limiter",
+		Clock:     clock,
+		Limits: []wflimiter.Limit{
+			{Kind: wflimiter.KindActivity, Name: "concurrent", MaxConcurrent: 2, Scope: wflimiter.ScopeCluster},
+			{Kind: wflimiter.KindActivity, Name: "rate", MaxPerSecond: 2, Scope: wflimiter.ScopeCluster},
+			{Kind: wflimiter.KindActivity, Name: "local", MaxConcurrent: 1, Scope: wflimiter.ScopeSidecar},
+		},
+	})
+
+	t.Run("concurrent leases", func(t *testing.T) {
+		l := factory(wflimiter.Key(wflimiter.KindActivity, "concurrent")).(*limiter)
+		assert.Zero(t, l.acquire("a"))
+		assert.Zero(t, l.acquire("b"))
+		assert.Equal(t, retryInterval, l.acquire("c"))
+
+		// Renewing a lease is always granted.
+		assert.Zero(t, l.acquire("a"))
+
+		l.release("a")
+		assert.Zero(t, l.acquire("c"))
+
+		// Leases which are not renewed expire.
+		clock.Step(wflimiter.LeaseTTL / 2)
+		assert.Zero(t, l.acquire("b"))
+		clock.Step(wflimiter.LeaseTTL / 2)
+		assert.Zero(t, l.acquire("d"))
+		assert.Equal(t, retryInterval, l.acquire("e"))
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		l := factory(wflimiter.Key(wflimiter.KindActivity, "rate")).(*limiter)
+		assert.Zero(t, l.acquire("a"))
+		assert.Zero(t, l.acquire("b"))
+		delay := l.acquire("c")
+		assert.Positive(t, delay)
+		assert.LessOrEqual(t, delay, time.Second/2)
+
+		clock.Step(delay)
+		assert.Zero(t, l.acquire("c"))
+	})
+
+	t.Run("limits which are not cluster wide are not enforced", func(t *testing.T) {
+		l := factory(wflimiter.Key(wflimiter.KindActivity, "local")).(*limiter)
+		assert.Zero(t, l.acquire("a"))
+		assert.Zero(t, l.acquire("b"))
+	})
+}
+
+func TestInvokeMethod(t *testing.T) {
+	l := Factory(Options{
+		ActorType: "limiter",
+		Limits: []wflimiter.Limit{
+			{Kind: wflimiter.KindWorkflow, Name: "wf", MaxConcurrent: 1, Scope: wflimiter.ScopeCluster},
+		},
+	})(wflimiter.Key(wflimiter.KindWorkflow, "wf"))
+
+	call := func(method, lease string) *internalsv1pb.InternalInvokeResponse {
+		data, err := proto.Marshal(wrapperspb.String(lease))
+		require.NoError(t, err)
+		resp, err := l.InvokeMethod(t.Context(), internalsv1pb.
+			NewInternalInvokeRequest(method).
+			WithActor("limiter", l.ID()).
+			WithData(data).
+			WithContentType(invokev1.ProtobufContentType))
+		require.NoError(t, err)
+		return resp
+	}
+	retryAfter := func(resp *internalsv1pb.InternalInvokeResponse) time.Duration {
+		var d durationpb.Duration
+		require.NoError(t, resp.GetMessage().GetData().UnmarshalTo(&d))
+		return d.AsDuration()
+	}
+
+	assert.Zero(t, retryAfter(call(wflimiter.AcquireMethod, "a")))
+	assert.Equal(t, retryInterval, retryAfter(call(wflimiter.AcquireMethod, "b")))
+	call(wflimiter.ReleaseMethod, "a")
+	assert.Zero(t, retryAfter(call(wflimiter.AcquireMethod, "b")))
+
+	_, err := l.InvokeMethod(t.Context(), internalsv1pb.NewInternalInvokeRequest("Unknown").WithActor("limiter", l.ID()))
+	require.Error(t, err)
+}
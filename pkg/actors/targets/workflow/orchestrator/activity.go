@@ -25,10 +25,11 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 )
 
-func (o *orchestrator) callActivities(ctx context.Context, es []*backend.HistoryEvent, generation uint64) error {
+func (o *orchestrator) callActivities(ctx context.Context, startEvent *protos.ExecutionStartedEvent, es []*backend.HistoryEvent, generation uint64) error {
 	errs := make([]error, len(es))
 
 	var wg sync.WaitGroup
@@ -37,15 +38,13 @@ func (o *orchestrator) callActivities(ctx context.Context, es []*backend.History
 		go func(i int, e *backend.HistoryEvent) {
 			defer wg.Done()
 
-			err := o.callActivity(ctx, e, generation)
+			err := o.callActivity(ctx, startEvent, e, generation)
 			if errors.Is(err, todo.ErrDuplicateInvocation) {
 				log.Warnf("Workflow actor '%s': activity invocation '%s::%d' was flagged as a duplicate and will be skipped", o.actorID, e.GetTaskScheduled().GetName(), e.GetEventId())
 				return
 			}
 
-			if err != nil {
-				errs[i] = err
-			}
+			errs[i] = err
 		}(i, e)
 	}
 
@@ -54,7 +53,7 @@ func (o *orchestrator) callActivities(ctx context.Context, es []*backend.History
 	return errors.Join(errs...)
 }
 
-func (o *orchestrator) callActivity(ctx context.Context, e *backend.HistoryEvent, generation uint64) error {
+func (o *orchestrator) callActivity(ctx context.Context, startEvent *protos.ExecutionStartedEvent, e *backend.HistoryEvent, generation uint64) error {
 	ts := e.GetTaskScheduled()
 	if ts == nil {
 		log.Warnf("Workflow actor '%s': unable to process task '%v'", o.actorID, e)
@@ -73,11 +72,13 @@ func (o *orchestrator) callActivity(ctx context.Context, e *backend.HistoryEvent
 
 	log.Debugf("Workflow actor '%s': invoking execute method on activity actor '%s'", o.actorID, targetActorID)
 
+	// The timeouts of the activity are enforced by its activity actor.
 	_, err = o.router.Call(ctx, internalsv1pb.
 		NewInternalInvokeRequest("Execute").
-		WithActor(o.activityActorType, targetActorID).
+		WithActor(o.activityActorTypeFor(e), targetActorID).
 		WithData(eventData).
-		WithContentType(invokev1.ProtobufContentType),
+		WithContentType(invokev1.ProtobufContentType).
+		WithMetadata(activityTimeoutMetadata(startEvent)),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to invoke activity actor '%s' to execute '%s': %w", targetActorID, ts.GetName(), err)
@@ -86,6 +87,16 @@ func (o *orchestrator) callActivity(ctx context.Context, e *backend.HistoryEvent
 	return nil
 }
 
+// activityActorTypeFor returns the type of the activity actor of the given
+// task scheduled event. Activities may be routed to an activity actor of
+// another app.
+func (o *orchestrator) activityActorTypeFor(e *backend.HistoryEvent) string {
+	if target := e.GetRouter().GetTarget(); len(target) > 0 && target != o.appID {
+		return todo.ActorTypeForApp(o.activityActorType, target)
+	}
+	return o.activityActorType
+}
+
 func buildActivityActorID(workflowID string, taskID int32, generation uint64) string {
 	// An activity can be identified by its name followed by its task ID and generation. Example: SayHello::0::1, SayHello::1::1, etc.
 	return workflowID + "::" + strconv.Itoa(int(taskID)) + "::" + strconv.FormatUint(generation, 10)
@@ -18,6 +18,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/backend"
 )
@@ -40,8 +41,21 @@ func (o *orchestrator) addWorkflowEvent(ctx context.Context, historyEventBytes [
 	if err != nil {
 		return err
 	}
+	// The result of an activity which was already failed by the engine for
+	// exceeding its timeout is dropped.
+	if id, ok := taskResultID(&e); ok && !isTaskPending(state, id) {
+		log.Debugf("Workflow actor '%s': dropping result of activity task '%d' which is no longer running", o.actorID, id)
+		return nil
+	}
+
+	return o.addToInbox(ctx, state, &e)
+}
+
+// addToInbox saves the event to the inbox of the workflow and schedules its
+// processing.
+func (o *orchestrator) addToInbox(ctx context.Context, state *wfenginestate.State, e *backend.HistoryEvent) error {
 	log.Debugf("Workflow actor '%s': adding event to the workflow inbox", o.actorID)
-	state.AddToInbox(&e)
+	state.AddToInbox(e)
 
 	if err := o.saveInternalState(ctx, state); err != nil {
 		return err
@@ -44,6 +44,8 @@ func (o *orchestrator) createWorkflowInstance(ctx context.Context, request []byt
 		}
 	}
 
+	o.recordVersion(startEvent.GetExecutionStarted())
+
 	state, _, err := o.loadInternalState(ctx)
 	if err != nil {
 		return err
@@ -52,14 +54,7 @@ func (o *orchestrator) createWorkflowInstance(ctx context.Context, request []byt
 	// orchestration didn't exist
 	// create a new state entry if one doesn't already exist
 	if state == nil {
-		state = wfenginestate.NewState(wfenginestate.Options{
-			AppID:             o.appID,
-			WorkflowActorType: o.actorType,
-			ActivityActorType: o.activityActorType,
-		})
-		o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
-		o.setOrchestrationMetadata(o.rstate, startEvent.GetExecutionStarted())
-		return o.scheduleWorkflowStart(ctx, startEvent, state)
+		return o.scheduleWorkflowStart(ctx, startEvent, o.newWorkflowState(startEvent))
 	}
 
 	// orchestration already existed: apply reuse id policy
@@ -89,7 +84,16 @@ func (o *orchestrator) createWorkflowInstance(ctx context.Context, request []byt
 	return o.createIfCompleted(ctx, rs, state, startEvent)
 }
 
-func (o *orchestrator) createIfCompleted(ctx context.Context, rs *backend.OrchestrationRuntimeState, state *wfenginestate.State, startEvent *backend.HistoryEvent) error {
+// newWorkflowState returns the empty state of a workflow which is about to be
+// created from the given start event.
+func (o *orchestrator) newWorkflowState(startEvent *backend.HistoryEvent) *wfenginestate.State {
+	state := wfenginestate.NewState(o.stateOptions())
+	o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
+	o.setOrchestrationMetadata(o.rstate, startEvent.GetExecutionStarted())
+	return state
+}
+
+func (o *orchestrator) createIfCompleted(ctx context.Context, rs *backend.OrchestrationRuntimeState, state *wfenginestate.State, startEvent *backend.HistoryEvent, events ...*backend.HistoryEvent) error {
 	// We block (re)creation of existing workflows unless they are in a completed state
 	// Or if they still have any pending activity result awaited.
 	if !runtimestate.IsCompleted(rs) {
@@ -100,11 +104,16 @@ func (o *orchestrator) createIfCompleted(ctx context.Context, rs *backend.Orches
 	}
 	log.Infof("Workflow actor '%s': workflow was previously completed and is being recreated", o.actorID)
 	state.Reset()
-	return o.scheduleWorkflowStart(ctx, startEvent, state)
+	return o.scheduleWorkflowStart(ctx, startEvent, state, events...)
 }
 
-func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *backend.HistoryEvent, state *wfenginestate.State) error {
+// scheduleWorkflowStart saves the start event to the inbox of the workflow,
+// followed by the given events which are delivered to the new workflow.
+func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *backend.HistoryEvent, state *wfenginestate.State, events ...*backend.HistoryEvent) error {
 	state.AddToInbox(startEvent)
+	for _, e := range events {
+		state.AddToInbox(e)
+	}
 	if err := o.saveInternalState(ctx, state); err != nil {
 		return err
 	}
@@ -116,7 +125,7 @@ func (o *orchestrator) scheduleWorkflowStart(ctx context.Context, startEvent *ba
 		return err
 	}
 
-	return nil
+	return o.scheduleWorkflowDeadline(ctx, startEvent.GetExecutionStarted(), state.Generation)
 }
 
 func isStatusMatch(statuses []api.OrchestrationStatus, runtimeStatus api.OrchestrationStatus) bool {
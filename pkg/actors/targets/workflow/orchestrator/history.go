@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/runtime/wfengine/history"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// ErrorTypeHistoryLimitExceeded is the error type of the failure details of a
+// workflow which was failed for exceeding a history limit.
+const ErrorTypeHistoryLimitExceeded = "DaprWorkflowHistoryLimitExceeded"
+
+// enforceHistoryLimits is called once the workflow has been executed, before
+// its new history events are applied and saved, so that a history exceeding a
+// limit is never persisted. It logs a warning when the history of the workflow
+// approaches a limit. Once the history exceeds one, the execution is discarded
+// and the workflow is failed or continued as new, and true is returned.
+func (o *orchestrator) enforceHistoryLimits(ctx context.Context, state *wfenginestate.State, rs *backend.OrchestrationRuntimeState) (bool, error) {
+	// A workflow which continued as new or completed doesn't grow its history
+	// further.
+	if o.historyLimits == nil || rs.GetContinuedAsNew() || runtimestate.IsCompleted(rs) {
+		return false, nil
+	}
+
+	prev := history.Measure(state.History)
+	usage := history.Measure(state.History, rs.GetNewEvents())
+	if o.historyLimits.ApproachingLimit(prev, usage) {
+		log.Warnf("Workflow actor '%s': workflow history of %d events and %d bytes is approaching its limit of %s", o.actorID, usage.Events, usage.SizeBytes, o.describeHistoryLimits())
+	}
+	if !o.historyLimits.Exceeded(usage) {
+		return false, nil
+	}
+
+	events := slices.Concat(state.History, rs.GetNewEvents())
+
+	// The workflow is failed or continued as new from its saved state.
+	o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
+	o.setOrchestrationMetadata(o.rstate, o.getExecutionStartedEvent(state))
+
+	if o.historyLimits.OnLimitExceeded == history.ActionContinueAsNew {
+		log.Infof("Workflow actor '%s': workflow history of %d events and %d bytes exceeds its limit of %s, continuing workflow as new", o.actorID, usage.Events, usage.SizeBytes, o.describeHistoryLimits())
+		return true, o.continueAsNewFromCarryOver(ctx, state, events)
+	}
+
+	log.Infof("Workflow actor '%s': workflow history of %d events and %d bytes exceeds its limit of %s, failing workflow", o.actorID, usage.Events, usage.SizeBytes, o.describeHistoryLimits())
+	return true, o.failWorkflow(ctx, state, &protos.TaskFailureDetails{
+		ErrorType:      ErrorTypeHistoryLimitExceeded,
+		ErrorMessage:   fmt.Sprintf("workflow history of %d events and %d bytes exceeds its limit of %s", usage.Events, usage.SizeBytes, o.describeHistoryLimits()),
+		IsNonRetriable: true,
+	})
+}
+
+// continueAsNewFromCarryOver restarts the workflow with a new history, with
+// the carry-over state set in the given events of the workflow as input, or
+// its original input if it didn't set one. The search attributes of the
+// workflow are carried over. Activities and timers of the previous execution
+// are abandoned, as they are when a workflow continues as new.
+func (o *orchestrator) continueAsNewFromCarryOver(ctx context.Context, state *wfenginestate.State, events []*backend.HistoryEvent) error {
+	es, _ := proto.Clone(o.getExecutionStartedEvent(state)).(*protos.ExecutionStartedEvent)
+	if input, ok := history.CarryOverState(o.actorID, events); ok {
+		es.Input = input
+	}
+	es.OrchestrationInstance = &protos.OrchestrationInstance{
+		InstanceId:  o.actorID,
+		ExecutionId: wrapperspb.String(uuid.New().String()),
+	}
+	es.ScheduledStartTimestamp = nil
+	delete(es.GetTags(), search.CarryOverTag)
+	if err := search.CarryOver(search.FromHistory(o.actorID, events), es); err != nil {
+		return fmt.Errorf("failed to carry over search attributes: %w", err)
+	}
+
+	startEvent := &backend.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: es},
+	}
+
+	deletes := state.ResetHistory(o.actorID)
+	o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
+	o.setOrchestrationMetadata(o.rstate, es)
+	if err := o.scheduleWorkflowStart(ctx, startEvent, state); err != nil {
+		return err
+	}
+
+	// The new execution can't run before the previous history is deleted, as
+	// the workflow actor is locked.
+	for _, req := range deletes {
+		if err := o.actorState.TransactionalStateOperation(ctx, true, req, false); err != nil {
+			log.Warnf("Workflow actor '%s': failed to delete the history of the previous execution: %v", o.actorID, err)
+			break
+		}
+	}
+	return nil
+}
+
+func (o *orchestrator) describeHistoryLimits() string {
+	switch {
+	case o.historyLimits.MaxEvents > 0 && o.historyLimits.MaxSizeBytes > 0:
+		return fmt.Sprintf("%d events and %d bytes", o.historyLimits.MaxEvents, o.historyLimits.MaxSizeBytes)
+	case o.historyLimits.MaxEvents > 0:
+		return fmt.Sprintf("%d events", o.historyLimits.MaxEvents)
+	default:
+		return fmt.Sprintf("%d bytes", o.historyLimits.MaxSizeBytes)
+	}
+}
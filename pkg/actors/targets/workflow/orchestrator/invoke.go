@@ -83,6 +83,11 @@ func (o *orchestrator) executeMethod(ctx context.Context, methodName string, req
 
 	case todo.AddWorkflowEventMethod:
 		return nil, o.addWorkflowEvent(ctx, request)
+	case todo.SignalWithStartMethod:
+		return nil, o.signalWithStart(ctx, request)
+
+	case todo.ActivityHeartbeatMethod:
+		return nil, o.activityHeartbeat(ctx, request)
 
 	case todo.PurgeWorkflowStateMethod:
 		return nil, o.purgeWorkflowState(ctx)
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+)
+
+// lifecycleReminderName is the name of the reminder which publishes the
+// lifecycle events in the outbox of the workflow.
+const lifecycleReminderName = "lifecycle-events"
+
+// addLifecycleEvents adds the lifecycle events of the history events added
+// since the last save to the outbox of the workflow, and creates the reminder
+// which publishes them. The reminder is created before the state is saved: it
+// can't run until the actor is unlocked, and finds nothing to publish if the
+// save fails.
+func (o *orchestrator) addLifecycleEvents(ctx context.Context, state *wfenginestate.State) error {
+	events, err := o.lifecycle.Events(o.actorID, state.History, state.AddedHistory())
+	if err != nil {
+		return fmt.Errorf("failed to create workflow lifecycle events: %w", err)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err = o.createNamedReminder(ctx, lifecycleReminderName, nil, 0); err != nil {
+		return err
+	}
+	state.AddToOutbox(events)
+	return nil
+}
+
+// publishLifecycleEvents publishes the lifecycle events in the outbox of the
+// workflow in order, and removes the published events from the outbox. If an
+// event fails to be published, the error is returned so that the reminder is
+// retried.
+func (o *orchestrator) publishLifecycleEvents(ctx context.Context, state *wfenginestate.State) error {
+	if len(state.Outbox) == 0 {
+		return nil
+	}
+
+	n, err := o.lifecycle.Publish(ctx, state.Outbox)
+	if n > 0 {
+		state.RemoveFromOutbox(n)
+		if serr := o.saveInternalState(ctx, state); serr != nil {
+			err = errors.Join(err, serr)
+		}
+	}
+	return err
+}
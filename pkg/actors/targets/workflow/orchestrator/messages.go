@@ -29,38 +29,66 @@ import (
 
 func (o *orchestrator) callCreateWorkflowStateMessage(ctx context.Context, events []*backend.OrchestrationRuntimeStateMessage) error {
 	msgs := make([]proto.Message, len(events))
+	actorTypes := make([]string, len(events))
 	targets := make([]string, len(events))
 
 	for i, msg := range events {
 		msgs[i] = &backend.CreateWorkflowInstanceRequest{StartEvent: msg.GetHistoryEvent()}
+		actorTypes[i] = o.workflowActorTypeFor(msg.GetHistoryEvent())
 		targets[i] = msg.GetTargetInstanceID()
 	}
 
-	return o.callStateMessages(ctx, msgs, targets, todo.CreateWorkflowInstanceMethod)
+	return o.callStateMessages(ctx, msgs, actorTypes, targets, todo.CreateWorkflowInstanceMethod)
 }
 
 func (o *orchestrator) callAddEventStateMessage(ctx context.Context, events []*backend.OrchestrationRuntimeStateMessage) error {
 	targets := make([]string, len(events))
+	actorTypes := make([]string, len(events))
 	msgs := make([]proto.Message, len(events))
 
 	for i, msg := range events {
 		msgs[i] = msg.GetHistoryEvent()
+		actorTypes[i] = o.workflowActorTypeFor(msg.GetHistoryEvent())
 		targets[i] = msg.GetTargetInstanceID()
 	}
 
-	return o.callStateMessages(ctx, msgs, targets, todo.AddWorkflowEventMethod)
+	return o.callStateMessages(ctx, msgs, actorTypes, targets, todo.AddWorkflowEventMethod)
 }
 
-func (o *orchestrator) callStateMessages(ctx context.Context, msgs []proto.Message, targets []string, method string) error {
+// workflowActorTypeFor returns the workflow actor type of the app which the
+// event is routed to, which is the local app unless the event targets a
+// workflow of another app.
+func (o *orchestrator) workflowActorTypeFor(e *backend.HistoryEvent) string {
+	target := e.GetRouter().GetTarget()
+	if len(target) == 0 || target == o.appID {
+		return o.actorType
+	}
+	return todo.ActorTypeForApp(o.actorType, target)
+}
+
+// parentAppID returns the app ID of the parent workflow of a child workflow,
+// as recorded by the router of its execution started event.
+func parentAppID(rs *backend.OrchestrationRuntimeState) string {
+	for _, events := range [][]*backend.HistoryEvent{rs.GetOldEvents(), rs.GetNewEvents()} {
+		for _, e := range events {
+			if e.GetExecutionStarted() != nil {
+				return e.GetRouter().GetSource()
+			}
+		}
+	}
+	return ""
+}
+
+func (o *orchestrator) callStateMessages(ctx context.Context, msgs []proto.Message, actorTypes, targets []string, method string) error {
 	errs := make([]error, len(msgs))
 
 	var wg sync.WaitGroup
 	wg.Add(len(msgs))
 	for i, msg := range msgs {
-		go func(i int, msg proto.Message, target string) {
+		go func(i int, msg proto.Message, actorType, target string) {
 			defer wg.Done()
-			errs[i] = o.callStateMessage(ctx, msg, target, method)
-		}(i, msg, targets[i])
+			errs[i] = o.callStateMessage(ctx, msg, actorType, target, method)
+		}(i, msg, actorTypes[i], targets[i])
 	}
 
 	wg.Wait()
@@ -68,7 +96,7 @@ func (o *orchestrator) callStateMessages(ctx context.Context, msgs []proto.Messa
 	return errors.Join(errs...)
 }
 
-func (o *orchestrator) callStateMessage(ctx context.Context, m proto.Message, target string, method string) error {
+func (o *orchestrator) callStateMessage(ctx context.Context, m proto.Message, actorType, target, method string) error {
 	b, err := proto.Marshal(m)
 	if err != nil {
 		return err
@@ -78,7 +106,7 @@ func (o *orchestrator) callStateMessage(ctx context.Context, m proto.Message, ta
 
 	if _, err = o.router.Call(ctx, internalsv1pb.
 		NewInternalInvokeRequest(method).
-		WithActor(o.actorType, target).
+		WithActor(actorType, target).
 		WithData(b).
 		WithContentType(invokev1.ProtobufContentType),
 	); err != nil {
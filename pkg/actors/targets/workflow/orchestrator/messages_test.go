@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/ptr"
+)
+
+type calls struct {
+	lock sync.Mutex
+	reqs []*internalsv1pb.InternalInvokeRequest
+}
+
+func (c *calls) record(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.reqs = append(c.reqs, req)
+	return &internalsv1pb.InternalInvokeResponse{Message: &commonv1.InvokeResponse{}}, nil
+}
+
+func newTestOrchestrator(c *calls) *orchestrator {
+	return &orchestrator{
+		appID:             "app1",
+		actorID:           "wf1",
+		actorType:         "dapr.internal.default.app1.workflow",
+		activityActorType: "dapr.internal.default.app1.activity",
+		router:            routerfake.New().WithCallFn(c.record),
+	}
+}
+
+func TestCallActivity(t *testing.T) {
+	tests := map[string]struct {
+		router    *protos.TaskRouter
+		actorType string
+	}{
+		"activities without a router run on the local app": {
+			actorType: "dapr.internal.default.app1.activity",
+		},
+		"activities routed to the local app run on the local app": {
+			router:    &protos.TaskRouter{Source: "app1", Target: ptr.Of("app1")},
+			actorType: "dapr.internal.default.app1.activity",
+		},
+		"activities routed to another app run on that app": {
+			router:    &protos.TaskRouter{Source: "app1", Target: ptr.Of("app2")},
+			actorType: "dapr.internal.default.app2.activity",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var c calls
+			o := newTestOrchestrator(&c)
+
+			e := &backend.HistoryEvent{
+				EventId: 3,
+				Router:  test.router,
+				EventType: &protos.HistoryEvent_TaskScheduled{
+					TaskScheduled: &protos.TaskScheduledEvent{Name: "SayHello"},
+				},
+			}
+			require.NoError(t, o.callActivity(t.Context(), nil, e, 1))
+
+			require.Len(t, c.reqs, 1)
+			assert.Equal(t, test.actorType, c.reqs[0].GetActor().GetActorType())
+			assert.Equal(t, "wf1::3::1", c.reqs[0].GetActor().GetActorId())
+
+			// The router is kept so that the activity actor reports the result
+			// back to the workflow actor of the source app.
+			var sent backend.HistoryEvent
+			require.NoError(t, proto.Unmarshal(c.reqs[0].GetMessage().GetData().GetValue(), &sent))
+			assert.Equal(t, test.router.GetSource(), sent.GetRouter().GetSource())
+		})
+	}
+}
+
+func TestCallPendingMessages(t *testing.T) {
+	t.Run("child workflows routed to another app are created on that app", func(t *testing.T) {
+		var c calls
+		o := newTestOrchestrator(&c)
+
+		rs := &backend.OrchestrationRuntimeState{
+			PendingMessages: []*backend.OrchestrationRuntimeStateMessage{
+				{
+					TargetInstanceID: "child-local",
+					HistoryEvent: &backend.HistoryEvent{
+						EventType: &protos.HistoryEvent_ExecutionStarted{
+							ExecutionStarted: &protos.ExecutionStartedEvent{Name: "child"},
+						},
+					},
+				},
+				{
+					TargetInstanceID: "child-remote",
+					HistoryEvent: &backend.HistoryEvent{
+						Router: &protos.TaskRouter{Source: "app1", Target: ptr.Of("app2")},
+						EventType: &protos.HistoryEvent_ExecutionStarted{
+							ExecutionStarted: &protos.ExecutionStartedEvent{Name: "child"},
+						},
+					},
+				},
+			},
+		}
+		updates, err := o.callPendingMessages(t.Context(), rs)
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+
+		actorTypes := make(map[string]string, len(c.reqs))
+		for _, req := range c.reqs {
+			assert.Equal(t, todo.CreateWorkflowInstanceMethod, req.GetMessage().GetMethod())
+			actorTypes[req.GetActor().GetActorId()] = req.GetActor().GetActorType()
+		}
+		assert.Equal(t, map[string]string{
+			"child-local":  "dapr.internal.default.app1.workflow",
+			"child-remote": "dapr.internal.default.app2.workflow",
+		}, actorTypes)
+	})
+
+	t.Run("results of child workflows of another app go back to the parent app", func(t *testing.T) {
+		var c calls
+		o := newTestOrchestrator(&c)
+
+		rs := &backend.OrchestrationRuntimeState{
+			OldEvents: []*backend.HistoryEvent{{
+				Router: &protos.TaskRouter{Source: "app2", Target: ptr.Of("app1")},
+				EventType: &protos.HistoryEvent_ExecutionStarted{
+					ExecutionStarted: &protos.ExecutionStartedEvent{Name: "child"},
+				},
+			}},
+			PendingMessages: []*backend.OrchestrationRuntimeStateMessage{{
+				TargetInstanceID: "parent",
+				HistoryEvent: &backend.HistoryEvent{
+					EventType: &protos.HistoryEvent_SubOrchestrationInstanceCompleted{
+						SubOrchestrationInstanceCompleted: &protos.SubOrchestrationInstanceCompletedEvent{
+							TaskScheduledId: 1,
+							Result:          wrapperspb.String("done"),
+						},
+					},
+				},
+			}},
+		}
+		updates, err := o.callPendingMessages(t.Context(), rs)
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+
+		require.Len(t, c.reqs, 1)
+		assert.Equal(t, todo.AddWorkflowEventMethod, c.reqs[0].GetMessage().GetMethod())
+		assert.Equal(t, "dapr.internal.default.app2.workflow", c.reqs[0].GetActor().GetActorType())
+		assert.Equal(t, "parent", c.reqs[0].GetActor().GetActorId())
+
+		var sent backend.HistoryEvent
+		require.NoError(t, proto.Unmarshal(c.reqs[0].GetMessage().GetData().GetValue(), &sent))
+		assert.Equal(t, "done", sent.GetSubOrchestrationInstanceCompleted().GetResult().GetValue())
+	})
+
+	t.Run("results of child workflows of the local app stay on the local app", func(t *testing.T) {
+		var c calls
+		o := newTestOrchestrator(&c)
+
+		rs := &backend.OrchestrationRuntimeState{
+			OldEvents: []*backend.HistoryEvent{{
+				EventType: &protos.HistoryEvent_ExecutionStarted{
+					ExecutionStarted: &protos.ExecutionStartedEvent{Name: "child"},
+				},
+			}},
+			PendingMessages: []*backend.OrchestrationRuntimeStateMessage{{
+				TargetInstanceID: "parent",
+				HistoryEvent: &backend.HistoryEvent{
+					EventType: &protos.HistoryEvent_SubOrchestrationInstanceFailed{
+						SubOrchestrationInstanceFailed: &protos.SubOrchestrationInstanceFailedEvent{TaskScheduledId: 1},
+					},
+				},
+			}},
+		}
+		updates, err := o.callPendingMessages(t.Context(), rs)
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+
+		require.Len(t, c.reqs, 1)
+		assert.Equal(t, "dapr.internal.default.app1.workflow", c.reqs[0].GetActor().GetActorType())
+	})
+}
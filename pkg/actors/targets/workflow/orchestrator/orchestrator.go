@@ -29,8 +29,14 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/history"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/lifecycle"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/events/broadcaster"
 	"github.com/dapr/kit/logger"
@@ -62,12 +68,24 @@ type orchestrator struct {
 	actorState state.Interface
 
 	reminderInterval time.Duration
+	versions         Versions
+	searchIndex      *search.Index
+	historyLimits    *history.Limits
+	limiter          *limiter.Limiter
+	admittedWorkflow string
+	payloads         *payload.Store
+	lifecycle        *lifecycle.Lifecycle
 
 	state            *wfenginestate.State
 	rstate           *backend.OrchestrationRuntimeState
 	ometa            *backend.OrchestrationMetadata
 	ometaBroadcaster *broadcaster.Broadcaster[*backend.OrchestrationMetadata]
 
+	// updates are the channels of the callers waiting for the response of the
+	// workflow to an update, by update name.
+	updates     map[string][]chan *protos.EventSentEvent
+	updatesLock sync.Mutex
+
 	scheduler             todo.WorkflowScheduler
 	activityResultAwaited atomic.Bool
 	completed             atomic.Bool
@@ -84,6 +102,30 @@ type Options struct {
 	ActivityActorType string
 	ReminderInterval  *time.Duration
 
+	// Versions reports the workflow versions of the connected workers.
+	// Versions are not checked if nil.
+	Versions Versions
+
+	// SearchIndex indexes the search attributes of workflows. Search
+	// attributes are not indexed if nil.
+	SearchIndex *search.Index
+
+	// HistoryLimits are the limits on the history of workflows. The history
+	// of workflows is not limited if nil.
+	HistoryLimits *history.Limits
+
+	// Limiter enforces the limits of the invocations of workflows.
+	// Invocations are not limited if nil.
+	Limiter *limiter.Limiter
+
+	// Payloads offloads the large payloads of workflows. Payloads are not
+	// offloaded if nil.
+	Payloads *payload.Store
+
+	// Lifecycle publishes the lifecycle events of workflows. Lifecycle events
+	// are not published if nil.
+	Lifecycle *lifecycle.Lifecycle
+
 	Resiliency         resiliency.Provider
 	Actors             actors.Interface
 	Scheduler          todo.WorkflowScheduler
@@ -146,6 +188,12 @@ func Factory(ctx context.Context, opts Options) (targets.Factory, error) {
 			o.closeCh = make(chan struct{})
 			o.closed.Store(false)
 		}
+		o.versions = opts.Versions
+		o.searchIndex = opts.SearchIndex
+		o.historyLimits = opts.HistoryLimits
+		o.limiter = opts.Limiter
+		o.payloads = opts.Payloads
+		o.lifecycle = opts.Lifecycle
 
 		if opts.EventSink != nil {
 			ch := make(chan *backend.OrchestrationMetadata)
@@ -206,6 +254,15 @@ func (o *orchestrator) Deactivate(ctx context.Context) error {
 	return nil
 }
 
+// releaseLimits releases the instance from the limits of its workflow name,
+// if it was admitted.
+func (o *orchestrator) releaseLimits() {
+	if len(o.admittedWorkflow) > 0 {
+		o.limiter.Release(limiter.KindWorkflow, o.admittedWorkflow, o.actorID)
+		o.admittedWorkflow = ""
+	}
+}
+
 // Key returns the key for this unique actor.
 func (o *orchestrator) Key() string {
 	return o.actorType + actorapi.DaprSeparator + o.actorID
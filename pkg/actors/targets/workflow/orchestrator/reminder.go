@@ -35,6 +35,12 @@ func (o *orchestrator) createReminder(ctx context.Context, namePrefix string, da
 	}
 
 	reminderName := namePrefix + "-" + base64.RawURLEncoding.EncodeToString(b)
+	return reminderName, o.createNamedReminder(ctx, reminderName, data, delay)
+}
+
+// createNamedReminder creates a reminder with the given name, replacing any
+// existing reminder of this workflow with the same name.
+func (o *orchestrator) createNamedReminder(ctx context.Context, reminderName string, data proto.Message, delay time.Duration) error {
 	log.Debugf("Workflow actor '%s||%s': creating '%s' reminder with DueTime = '%s'", o.activityActorType, o.actorID, reminderName, delay)
 
 	var period string
@@ -47,13 +53,14 @@ func (o *orchestrator) createReminder(ctx context.Context, namePrefix string, da
 
 	var adata *anypb.Any
 	if data != nil {
+		var err error
 		adata, err = anypb.New(data)
 		if err != nil {
-			return "", err
+			return err
 		}
 	}
 
-	return reminderName, o.reminders.Create(ctx, &actorapi.CreateReminderRequest{
+	return o.reminders.Create(ctx, &actorapi.CreateReminderRequest{
 		ActorType: o.actorType,
 		ActorID:   o.actorID,
 		Data:      adata,
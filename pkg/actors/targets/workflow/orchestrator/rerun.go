@@ -140,11 +140,7 @@ func (o *orchestrator) rerunWorkflowInstanceRequest(ctx context.Context, request
 		i--
 	}
 
-	newState := wfenginestate.NewState(wfenginestate.Options{
-		AppID:             o.appID,
-		WorkflowActorType: o.actorType,
-		ActivityActorType: o.activityActorType,
-	})
+	newState := wfenginestate.NewState(o.stateOptions())
 
 	newState.FromWorkflowState(&workflowState)
 
@@ -153,7 +149,7 @@ func (o *orchestrator) rerunWorkflowInstanceRequest(ctx context.Context, request
 	}
 
 	if err = errors.Join(
-		o.callActivities(ctx, activities, newState.Generation),
+		o.callActivities(ctx, o.getExecutionStartedEvent(newState), activities, newState.Generation),
 		o.createTimers(ctx, timers, newState.Generation),
 	); err != nil {
 		return err
@@ -23,11 +23,16 @@ import (
 	actorapi "github.com/dapr/dapr/pkg/actors/api"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	wferrors "github.com/dapr/dapr/pkg/runtime/wfengine/errors"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/history"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/durabletask-go/backend/runtimestate"
+	"github.com/dapr/kit/ptr"
 )
 
 func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Reminder) (todo.RunCompleted, error) {
@@ -41,6 +46,13 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		return todo.RunCompletedTrue, nil
 	}
 
+	if reminder.Name == lifecycleReminderName {
+		if err = o.publishLifecycleEvents(ctx, state); err != nil {
+			return todo.RunCompletedFalse, wferrors.NewRecoverable(err)
+		}
+		return todo.RunCompletedFalse, nil
+	}
+
 	if strings.HasPrefix(reminder.Name, "timer-") {
 		var durableTimer backend.DurableTimer
 		if err = reminder.Data.UnmarshalTo(&durableTimer); err != nil {
@@ -57,6 +69,10 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		state.Inbox = append(state.Inbox, durableTimer.GetTimerEvent())
 	}
 
+	if done, completed, terr := o.handleTimeoutReminder(ctx, state, reminder.Name); done {
+		return completed, terr
+	}
+
 	if len(state.Inbox) == 0 {
 		// This can happen after multiple events are processed in batches; there may still be reminders around
 		// for some of those already processed events.
@@ -64,6 +80,19 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		return todo.RunCompletedFalse, nil
 	}
 
+	// Replaying the workflow on an incompatible worker would fail with a
+	// non-determinism error, so the execution is retried until a compatible
+	// worker is connected.
+	if version := o.getExecutionStartedEvent(state).GetVersion().GetValue(); !o.isVersionCompatible(version) {
+		if err = o.reportNoCompatibleWorker(ctx, state, version); err != nil {
+			log.Warnf("Workflow actor '%s': failed to report no compatible worker: %s", o.actorID, err)
+		}
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("no worker compatible with workflow version '%s' is connected", version))
+	}
+
+	// The stalled reason is cleared with the next save of the state.
+	state.SetStalled(nil)
+
 	var esHistoryEvent *backend.HistoryEvent
 	for _, e := range state.Inbox {
 		if es := e.GetExecutionStarted(); es != nil {
@@ -73,6 +102,7 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	}
 
 	rs := o.rstate
+	prevStatus := runtimestate.RuntimeStatus(rs)
 	wi := &backend.OrchestrationWorkItem{
 		InstanceID: api.InstanceID(rs.GetInstanceId()),
 		NewEvents:  state.Inbox,
@@ -93,6 +123,24 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		executionStatus = ""
 	}
 	workflowName := o.getExecutionStartedEvent(state).GetName()
+
+	// Instances beyond the limits of their workflow name are queued: the
+	// reminder is retried until the instance is admitted. An admitted instance
+	// counts against the limits until it completes or its actor is deactivated.
+	admitted, err := o.limiter.Admit(ctx, limiter.KindWorkflow, workflowName, o.actorID)
+	if err != nil {
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("failed to check the limits of workflow '%s': %w", workflowName, err))
+	}
+	if !admitted {
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(fmt.Errorf("workflow '%s' is at its limit of running instances", workflowName))
+	}
+	o.admittedWorkflow = workflowName
+	defer func() {
+		if o.rstate == nil || runtimestate.IsCompleted(o.rstate) {
+			o.releaseLimits()
+		}
+	}()
+
 	// Request to execute workflow
 	log.Debugf("Workflow actor '%s': scheduling workflow execution with instanceId '%s'", o.actorID, wi.InstanceID)
 	// Schedule the workflow execution by signaling the backend
@@ -129,6 +177,23 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	}
 	log.Debugf("Workflow actor '%s': workflow execution returned with status '%s' instanceId '%s'", o.actorID, runtimestate.RuntimeStatus(rs).String(), wi.InstanceID)
 
+	exceeded, err := o.enforceHistoryLimits(ctx, state, rs)
+	if err != nil {
+		executionStatus = diag.StatusRecoverable
+		return todo.RunCompletedFalse, wferrors.NewRecoverable(err)
+	}
+	if exceeded {
+		// The workflow was failed or continued as new for exceeding a history
+		// limit.
+		executionStatus = ""
+		if runtimestate.IsCompleted(o.rstate) {
+			executionStatus = diag.StatusFailed
+			wfExecutionElapsedTime = o.calculateWorkflowExecutionLatency(state)
+			return todo.RunCompletedTrue, nil
+		}
+		return todo.RunCompletedFalse, nil
+	}
+
 	// Increment the generation counter if the workflow used continue-as-new. Subsequent actions below
 	// will use this updated generation value for their duplication execution handling.
 	if rs.GetContinuedAsNew() {
@@ -143,33 +208,14 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 		}
 	}
 
-	err = o.callActivities(ctx, rs.GetPendingTasks(), state.Generation)
+	err = o.callActivities(ctx, rs.GetStartEvent(), rs.GetPendingTasks(), state.Generation)
 	if err != nil {
 		executionStatus = diag.StatusRecoverable
 		return todo.RunCompletedFalse, err
 	}
 
-	// Process the outbound orchestrator events
-	var addWorkflows []*backend.OrchestrationRuntimeStateMessage
-	var createWorkflows []*backend.OrchestrationRuntimeStateMessage
-	for _, msg := range rs.GetPendingMessages() {
-		switch {
-		case msg.GetHistoryEvent().GetExecutionStarted() != nil:
-			createWorkflows = append(createWorkflows, msg)
-
-		case msg.GetHistoryEvent().GetSubOrchestrationInstanceCompleted() != nil, msg.GetHistoryEvent().GetSubOrchestrationInstanceFailed() != nil:
-			addWorkflows = append(addWorkflows, msg)
-
-		default:
-			return todo.RunCompletedTrue, fmt.Errorf("workflow actor '%s': don't know how to process outbound message '%v'", o.actorID, msg)
-		}
-	}
-
-	if err = o.callAddEventStateMessage(ctx, addWorkflows); err != nil {
-		return todo.RunCompletedFalse, err
-	}
-
-	if err = o.callCreateWorkflowStateMessage(ctx, createWorkflows); err != nil {
+	updates, err := o.callPendingMessages(ctx, rs)
+	if err != nil {
 		return todo.RunCompletedFalse, err
 	}
 
@@ -180,6 +226,9 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	if err != nil {
 		return todo.RunCompletedTrue, err
 	}
+	o.respondToUpdates(updates)
+	o.indexSearchAttributes(ctx, rs, state, prevStatus)
+
 	if executionStatus != "" {
 		// If workflow is not completed, set executionStatus to empty string
 		// which will skip recording metrics for this execution.
@@ -201,6 +250,59 @@ func (o *orchestrator) runWorkflow(ctx context.Context, reminder *actorapi.Remin
 	return todo.RunCompletedFalse, nil
 }
 
+// callPendingMessages delivers the outbound events of the workflow to the
+// workflow actors they are addressed to. The responses of the workflow to
+// updates are returned, to be handed to their waiters once the state of the
+// workflow is saved.
+func (o *orchestrator) callPendingMessages(ctx context.Context, rs *backend.OrchestrationRuntimeState) ([]*protos.EventSentEvent, error) {
+	var updates []*protos.EventSentEvent
+	var addWorkflows []*backend.OrchestrationRuntimeStateMessage
+	var createWorkflows []*backend.OrchestrationRuntimeStateMessage
+	for _, msg := range rs.GetPendingMessages() {
+		switch {
+		case msg.GetHistoryEvent().GetExecutionStarted() != nil:
+			createWorkflows = append(createWorkflows, msg)
+
+		case msg.GetHistoryEvent().GetSubOrchestrationInstanceCompleted() != nil, msg.GetHistoryEvent().GetSubOrchestrationInstanceFailed() != nil:
+			// The result of a child workflow started by a workflow of another
+			// app is routed back to the app of the parent workflow.
+			if source := parentAppID(rs); len(source) > 0 && source != o.appID && msg.GetHistoryEvent().GetRouter() == nil {
+				msg.HistoryEvent.Router = &protos.TaskRouter{
+					Source: o.appID,
+					Target: ptr.Of(source),
+				}
+			}
+			addWorkflows = append(addWorkflows, msg)
+
+		case search.IsUpsert(o.actorID, msg):
+			// Upserts of search attributes are recorded in the history of the
+			// workflow and indexed once it is saved.
+
+		case history.IsCarryOver(o.actorID, msg):
+			// The carry-over state is recorded in the history of the workflow
+			// and used if the engine continues it as new.
+
+		case msg.GetHistoryEvent().GetEventSent() != nil && msg.GetTargetInstanceID() == o.actorID:
+			// An event sent by the workflow to itself is the response to an
+			// update.
+			updates = append(updates, msg.GetHistoryEvent().GetEventSent())
+
+		default:
+			return nil, fmt.Errorf("workflow actor '%s': don't know how to process outbound message '%v'", o.actorID, msg)
+		}
+	}
+
+	if err := o.callAddEventStateMessage(ctx, addWorkflows); err != nil {
+		return nil, err
+	}
+
+	if err := o.callCreateWorkflowStateMessage(ctx, createWorkflows); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
 func (*orchestrator) calculateWorkflowExecutionLatency(state *wfenginestate.State) (wExecutionElapsedTime float64) {
 	for _, e := range state.History {
 		if os := e.GetOrchestratorStarted(); os != nil {
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// indexSearchAttributes updates the search index record of the workflow after
+// its state was saved, if the workflow upserted its search attributes or its
+// runtime status changed. Workflows which never set search attributes are not
+// indexed. Indexing failures are logged rather than failing the workflow,
+// since its state has already been saved.
+func (o *orchestrator) indexSearchAttributes(ctx context.Context, rs *backend.OrchestrationRuntimeState, state *wfenginestate.State, prevStatus api.OrchestrationStatus) {
+	if o.searchIndex == nil {
+		return
+	}
+
+	status := runtimestate.RuntimeStatus(rs)
+	upserted := slices.ContainsFunc(rs.GetPendingMessages(), func(msg *backend.OrchestrationRuntimeStateMessage) bool {
+		return search.IsUpsert(o.actorID, msg)
+	})
+	if !upserted && status == prevStatus {
+		return
+	}
+
+	attrs := search.FromHistory(o.actorID, state.History)
+	if attrs == nil {
+		return
+	}
+
+	lastUpdated, _ := runtimestate.LastUpdatedTime(rs)
+	err := o.searchIndex.Save(ctx, &search.Record{
+		InstanceID:       o.actorID,
+		WorkflowName:     o.getExecutionStartedEvent(state).GetName(),
+		RuntimeStatus:    search.RuntimeStatus(status),
+		SearchAttributes: attrs,
+		LastUpdatedAt:    lastUpdated,
+	})
+	if err != nil {
+		log.Errorf("Workflow actor '%s': failed to index search attributes: %v", o.actorID, err)
+	}
+}
+
+// deleteSearchAttributes removes the search index record of the workflow when
+// its state is purged.
+func (o *orchestrator) deleteSearchAttributes(ctx context.Context) {
+	if o.searchIndex == nil {
+		return
+	}
+	if err := o.searchIndex.Delete(ctx, o.actorID); err != nil {
+		log.Errorf("Workflow actor '%s': failed to delete search attributes from index: %v", o.actorID, err)
+	}
+}
@@ -33,11 +33,7 @@ func (o *orchestrator) loadInternalState(ctx context.Context) (*wfenginestate.St
 
 	// state is not cached, so try to load it from the state store
 	log.Debugf("Workflow actor '%s': loading workflow state", o.actorID)
-	state, err := wfenginestate.LoadWorkflowState(ctx, o.actorState, o.actorID, wfenginestate.Options{
-		AppID:             o.appID,
-		WorkflowActorType: o.actorType,
-		ActivityActorType: o.activityActorType,
-	})
+	state, err := wfenginestate.LoadWorkflowState(ctx, o.actorState, o.actorID, o.stateOptions())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,9 +50,25 @@ func (o *orchestrator) loadInternalState(ctx context.Context) (*wfenginestate.St
 	return state, o.ometa, nil
 }
 
+// stateOptions returns the options of the state of the workflow.
+func (o *orchestrator) stateOptions() wfenginestate.Options {
+	return wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+		Payloads:          o.payloads,
+	}
+}
+
 func (o *orchestrator) saveInternalState(ctx context.Context, state *wfenginestate.State) error {
+	// Lifecycle events are saved with the history events they are derived
+	// from.
+	if err := o.addLifecycleEvents(ctx, state); err != nil {
+		return err
+	}
+
 	// generate and run a state store operation that saves all changes
-	req, err := state.GetSaveRequest(o.actorID)
+	req, err := state.GetSaveRequest(ctx, o.actorID)
 	if err != nil {
 		return err
 	}
@@ -70,6 +82,12 @@ func (o *orchestrator) saveInternalState(ctx context.Context, state *wfenginesta
 	// ResetChangeTracking should always be called after a save operation succeeds
 	state.ResetChangeTracking()
 
+	// Payloads which are no longer referenced are deleted on a best-effort
+	// basis, since the state has been saved already.
+	if err = state.DeleteUnreferencedPayloads(ctx, o.actorID); err != nil {
+		log.Warnf("Workflow actor '%s': failed to delete unreferenced workflow payloads: %v", o.actorID, err)
+	}
+
 	// Update cached state
 	o.state = state
 	o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
@@ -98,6 +116,10 @@ func (o *orchestrator) cleanupWorkflowStateInternal(ctx context.Context, state *
 		return err
 	}
 
+	if err = state.DeletePayloads(ctx, o.actorID); err != nil {
+		log.Warnf("Workflow actor '%s': failed to delete workflow payloads: %v", o.actorID, err)
+	}
+	o.deleteSearchAttributes(ctx)
 	o.table.DeleteFromTableIn(o, 0)
 	o.cleanup()
 
@@ -121,7 +143,11 @@ func (o *orchestrator) setOrchestrationMetadata(rstate *backend.OrchestrationRun
 	completedAt, _ := runtimestate.CompletedTime(rstate)
 	input, _ := runtimestate.Input(rstate)
 	output, _ := runtimestate.Output(rstate)
+	status := runtimestate.RuntimeStatus(rstate)
 	failureDetails, _ := runtimestate.FailureDetails(rstate)
+	if failureDetails == nil {
+		failureDetails = o.state.StalledFailureDetails(status)
+	}
 	var parentInstanceID string
 	if se != nil && se.GetParentInstance() != nil && se.GetParentInstance().GetOrchestrationInstance() != nil {
 		parentInstanceID = se.GetParentInstance().GetOrchestrationInstance().GetInstanceId()
@@ -129,7 +155,7 @@ func (o *orchestrator) setOrchestrationMetadata(rstate *backend.OrchestrationRun
 	o.ometa = &backend.OrchestrationMetadata{
 		InstanceId:       rstate.GetInstanceId(),
 		Name:             name,
-		RuntimeStatus:    runtimestate.RuntimeStatus(rstate),
+		RuntimeStatus:    status,
 		CreatedAt:        timestamppb.New(createdAt),
 		LastUpdatedAt:    timestamppb.New(lastUpdated),
 		CompletedAt:      timestamppb.New(completedAt),
@@ -142,6 +168,7 @@ func (o *orchestrator) setOrchestrationMetadata(rstate *backend.OrchestrationRun
 }
 
 func (o *orchestrator) cleanup() {
+	o.releaseLimits()
 	if o.closed.CompareAndSwap(false, true) {
 		close(o.closeCh)
 		o.ometaBroadcaster.Close()
@@ -29,6 +29,10 @@ import (
 )
 
 func (o *orchestrator) handleStream(ctx context.Context, req *internalsv1pb.InternalInvokeRequest, stream chan<- *internalsv1pb.InternalInvokeResponse) error {
+	if req.GetMessage().GetMethod() == todo.UpdateWorkflowMethod {
+		return o.handleUpdateStream(ctx, req, stream)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -0,0 +1,270 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wferrors "github.com/dapr/dapr/pkg/runtime/wfengine/errors"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+const (
+	// OptionWorkflowTimeout is the start workflow option which sets the maximum
+	// total duration of a workflow, starting from when it is created.
+	OptionWorkflowTimeout = "dapr.workflow.timeout"
+	// OptionActivityStartToCloseTimeout is the start workflow option which sets
+	// the maximum duration of every activity of the workflow, starting from
+	// when it is scheduled.
+	OptionActivityStartToCloseTimeout = "dapr.workflow.activity_start_to_close_timeout"
+	// OptionActivityHeartbeatTimeout is the start workflow option which sets the
+	// maximum duration between two heartbeats of every activity of the
+	// workflow. The first heartbeat is due when the activity is scheduled.
+	OptionActivityHeartbeatTimeout = "dapr.workflow.activity_heartbeat_timeout"
+
+	// ErrorTypeWorkflowTimeout is the error type of the failure details of a
+	// workflow which was failed for exceeding its timeout.
+	ErrorTypeWorkflowTimeout = "DaprWorkflowTimeout"
+
+	deadlineReminderPrefix = "deadline-"
+)
+
+// TimeoutOptions are the start workflow options which configure the timeouts
+// enforced by the engine. They are recorded as tags of the execution started
+// event of the workflow.
+var TimeoutOptions = []string{
+	OptionWorkflowTimeout,
+	OptionActivityStartToCloseTimeout,
+	OptionActivityHeartbeatTimeout,
+}
+
+// timeoutOption returns the duration of the given timeout option of the
+// workflow, or 0 if it is not set.
+func timeoutOption(es *protos.ExecutionStartedEvent, option string) time.Duration {
+	v, ok := es.GetTags()[option]
+	if !ok {
+		return 0
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
+// scheduleWorkflowDeadline creates the reminder which fails the workflow once
+// its timeout is exceeded.
+func (o *orchestrator) scheduleWorkflowDeadline(ctx context.Context, es *protos.ExecutionStartedEvent, generation uint64) error {
+	timeout := timeoutOption(es, OptionWorkflowTimeout)
+	if timeout == 0 {
+		return nil
+	}
+	return o.createNamedReminder(ctx, deadlineReminderPrefix+strconv.FormatUint(generation, 10), nil, timeout)
+}
+
+// activityTimeoutMetadata returns the metadata of the invocation of an
+// activity actor with the timeouts of the activity, which are enforced by the
+// activity actor.
+func activityTimeoutMetadata(es *protos.ExecutionStartedEvent) map[string][]string {
+	var md map[string][]string
+	for option, key := range map[string]string{
+		OptionActivityStartToCloseTimeout: todo.ActivityStartToCloseTimeoutMetadata,
+		OptionActivityHeartbeatTimeout:    todo.ActivityHeartbeatTimeoutMetadata,
+	} {
+		if timeout := timeoutOption(es, option); timeout > 0 {
+			if md == nil {
+				md = make(map[string][]string, 2)
+			}
+			md[key] = []string{timeout.String()}
+		}
+	}
+	return md
+}
+
+// activityHeartbeat records the liveness of a running activity by forwarding
+// the heartbeat to its activity actor, which pushes back the due time of its
+// heartbeat reminder.
+func (o *orchestrator) activityHeartbeat(ctx context.Context, request []byte) error {
+	state, _, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return api.ErrInstanceNotFound
+	}
+
+	var taskID wrapperspb.Int32Value
+	if err = proto.Unmarshal(request, &taskID); err != nil {
+		return fmt.Errorf("failed to unmarshal activity heartbeat: %w", err)
+	}
+
+	if !isTaskPending(state, taskID.GetValue()) {
+		return fmt.Errorf("activity task '%d' of workflow '%s' is not running", taskID.GetValue(), o.actorID)
+	}
+
+	timeout := timeoutOption(o.getExecutionStartedEvent(state), OptionActivityHeartbeatTimeout)
+	if timeout == 0 {
+		return nil
+	}
+
+	data, err := proto.Marshal(durationpb.New(timeout))
+	if err != nil {
+		return err
+	}
+
+	_, err = o.router.Call(ctx, internalsv1pb.
+		NewInternalInvokeRequest(todo.ActivityHeartbeatMethod).
+		WithActor(o.activityActorTypeFor(taskScheduledEvent(state, taskID.GetValue())), buildActivityActorID(o.actorID, taskID.GetValue(), state.Generation)).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType),
+	)
+	return err
+}
+
+// handleTimeoutReminder handles the reminder of the timeout of the workflow.
+// Returns true if the reminder was a timeout reminder, in which case the
+// workflow is not executed.
+func (o *orchestrator) handleTimeoutReminder(ctx context.Context, state *wfenginestate.State, name string) (bool, todo.RunCompleted, error) {
+	switch {
+	case strings.HasPrefix(name, deadlineReminderPrefix):
+		generation, err := strconv.ParseUint(strings.TrimPrefix(name, deadlineReminderPrefix), 10, 64)
+		if err != nil || generation != state.Generation || runtimestate.IsCompleted(o.rstate) {
+			return true, todo.RunCompletedFalse, nil
+		}
+
+		log.Infof("Workflow actor '%s': workflow exceeded its timeout and is being failed", o.actorID)
+		if err = o.failWorkflow(ctx, state, &protos.TaskFailureDetails{
+			ErrorType:      ErrorTypeWorkflowTimeout,
+			ErrorMessage:   fmt.Sprintf("workflow exceeded its timeout of %s", timeoutOption(o.getExecutionStartedEvent(state), OptionWorkflowTimeout)),
+			IsNonRetriable: true,
+		}); err != nil {
+			return true, todo.RunCompletedFalse, wferrors.NewRecoverable(err)
+		}
+		return true, todo.RunCompletedTrue, nil
+
+	default:
+		return false, todo.RunCompletedFalse, nil
+	}
+}
+
+// failWorkflow completes the workflow with a failed status without executing
+// the workflow code, notifying the parent workflow if any.
+func (o *orchestrator) failWorkflow(ctx context.Context, state *wfenginestate.State, failureDetails *protos.TaskFailureDetails) error {
+	rs := o.rstate
+	prevStatus := runtimestate.RuntimeStatus(rs)
+	if err := runtimestate.AddEvent(rs, &protos.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_OrchestratorStarted{
+			OrchestratorStarted: &protos.OrchestratorStartedEvent{},
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to fail workflow: %w", err)
+	}
+	for _, e := range state.Inbox {
+		if err := runtimestate.AddEvent(rs, e); err != nil {
+			log.Debugf("Workflow actor '%s': dropping inbox event while failing workflow: %v", o.actorID, err)
+		}
+	}
+
+	if _, err := runtimestate.ApplyActions(rs, rs.GetCustomStatus(), []*protos.OrchestratorAction{{
+		Id: -1,
+		OrchestratorActionType: &protos.OrchestratorAction_CompleteOrchestration{
+			CompleteOrchestration: &protos.CompleteOrchestrationAction{
+				OrchestrationStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED,
+				FailureDetails:      failureDetails,
+			},
+		},
+	}}, nil); err != nil {
+		return fmt.Errorf("failed to fail workflow: %w", err)
+	}
+
+	updates, err := o.callPendingMessages(ctx, rs)
+	if err != nil {
+		return err
+	}
+
+	state.ApplyRuntimeStateChanges(rs)
+	state.ClearInbox()
+
+	if err := o.saveInternalState(ctx, state); err != nil {
+		return err
+	}
+	o.respondToUpdates(updates)
+	o.indexSearchAttributes(ctx, rs, state, prevStatus)
+	return nil
+}
+
+// taskScheduledEvent returns the event which scheduled the activity task with
+// the given ID, or nil.
+func taskScheduledEvent(state *wfenginestate.State, taskID int32) *backend.HistoryEvent {
+	for _, e := range state.History {
+		if e.GetTaskScheduled() != nil && e.GetEventId() == taskID {
+			return e
+		}
+	}
+	return nil
+}
+
+// isTaskPending returns true if the activity task with the given ID has been
+// scheduled and no result has been received for it yet.
+func isTaskPending(state *wfenginestate.State, taskID int32) bool {
+	if taskScheduledEvent(state, taskID) == nil {
+		return false
+	}
+	for _, e := range state.History {
+		if hasTaskResult(e, taskID) {
+			return false
+		}
+	}
+	for _, e := range state.Inbox {
+		if hasTaskResult(e, taskID) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasTaskResult(e *backend.HistoryEvent, taskID int32) bool {
+	id, ok := taskResultID(e)
+	return ok && id == taskID
+}
+
+// taskResultID returns the ID of the activity task of which the event is the
+// result, if it is one.
+func taskResultID(e *backend.HistoryEvent) (int32, bool) {
+	if tc := e.GetTaskCompleted(); tc != nil {
+		return tc.GetTaskScheduledId(), true
+	}
+	if tf := e.GetTaskFailed(); tf != nil {
+		return tf.GetTaskScheduledId(), true
+	}
+	return 0, false
+}
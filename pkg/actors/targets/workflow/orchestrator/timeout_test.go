@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestTimeoutOption(t *testing.T) {
+	es := &protos.ExecutionStartedEvent{Tags: map[string]string{
+		OptionWorkflowTimeout:             "1h",
+		OptionActivityStartToCloseTimeout: "notaduration",
+		OptionActivityHeartbeatTimeout:    "-5s",
+	}}
+
+	assert.Equal(t, time.Hour, timeoutOption(es, OptionWorkflowTimeout))
+	assert.Zero(t, timeoutOption(es, OptionActivityStartToCloseTimeout))
+	assert.Zero(t, timeoutOption(es, OptionActivityHeartbeatTimeout))
+	assert.Zero(t, timeoutOption(nil, OptionWorkflowTimeout))
+}
+
+func TestActivityTimeoutMetadata(t *testing.T) {
+	assert.Nil(t, activityTimeoutMetadata(&protos.ExecutionStartedEvent{}))
+
+	es := &protos.ExecutionStartedEvent{Tags: map[string]string{
+		OptionWorkflowTimeout:             "1h",
+		OptionActivityStartToCloseTimeout: "90s",
+		OptionActivityHeartbeatTimeout:    "10s",
+	}}
+	assert.Equal(t, map[string][]string{
+		todo.ActivityStartToCloseTimeoutMetadata: {"1m30s"},
+		todo.ActivityHeartbeatTimeoutMetadata:    {"10s"},
+	}, activityTimeoutMetadata(es))
+}
+
+func TestIsTaskPending(t *testing.T) {
+	scheduled := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId:   id,
+			EventType: &protos.HistoryEvent_TaskScheduled{TaskScheduled: &protos.TaskScheduledEvent{Name: "act"}},
+		}
+	}
+	completed := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId:   -1,
+			EventType: &protos.HistoryEvent_TaskCompleted{TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: id}},
+		}
+	}
+	failed := func(id int32) *backend.HistoryEvent {
+		return &backend.HistoryEvent{
+			EventId:   -1,
+			EventType: &protos.HistoryEvent_TaskFailed{TaskFailed: &protos.TaskFailedEvent{TaskScheduledId: id}},
+		}
+	}
+
+	state := wfenginestate.NewState(wfenginestate.Options{})
+	state.AddToHistory(scheduled(0))
+	state.AddToHistory(scheduled(1))
+	state.AddToHistory(scheduled(2))
+	state.AddToHistory(completed(0))
+	state.AddToInbox(failed(1))
+
+	assert.False(t, isTaskPending(state, 0))
+	assert.False(t, isTaskPending(state, 1))
+	assert.True(t, isTaskPending(state, 2))
+	assert.False(t, isTaskPending(state, 3))
+
+	id, ok := taskResultID(failed(1))
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), id)
+	_, ok = taskResultID(scheduled(1))
+	assert.False(t, ok)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+// signalWithStart delivers an event to the workflow, first creating the
+// workflow from the given start event if it does not exist or is completed.
+// The request is a history chunk holding the execution started event followed
+// by the event raised event.
+func (o *orchestrator) signalWithStart(ctx context.Context, request []byte) error {
+	var chunk protos.HistoryChunk
+	if err := proto.Unmarshal(request, &chunk); err != nil {
+		return fmt.Errorf("failed to unmarshal signal with start request: %w", err)
+	}
+
+	events := chunk.GetEvents()
+	if len(events) != 2 || events[0].GetExecutionStarted() == nil || events[1].GetEventRaised() == nil {
+		return errors.New("invalid signal with start request")
+	}
+	startEvent, e := events[0], events[1]
+
+	state, _, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state != nil && !runtimestate.IsCompleted(o.rstate) {
+		log.Debugf("Workflow actor '%s': signaling running workflow with event '%s'", o.actorID, e.GetEventRaised().GetName())
+		return o.addToInbox(ctx, state, e)
+	}
+
+	log.Debugf("Workflow actor '%s': starting workflow '%s' to signal it with event '%s'", o.actorID, startEvent.GetExecutionStarted().GetName(), e.GetEventRaised().GetName())
+	o.recordVersion(startEvent.GetExecutionStarted())
+	if state == nil {
+		return o.scheduleWorkflowStart(ctx, startEvent, o.newWorkflowState(startEvent), e)
+	}
+	return o.createIfCompleted(ctx, o.rstate, state, startEvent, e)
+}
+
+// handleUpdateStream delivers the update event of the request to the workflow
+// and streams the response of the workflow back to the caller. The workflow
+// responds to an update by sending an event with the name of the update to its
+// own instance. Concurrent updates with the same name are responded to in the
+// order they were delivered.
+func (o *orchestrator) handleUpdateStream(ctx context.Context, req *internalsv1pb.InternalInvokeRequest, stream chan<- *internalsv1pb.InternalInvokeResponse) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var e backend.HistoryEvent
+	if err := proto.Unmarshal(req.GetMessage().GetData().GetValue(), &e); err != nil {
+		return fmt.Errorf("failed to unmarshal update event: %w", err)
+	}
+	name := e.GetEventRaised().GetName()
+	if len(name) == 0 {
+		return errors.New("the update event must be a named EventRaisedEvent")
+	}
+
+	respCh := make(chan *protos.EventSentEvent, 1)
+	ometaCh := make(chan *backend.OrchestrationMetadata)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case o.lock <- struct{}{}:
+	}
+
+	err := o.deliverUpdate(ctx, &e, respCh, ometaCh)
+	<-o.lock
+	if err != nil {
+		return err
+	}
+	defer o.removeUpdateWaiter(name, respCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-o.closeCh:
+			return fmt.Errorf("workflow actor '%s' was deactivated before responding to update '%s'", o.actorID, name)
+		case ometa := <-ometaCh:
+			if !api.OrchestrationMetadataIsComplete(ometa) {
+				continue
+			}
+			// The workflow may have responded in its final execution.
+			select {
+			case resp := <-respCh:
+				return sendUpdateResponse(ctx, stream, resp)
+			default:
+				return fmt.Errorf("workflow '%s' completed before responding to update '%s'", o.actorID, name)
+			}
+		case resp := <-respCh:
+			return sendUpdateResponse(ctx, stream, resp)
+		}
+	}
+}
+
+func sendUpdateResponse(ctx context.Context, stream chan<- *internalsv1pb.InternalInvokeResponse, resp *protos.EventSentEvent) error {
+	d, err := anypb.New(resp)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case stream <- &internalsv1pb.InternalInvokeResponse{
+		Status:  &internalsv1pb.Status{Code: http.StatusOK},
+		Message: &commonv1pb.InvokeResponse{Data: d},
+	}:
+		return nil
+	}
+}
+
+// deliverUpdate registers the waiter of the update and adds the update event
+// to the inbox of the running workflow. Must be called with the actor lock
+// held.
+func (o *orchestrator) deliverUpdate(ctx context.Context, e *backend.HistoryEvent, respCh chan *protos.EventSentEvent, ometaCh chan *backend.OrchestrationMetadata) error {
+	state, _, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return api.ErrInstanceNotFound
+	}
+	if runtimestate.IsCompleted(o.rstate) {
+		return fmt.Errorf("workflow '%s' is not running", o.actorID)
+	}
+
+	name := e.GetEventRaised().GetName()
+	o.updatesLock.Lock()
+	if o.updates == nil {
+		o.updates = make(map[string][]chan *protos.EventSentEvent)
+	}
+	o.updates[name] = append(o.updates[name], respCh)
+	o.updatesLock.Unlock()
+
+	o.ometaBroadcaster.Subscribe(ctx, ometaCh)
+
+	if err = o.addToInbox(ctx, state, e); err != nil {
+		o.removeUpdateWaiter(name, respCh)
+		return err
+	}
+	return nil
+}
+
+func (o *orchestrator) removeUpdateWaiter(name string, respCh chan *protos.EventSentEvent) {
+	o.updatesLock.Lock()
+	defer o.updatesLock.Unlock()
+	o.updates[name] = slices.DeleteFunc(o.updates[name], func(ch chan *protos.EventSentEvent) bool {
+		return ch == respCh
+	})
+	if len(o.updates[name]) == 0 {
+		delete(o.updates, name)
+	}
+}
+
+// respondToUpdates hands the responses of the workflow to updates to their
+// waiters. Must only be called once the state of the workflow which holds the
+// responses is saved, so that callers are never told about an update which is
+// then lost.
+func (o *orchestrator) respondToUpdates(resps []*protos.EventSentEvent) {
+	for _, resp := range resps {
+		o.respondToUpdate(resp)
+	}
+}
+
+// respondToUpdate hands an event sent by the workflow to its own instance to
+// the oldest waiter of the update with the same name. Responses to updates
+// which no longer have a waiter, for example because the caller gave up, are
+// dropped.
+func (o *orchestrator) respondToUpdate(resp *protos.EventSentEvent) {
+	o.updatesLock.Lock()
+	defer o.updatesLock.Unlock()
+
+	waiters := o.updates[resp.GetName()]
+	if len(waiters) == 0 {
+		log.Debugf("Workflow actor '%s': dropping response to update '%s' which has no waiter", o.actorID, resp.GetName())
+		return
+	}
+
+	waiters[0] <- resp
+	if len(waiters) == 1 {
+		delete(o.updates, resp.GetName())
+	} else {
+		o.updates[resp.GetName()] = waiters[1:]
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestRespondToUpdate(t *testing.T) {
+	o := &orchestrator{
+		updates: make(map[string][]chan *protos.EventSentEvent),
+	}
+
+	ch1 := make(chan *protos.EventSentEvent, 1)
+	ch2 := make(chan *protos.EventSentEvent, 1)
+	ch3 := make(chan *protos.EventSentEvent, 1)
+	o.updates["set"] = append(o.updates["set"], ch1, ch2, ch3)
+
+	t.Run("responses go to the oldest waiter", func(t *testing.T) {
+		o.respondToUpdate(&protos.EventSentEvent{Name: "set", Input: wrapperspb.String("1")})
+		assert.Equal(t, "1", (<-ch1).GetInput().GetValue())
+		assert.Len(t, o.updates["set"], 2)
+	})
+
+	t.Run("waiters which gave up are removed", func(t *testing.T) {
+		o.removeUpdateWaiter("set", ch2)
+		o.respondToUpdate(&protos.EventSentEvent{Name: "set", Input: wrapperspb.String("3")})
+		assert.Equal(t, "3", (<-ch3).GetInput().GetValue())
+		assert.Empty(t, ch2)
+		assert.NotContains(t, o.updates, "set")
+	})
+
+	t.Run("responses without a waiter are dropped", func(t *testing.T) {
+		o.respondToUpdate(&protos.EventSentEvent{Name: "other"})
+		assert.Empty(t, o.updates)
+	})
+}
+
+func TestCallPendingMessagesDefersUpdateResponses(t *testing.T) {
+	o := &orchestrator{
+		actorID: "wf1",
+		updates: make(map[string][]chan *protos.EventSentEvent),
+	}
+
+	ch := make(chan *protos.EventSentEvent, 1)
+	o.updates["set"] = append(o.updates["set"], ch)
+
+	rs := &backend.OrchestrationRuntimeState{
+		PendingMessages: []*backend.OrchestrationRuntimeStateMessage{{
+			TargetInstanceID: "wf1",
+			HistoryEvent: &backend.HistoryEvent{
+				EventType: &protos.HistoryEvent_EventSent{
+					EventSent: &protos.EventSentEvent{InstanceId: "wf1", Name: "set", Input: wrapperspb.String("1")},
+				},
+			},
+		}},
+	}
+
+	// The response is only handed to the waiter once the state holding it is
+	// saved.
+	updates, err := o.callPendingMessages(t.Context(), rs)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Empty(t, ch)
+
+	o.respondToUpdates(updates)
+	assert.Equal(t, "1", (<-ch).GetInput().GetValue())
+	assert.Empty(t, o.updates)
+}
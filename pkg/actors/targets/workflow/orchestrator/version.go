@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+// ErrorTypeNoCompatibleWorker is the error type of the failure details
+// reported in the workflow metadata while no worker compatible with the
+// version of the workflow is connected. The workflow is not failed and is
+// executed once a compatible worker connects.
+const ErrorTypeNoCompatibleWorker = "DaprNoCompatibleWorker"
+
+// Versions reports the workflow versions of the workers currently connected.
+type Versions interface {
+	// Compatible returns the versions which every connected worker is able to
+	// replay. Versions are not checked if nil.
+	Compatible() []string
+
+	// Current returns the version recorded for new workflows started without
+	// an explicit version, or empty if workers are not versioned.
+	Current() string
+}
+
+// recordVersion records the current worker version in the execution started
+// event of a new workflow, unless the workflow was started with an explicit
+// version.
+func (o *orchestrator) recordVersion(es *protos.ExecutionStartedEvent) {
+	if es == nil || es.GetVersion() != nil || o.versions == nil {
+		return
+	}
+	if version := o.versions.Current(); len(version) > 0 {
+		es.Version = wrapperspb.String(version)
+	}
+}
+
+// isVersionCompatible returns true if the connected workers are able to replay
+// workflows of the given version.
+func (o *orchestrator) isVersionCompatible(version string) bool {
+	if len(version) == 0 || o.versions == nil {
+		return true
+	}
+	compatible := o.versions.Compatible()
+	return compatible == nil || slices.Contains(compatible, version)
+}
+
+// reportNoCompatibleWorker persists that the workflow is waiting for a worker
+// compatible with its version, so that it is reported in the workflow
+// metadata until a compatible worker executes it.
+func (o *orchestrator) reportNoCompatibleWorker(ctx context.Context, state *wfenginestate.State, version string) error {
+	var compatible []string
+	if o.versions != nil {
+		compatible = o.versions.Compatible()
+	}
+
+	changed := state.SetStalled(&protos.TaskFailureDetails{
+		ErrorType:    ErrorTypeNoCompatibleWorker,
+		ErrorMessage: fmt.Sprintf("no connected worker is compatible with workflow version '%s', compatible worker versions: %v", version, compatible),
+	})
+	if !changed {
+		return nil
+	}
+
+	return o.saveInternalState(ctx, state)
+}
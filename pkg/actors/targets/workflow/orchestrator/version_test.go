@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+type fakeVersions struct {
+	compatible []string
+	current    string
+}
+
+func (f *fakeVersions) Compatible() []string { return f.compatible }
+func (f *fakeVersions) Current() string      { return f.current }
+
+func TestVersions(t *testing.T) {
+	o := &orchestrator{
+		versions: &fakeVersions{compatible: []string{"v1", "v2"}, current: "v2"},
+	}
+
+	t.Run("worker version is recorded for new workflows", func(t *testing.T) {
+		es := new(protos.ExecutionStartedEvent)
+		o.recordVersion(es)
+		assert.Equal(t, "v2", es.GetVersion().GetValue())
+
+		es = &protos.ExecutionStartedEvent{Version: wrapperspb.String("v1")}
+		o.recordVersion(es)
+		assert.Equal(t, "v1", es.GetVersion().GetValue())
+
+		es = new(protos.ExecutionStartedEvent)
+		(&orchestrator{}).recordVersion(es)
+		assert.Nil(t, es.GetVersion())
+	})
+
+	t.Run("compatible versions", func(t *testing.T) {
+		assert.True(t, o.isVersionCompatible(""))
+		assert.True(t, o.isVersionCompatible("v1"))
+		assert.True(t, o.isVersionCompatible("v2"))
+		assert.False(t, o.isVersionCompatible("v3"))
+		assert.True(t, (&orchestrator{}).isVersionCompatible("v3"))
+		assert.False(t, (&orchestrator{versions: &fakeVersions{compatible: []string{}}}).isVersionCompatible("v1"))
+	})
+
+	t.Run("no compatible worker is only saved once", func(t *testing.T) {
+		state := wfenginestate.NewState(wfenginestate.Options{})
+		state.SetStalled(&protos.TaskFailureDetails{
+			ErrorType:    ErrorTypeNoCompatibleWorker,
+			ErrorMessage: "no connected worker is compatible with workflow version 'v3', compatible worker versions: [v1 v2]",
+		})
+
+		// The state is unchanged so is not saved again.
+		require.NoError(t, o.reportNoCompatibleWorker(t.Context(), state, "v3"))
+	})
+
+	t.Run("stalled reason is reported while the workflow is running", func(t *testing.T) {
+		state := wfenginestate.NewState(wfenginestate.Options{})
+		assert.True(t, state.SetStalled(&protos.TaskFailureDetails{ErrorType: ErrorTypeNoCompatibleWorker}))
+		assert.False(t, state.SetStalled(&protos.TaskFailureDetails{ErrorType: ErrorTypeNoCompatibleWorker}))
+
+		details := state.StalledFailureDetails(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING)
+		assert.Equal(t, ErrorTypeNoCompatibleWorker, details.GetErrorType())
+		assert.Nil(t, state.StalledFailureDetails(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED))
+
+		assert.True(t, state.SetStalled(nil))
+		assert.Nil(t, state.StalledFailureDetails(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING))
+	})
+}
@@ -20,14 +20,18 @@ import (
 )
 
 type Fake struct {
-	createFn func(context.Context, *api.CreateTimerRequest) error
-	deleteFn func(context.Context, *api.DeleteTimerRequest)
+	createFn    func(context.Context, *api.CreateTimerRequest) error
+	deleteFn    func(context.Context, *api.DeleteTimerRequest)
+	deleteAllFn func(context.Context, *api.DeleteAllTimersRequest) error
 }
 
 func New() *Fake {
 	return &Fake{
 		createFn: func(context.Context, *api.CreateTimerRequest) error { return nil },
 		deleteFn: func(context.Context, *api.DeleteTimerRequest) {},
+		deleteAllFn: func(context.Context, *api.DeleteAllTimersRequest) error {
+			return nil
+		},
 	}
 }
 
@@ -41,6 +45,11 @@ func (f *Fake) WithDeleteFn(fn func(context.Context, *api.DeleteTimerRequest)) *
 	return f
 }
 
+func (f *Fake) WithDeleteAllFn(fn func(context.Context, *api.DeleteAllTimersRequest) error) *Fake {
+	f.deleteAllFn = fn
+	return f
+}
+
 func (f *Fake) Create(ctx context.Context, req *api.CreateTimerRequest) error {
 	return f.createFn(ctx, req)
 }
@@ -48,3 +57,7 @@ func (f *Fake) Create(ctx context.Context, req *api.CreateTimerRequest) error {
 func (f *Fake) Delete(ctx context.Context, req *api.DeleteTimerRequest) {
 	f.deleteFn(ctx, req)
 }
+
+func (f *Fake) DeleteAll(ctx context.Context, req *api.DeleteAllTimersRequest) error {
+	return f.deleteAllFn(ctx, req)
+}
@@ -15,28 +15,45 @@ package timers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/internal/reminders/storage"
 	internaltimers "github.com/dapr/dapr/pkg/actors/internal/timers"
 	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/kit/logger"
 )
 
+var log = logger.NewLogger("dapr.runtime.actors.timers")
+
+// ErrDurableTimersUnavailable is returned when a durable timer is created but
+// the scheduler is not used for actor reminders.
+var ErrDurableTimersUnavailable = errors.New("durable timers require scheduler actor reminders to be enabled")
+
 type Interface interface {
 	Create(ctx context.Context, req *api.CreateTimerRequest) error
 	Delete(ctx context.Context, req *api.DeleteTimerRequest)
+	// DeleteAll deletes all durable timers of the given actor.
+	DeleteAll(ctx context.Context, req *api.DeleteAllTimersRequest) error
 }
 
 type Options struct {
 	Storage internaltimers.Storage
 	Table   table.Interface
+
+	// Durable is the scheduler reminders storage used to store durable timers.
+	// Durable timers are unavailable if nil.
+	Durable storage.Interface
 }
 
 // Implements a timers provider.
 type timers struct {
 	storage internaltimers.Storage
+	durable storage.Interface
 	table   table.Interface
 	clock   clock.Clock
 }
@@ -44,6 +61,7 @@ type timers struct {
 func New(opts Options) Interface {
 	return &timers{
 		storage: opts.Storage,
+		durable: opts.Durable,
 		table:   opts.Table,
 		clock:   clock.RealClock{},
 	}
@@ -61,9 +79,65 @@ func (t *timers) Create(ctx context.Context, req *api.CreateTimerRequest) error
 
 	reminder.IsTimer = true
 
-	return t.storage.Create(ctx, reminder)
+	if !req.Durable {
+		return t.storage.Create(ctx, reminder)
+	}
+
+	if t.durable == nil {
+		return ErrDurableTimersUnavailable
+	}
+
+	durable, err := req.NewDurableReminder()
+	if err != nil {
+		return err
+	}
+
+	// Replace any in-memory timer of the same name.
+	t.storage.Delete(ctx, req.Key())
+
+	return t.durable.Create(ctx, durable)
 }
 
 func (t *timers) Delete(ctx context.Context, req *api.DeleteTimerRequest) {
-	t.storage.Delete(ctx, req.Key())
+	if !req.Durable {
+		t.storage.Delete(ctx, req.Key())
+		return
+	}
+
+	if t.durable == nil {
+		return
+	}
+
+	if err := t.durable.Delete(ctx, req.DurableReminder()); err != nil {
+		log.Warnf("Failed to delete durable timer %s: %s", req.Key(), err)
+	}
+}
+
+func (t *timers) DeleteAll(ctx context.Context, req *api.DeleteAllTimersRequest) error {
+	if t.durable == nil {
+		return nil
+	}
+
+	reminders, err := t.durable.List(ctx, &api.ListRemindersRequest{
+		ActorType: req.ActorType,
+		ActorID:   req.ActorID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list durable timers of actor %s: %w", req.ActorKey(), err)
+	}
+
+	var errs []error
+	for _, reminder := range reminders {
+		if reminder == nil || !strings.HasPrefix(reminder.Name, api.DurableTimerPrefix) {
+			continue
+		}
+
+		errs = append(errs, t.durable.Delete(ctx, &api.DeleteReminderRequest{
+			Name:      reminder.Name,
+			ActorType: reminder.ActorType,
+			ActorID:   reminder.ActorID,
+		}))
+	}
+
+	return errors.Join(errs...)
 }
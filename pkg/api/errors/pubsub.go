@@ -105,6 +105,69 @@ func (p *PubSubError) TestNotFound(topic string, err error) error {
 	)
 }
 
+func (p *PubSubError) Redrive(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when redriving dead-letter topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubSubRedrive,
+	)
+}
+
+func (p *PubSubError) RedriveRequest(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid request to redrive dead-letter topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubSubRedriveRequest,
+	)
+}
+
+func (p *PubSubError) RedriveSubscribed(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.FailedPrecondition,
+		http.StatusConflict,
+		fmt.Sprintf("cannot redrive dead-letter topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubSubRedriveSubscribed,
+	)
+}
+
+func (p *PubSubError) SchemaValidation(topic string, err error) error {
+	return p.withTopicError(topic, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid payload for topic %s in pubsub %s: %s", topic, p.name, err),
+		errorcodes.PubSubSchemaValidation,
+	)
+}
+
+func (p *PubSubError) Seek(subscription string, err error) error {
+	return p.withTopicError(subscription, err).build(
+		codes.Internal,
+		http.StatusInternalServerError,
+		fmt.Sprintf("error when seeking subscription %s: %s", subscription, err),
+		errorcodes.PubSubSeek,
+	)
+}
+
+func (p *PubSubError) SeekRequest(subscription string, err error) error {
+	return p.withTopicError(subscription, err).build(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		fmt.Sprintf("invalid request to seek subscription %s: %s", subscription, err),
+		errorcodes.PubSubSeekRequest,
+	)
+}
+
+func (p *PubSubError) SeekNotSupported(subscription string, err error) error {
+	return p.withTopicError(subscription, err).build(
+		codes.Unimplemented,
+		http.StatusBadRequest,
+		fmt.Sprintf("pubsub %s does not support seeking subscriptions: only pluggable components which advertise the SEEK feature can seek", p.name),
+		errorcodes.PubSubSeekNotSupported,
+	)
+}
+
 func (p *PubSubMetadataError) NotFound() error {
 	p.skipResourceInfo = true
 	return p.build(
@@ -233,3 +296,16 @@ func PubSubOutbox(appID string, err error) error {
 		"appID": appID, "error": err.Error(),
 	}).Build()
 }
+
+func PubSubOutboxMessage(appID string, err error) error {
+	message := fmt.Sprintf("invalid outbox message: %s", err)
+	return errors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		message,
+		errorcodes.PubsubOutboxMessage.Code,
+		string(errorcodes.CategoryPubsub),
+	).WithErrorInfo(errorcodes.PubsubOutboxMessage.GrpcCode, map[string]string{
+		"appID": appID, "error": err.Error(),
+	}).Build()
+}
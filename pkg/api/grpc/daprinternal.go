@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,6 +36,8 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wfbeactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
+	"github.com/dapr/dapr/pkg/security/spiffe"
 	"github.com/dapr/kit/concurrency"
 )
 
@@ -274,6 +277,10 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 // CallActor invokes a virtual actor.
 func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	// We don't do resiliency here as it is handled in the API layer. See InvokeActor().
+	if err := a.callActorValidateACL(ctx, in); err != nil {
+		return nil, err
+	}
+
 	var res *internalv1pb.InternalInvokeResponse
 	router, err := a.ActorRouter(ctx)
 	if err == nil {
@@ -327,6 +334,10 @@ func (a *api) CallActorReminder(ctx context.Context, in *internalv1pb.Reminder)
 }
 
 func (a *api) CallActorStream(req *internalv1pb.InternalInvokeRequest, stream internalv1pb.ServiceInvocation_CallActorStreamServer) error {
+	if err := a.callActorValidateACL(stream.Context(), req); err != nil {
+		return err
+	}
+
 	router, err := a.ActorRouter(stream.Context())
 	if err != nil {
 		return err
@@ -382,6 +393,37 @@ func (a *api) callLocalValidateACL(ctx context.Context, req *invokev1.InvokeMeth
 	return nil
 }
 
+// Used by CallActor and CallActorStream to check calls made by other apps to
+// the workflow and activity actors of this app against the access control
+// list. Such calls are made by workflows which schedule activities or child
+// workflows on this app, and are checked as the operation
+// `/dapr.workflows/<workflow|activity>/<method>`.
+func (a *api) callActorValidateACL(ctx context.Context, req *internalv1pb.InternalInvokeRequest) error {
+	if a.accessControlList == nil {
+		return nil
+	}
+
+	prefix := wfbeactors.ActorTypePrefix + a.Universal.Namespace() + "." + a.Universal.AppID() + "."
+	kind, ok := strings.CutPrefix(req.GetActor().GetActorType(), prefix)
+	if !ok || (kind != wfbeactors.WorkflowNameLabelKey && kind != wfbeactors.ActivityNameLabelKey) {
+		return nil
+	}
+
+	// Calls between the replicas of this app are always allowed.
+	if id, ok, err := spiffe.FromGRPCContext(ctx); err == nil && ok &&
+		id.AppID() == a.Universal.AppID() && id.Namespace() == a.Universal.Namespace() {
+		return nil
+	}
+
+	operation := "/dapr.workflows/" + kind + "/" + req.GetMessage().GetMethod()
+	callAllowed, errMsg := acl.ApplyAccessControlPolicies(ctx, operation, commonv1pb.HTTPExtension_NONE, false, a.accessControlList) //nolint:nosnakecase
+	if !callAllowed {
+		return status.Error(codes.PermissionDenied, errMsg)
+	}
+
+	return nil
+}
+
 // Internal function that records the received request for diagnostics
 // After invoking this method, make sure to `defer` a call like:
 //
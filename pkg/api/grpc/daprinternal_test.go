@@ -26,6 +26,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/api/universal"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
@@ -242,3 +243,37 @@ func TestCallActorWithTracing(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, resp.GetMessage(), "failed to generate trace context with actor call")
 }
+
+func TestCallActorValidateACL(t *testing.T) {
+	fakeAPI := &api{
+		Universal: universal.New(universal.Options{
+			AppID:     "fakeAPI",
+			Namespace: "ns1",
+		}),
+		accessControlList: &config.AccessControlList{
+			DefaultAction: config.DenyAccess,
+			TrustDomain:   "public",
+		},
+	}
+
+	t.Run("workflow actor calls are checked against the access control list", func(t *testing.T) {
+		for _, actorType := range []string{"dapr.internal.ns1.fakeAPI.workflow", "dapr.internal.ns1.fakeAPI.activity"} {
+			req := internalv1pb.NewInternalInvokeRequest("Execute").WithActor(actorType, "id")
+			err := fakeAPI.callActorValidateACL(t.Context(), req)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), actorType)
+		}
+	})
+
+	t.Run("other actor calls are not checked", func(t *testing.T) {
+		for _, actorType := range []string{"myactor", "dapr.internal.ns1.otherapp.workflow", "dapr.internal.ns1.fakeAPI.other"} {
+			req := internalv1pb.NewInternalInvokeRequest("Execute").WithActor(actorType, "id")
+			require.NoError(t, fakeAPI.callActorValidateACL(t.Context(), req), actorType)
+		}
+	})
+
+	t.Run("no access control list", func(t *testing.T) {
+		noACL := &api{Universal: fakeAPI.Universal}
+		req := internalv1pb.NewInternalInvokeRequest("Execute").WithActor("dapr.internal.ns1.fakeAPI.workflow", "id")
+		require.NoError(t, noACL.callActorValidateACL(t.Context(), req))
+	})
+}
@@ -47,6 +47,9 @@ var endpoints = map[string][]string{
 	"publish.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/BulkPublishEventAlpha1",
 	},
+	"redrive.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/RedriveDeadLetterTopicAlpha1",
+	},
 	"bindings.v1": {
 		daprRuntimePrefix + "v1.Dapr/InvokeBinding",
 	},
@@ -63,6 +66,10 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/ExecuteActorStateTransaction",
 		daprRuntimePrefix + "v1.Dapr/InvokeActor",
 	},
+	"actors.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/MigrateActorAlpha1",
+		daprRuntimePrefix + "v1.Dapr/UnregisterActorTimersAlpha1",
+	},
 	"metadata.v1": {
 		daprRuntimePrefix + "v1.Dapr/GetMetadata",
 		daprRuntimePrefix + "v1.Dapr/SetMetadata",
@@ -104,6 +111,12 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/PurgeWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/PauseWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/ResumeWorkflowAlpha1",
+		daprRuntimePrefix + "v1.Dapr/WorkflowActivityHeartbeatAlpha1",
+		daprRuntimePrefix + "v1.Dapr/SignalWithStartWorkflowAlpha1",
+		daprRuntimePrefix + "v1.Dapr/UpdateWorkflowAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ListWorkflowsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/GetWorkflowHistoryAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ReplayWorkflowAlpha1",
 	},
 	"workflows.v1beta1": {
 		daprRuntimePrefix + "v1.Dapr/StartWorkflowBeta1",
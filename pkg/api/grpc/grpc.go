@@ -42,6 +42,7 @@ import (
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/state"
 	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/callchain"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/metadata"
@@ -64,6 +65,7 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 	"github.com/dapr/kit/logger"
@@ -166,6 +168,10 @@ func (a *api) validateAndGetPubsubAndTopic(pubsubName, topic string, reqMeta map
 		err = apierrors.PubSub(pubsubName).WithMetadata(reqMeta).DeserializeError(metaErr)
 		return nil, "", "", false, err
 	}
+	if _, _, metaErr = runtimePubsub.DeliveryTime(reqMeta, time.Now()); metaErr != nil {
+		err = apierrors.PubSub(pubsubName).WithMetadata(reqMeta).DeserializeError(metaErr)
+		return nil, "", "", false, err
+	}
 
 	return thepubsub.Component, pubsubName, topic, rawPayload, nil
 }
@@ -239,6 +245,8 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &schema.ValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
@@ -935,6 +943,17 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 
 	operations := make([]state.TransactionalStateOperation, 0, len(in.GetOperations()))
 	for _, inputReq := range in.GetOperations() {
+		if state.OperationType(inputReq.GetOperationType()) == outbox.OperationPublish {
+			msg, err := a.outboxMessage(in.GetStoreName(), inputReq.GetMessage())
+			if err != nil {
+				apiServerLogger.Debug(err)
+				return &emptypb.Empty{}, err
+			}
+
+			operations = append(operations, msg)
+			continue
+		}
+
 		req := inputReq.GetRequest()
 
 		hasEtag, etag := extractEtag(req)
@@ -1054,6 +1073,31 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 	return &emptypb.Empty{}, nil
 }
 
+// outboxMessage returns the message of a publish operation, after checking it
+// can be published through the outbox of the state store.
+func (a *api) outboxMessage(storeName string, in *runtimev1pb.TransactionalOutboxMessage) (outbox.Message, error) {
+	msg := outbox.Message{
+		Topic:       in.GetTopic(),
+		Data:        in.GetData(),
+		ContentType: in.GetContentType(),
+		Metadata:    in.GetMetadata(),
+	}
+	if err := outbox.ValidateMessage(a.outbox, storeName, msg); err != nil {
+		return msg, apierrors.PubSubOutboxMessage(a.AppID(), err)
+	}
+
+	err := runtimePubsub.IsOutboxMessageAllowed(a.outbox, a.Universal.CompStore().GetPubSub, storeName, msg.Topic, a.AppID())
+	if err != nil {
+		pubsubName, _ := a.outbox.PublishPubSub(storeName)
+		if errors.As(err, &runtimePubsub.NotAllowedError{}) {
+			return msg, apierrors.PubSub(pubsubName).PublishForbidden(msg.Topic, a.AppID(), err)
+		}
+		return msg, apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+	}
+
+	return msg, nil
+}
+
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	astate, err := a.ActorState(ctx)
 	if err != nil {
@@ -1173,6 +1217,9 @@ func (a *api) InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorReques
 
 	req := in.ToInternalInvokeRequest()
 
+	// Carry the actor call chain the app sent in the gRPC metadata, if any.
+	callchain.Propagate(ctx, req)
+
 	// Unlike other actor calls, resiliency is handled here for invocation.
 	// This is due to actor invocation involving a lookup for the host.
 	policyDef := a.Universal.Resiliency().ActorPreLockPolicy(in.GetActorType(), in.GetActorId())
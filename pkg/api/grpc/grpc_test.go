@@ -65,6 +65,7 @@ import (
 	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/messages"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/outbox"
 	outboxfake "github.com/dapr/dapr/pkg/outbox/fake"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
@@ -72,6 +73,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	wfenginefake "github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
@@ -2373,6 +2375,10 @@ func TestPublishTopic(t *testing.T) {
 					return runtimePubsub.NotAllowedError{Topic: req.Topic, ID: "test"}
 				}
 
+				if req.Topic == "err-schema" {
+					return schema.ValidationError{Topic: req.Topic, Err: errors.New("missing properties: 'orderId'")}
+				}
+
 				return nil
 			},
 			BulkPublishFn: func(ctx context.Context, req *pubsub.BulkPublishRequest) (pubsub.BulkPublishResponse, error) {
@@ -2459,6 +2465,14 @@ func TestPublishTopic(t *testing.T) {
 		assert.Equal(t, codes.PermissionDenied, status.Code(err))
 	})
 
+	t.Run("err: publish event request with payload not matching the schema", func(t *testing.T) {
+		_, err := client.PublishEvent(t.Context(), &runtimev1pb.PublishEventRequest{
+			PubsubName: "pubsub",
+			Topic:      "err-schema",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("err: empty bulk publish event request", func(t *testing.T) {
 		_, err := client.BulkPublishEventAlpha1(t.Context(), &runtimev1pb.BulkPublishRequest{})
 		assert.Equal(t, codes.InvalidArgument, status.Code(err))
@@ -2550,6 +2564,64 @@ func TestPublishTopic(t *testing.T) {
 	})
 }
 
+func TestRedriveDeadLetterTopic(t *testing.T) {
+	srv := &api{
+		logger: logger.NewLogger("grpc.api.test"),
+		Universal: universal.New(universal.Options{
+			AppID:         "fakeAPI",
+			Logger:        logger.NewLogger("fakeLogger"),
+			CompStore:     compstore.New(),
+			PubSubAdapter: &daprt.MockPubSubAdapter{},
+		}),
+	}
+
+	mockPubSub := daprt.MockPubSub{}
+	mockPubSub.On("Subscribe", mock.Anything, mock.Anything).Return(errors.New("subscribe failed"))
+	srv.Universal.CompStore().AddPubSub("pubsub", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+	srv.Universal.CompStore().AddPubSub("scoped", &runtimePubsub.PubsubItem{Component: &mockPubSub, ScopedSubscriptions: []string{"other"}})
+
+	lis := startTestServerAPI(t, srv)
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("err: empty dead-letter topic", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName: "pubsub",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: invalid filter", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "pubsub",
+			DeadLetterTopic: "dlq",
+			Filter:          "event.type ==",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("err: subscription not allowed", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "scoped",
+			DeadLetterTopic: "dlq",
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("err: redrive error", func(t *testing.T) {
+		_, err := client.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "pubsub",
+			DeadLetterTopic: "dlq",
+			DryRun:          true,
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Contains(t, err.Error(), "subscribe failed")
+	})
+}
+
 func TestBulkPublish(t *testing.T) {
 	fakeAPI := &api{
 		logger: logger.NewLogger("test"),
@@ -2836,6 +2908,19 @@ func TestExecuteStateTransaction(t *testing.T) {
 			errorExcepted: true,
 			expectedError: codes.InvalidArgument,
 		},
+		{
+			testName:  "publish operation without outbox",
+			storeName: "store1",
+			ops: []*runtimev1pb.TransactionalStateOperation{{
+				OperationType: string(outbox.OperationPublish),
+				Message: &runtimev1pb.TransactionalOutboxMessage{
+					Topic: "orders",
+					Data:  []byte("1"),
+				},
+			}},
+			errorExcepted: true,
+			expectedError: codes.InvalidArgument,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -2868,6 +2953,85 @@ func TestExecuteStateTransaction(t *testing.T) {
 	}
 }
 
+func TestExecuteStateTransactionOutboxMessage(t *testing.T) {
+	fakeStore := &daprt.TransactionalStoreMock{}
+	fakeStore.MaxOperations = 10
+	fakeStore.On("Multi", mock.MatchedBy(matchContextInterface), mock.Anything).Return(nil)
+
+	compStore := compstore.New()
+	compStore.AddStateStore("store1", fakeStore)
+	compStore.AddPubSub("outboxps", &runtimePubsub.PubsubItem{
+		Component:         &daprt.MockPubSub{},
+		ScopedPublishings: []string{"orders"},
+	})
+
+	var published []outbox.Message
+	fakeAPI := &api{
+		Universal: universal.New(universal.Options{
+			Logger:     logger.NewLogger("grpc.api.test"),
+			AppID:      "fakeAPI",
+			CompStore:  compStore,
+			Resiliency: resiliency.New(nil),
+		}),
+		pubsubAdapter: &daprt.MockPubSubAdapter{},
+		outbox: outboxfake.New().
+			WithEnabled(func(string) bool { return true }).
+			WithPublishPubSub(func(string) (string, bool) { return "outboxps", true }).
+			WithPublishInternal(func(_ context.Context, _ string, ops []state.TransactionalStateOperation, _, _, _ string) ([]state.TransactionalStateOperation, error) {
+				for _, op := range ops {
+					if msg, ok := op.(outbox.Message); ok {
+						published = append(published, msg)
+					}
+				}
+				return []state.TransactionalStateOperation{state.SetRequest{Key: "outbox-1", Value: "0"}}, nil
+			}),
+	}
+	lis := startDaprAPIServer(t, fakeAPI, "")
+
+	clientConn := createTestClient(lis)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	publish := func(msg *runtimev1pb.TransactionalOutboxMessage) error {
+		_, err := client.ExecuteStateTransaction(t.Context(), &runtimev1pb.ExecuteStateTransactionRequest{
+			StoreName: "store1",
+			Operations: []*runtimev1pb.TransactionalStateOperation{{
+				OperationType: string(outbox.OperationPublish),
+				Message:       msg,
+			}},
+		})
+		return err
+	}
+
+	t.Run("message is published", func(t *testing.T) {
+		require.NoError(t, publish(&runtimev1pb.TransactionalOutboxMessage{
+			Topic:       "orders",
+			Data:        []byte(`{"id":1}`),
+			ContentType: "application/json",
+			Metadata:    map[string]string{"foo": "bar"},
+		}))
+		require.Len(t, published, 1)
+		assert.Equal(t, outbox.Message{
+			Topic:       "orders",
+			Data:        []byte(`{"id":1}`),
+			ContentType: "application/json",
+			Metadata:    map[string]string{"foo": "bar"},
+		}, published[0])
+	})
+
+	t.Run("topic is required", func(t *testing.T) {
+		err := publish(&runtimev1pb.TransactionalOutboxMessage{Data: []byte("1")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("topic is not allowed", func(t *testing.T) {
+		err := publish(&runtimev1pb.TransactionalOutboxMessage{Topic: "payments", Data: []byte("1")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Len(t, published, 1)
+	})
+}
+
 func TestStateStoreErrors(t *testing.T) {
 	t.Run("save etag mismatch", func(t *testing.T) {
 		a := &api{}
@@ -18,7 +18,9 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
 
@@ -66,6 +68,27 @@ func (a *api) SubscribeTopicEventsAlpha1(stream runtimev1pb.Dapr_SubscribeTopicE
 	}
 
 	key := a.pubsubAdapterStreamer.StreamerKey(req.GetPubsubName(), req.GetTopic())
+
+	// The subscription replays messages from the position set in the initial
+	// request, if any.
+	if req.SeekTimestamp != nil || req.SeekOffset != nil {
+		seek := &pubsubLoader.SeekRequest{
+			Topic:    req.GetTopic(),
+			Offset:   req.GetSeekOffset(),
+			Metadata: req.GetMetadata(),
+		}
+		if req.SeekTimestamp != nil {
+			ts := req.GetSeekTimestamp().AsTime()
+			seek.Timestamp = &ts
+		}
+		if err = seek.Validate(); err != nil {
+			return apierrors.PubSub(req.GetPubsubName()).SeekRequest(key, err)
+		}
+		if err = a.processor.Subscriber().SeekStreamerSubscription(stream.Context(), req.GetPubsubName(), seek); err != nil {
+			return err
+		}
+	}
+
 	sub := &subapi.Subscription{
 		ObjectMeta: metav1.ObjectMeta{Name: key},
 		Spec: subapi.SubscriptionSpec{
@@ -76,6 +99,13 @@ func (a *api) SubscribeTopicEventsAlpha1(stream runtimev1pb.Dapr_SubscribeTopicE
 			Routes:          subapi.Routes{Default: "/"},
 		},
 	}
+	if t := req.GetTransform(); t != nil {
+		sub.Spec.Transform = &subapi.Transform{
+			Unwrap:  t.GetUnwrap(),
+			Fields:  t.GetFields(),
+			Headers: t.GetHeaders(),
+		}
+	}
 	connectionID := a.Universal.CompStore().NextSubscriberIndex()
 	err = a.Universal.CompStore().AddStreamSubscription(sub, connectionID)
 	if err != nil {
@@ -35,6 +35,7 @@ import (
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	kitstrings "github.com/dapr/kit/strings"
 )
 
 var endpointGroupActorV1State = &endpoints.EndpointGroup{
@@ -166,6 +167,26 @@ func (a *api) constructActorEndpoints() []endpoints.Endpoint {
 				Name: "GetActorReminder",
 			},
 		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "actors/{actorType}/{actorId}/migrate",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupActorV1Misc,
+			Handler: a.onMigrateActor(),
+			Settings: endpoints.EndpointSettings{
+				Name: "MigrateActor",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "actors/{actorType}/{actorId}/timers",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupActorV1Misc,
+			Handler: a.onDeleteActorTimers(),
+			Settings: endpoints.EndpointSettings{
+				Name: "UnregisterActorTimers",
+			},
+		},
 	}
 }
 
@@ -193,6 +214,12 @@ func (a *api) onCreateActorReminder(w http.ResponseWriter, r *http.Request) {
 
 	err = rem.Create(ctx, &req)
 	if err != nil {
+		if errors.Is(err, reminders.ErrReminderNameReserved) {
+			msg := messages.ErrActorReminderNameReserved.WithFormat(req.Name)
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
 			msg := messages.ErrActorReminderOpActorNotHosted
 			respondWithError(w, msg)
@@ -256,6 +283,19 @@ func (a *api) onDeleteActorReminder() http.HandlerFunc {
 	)
 }
 
+func (a *api) onMigrateActor() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.MigrateActorAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.MigrateActorRequestAlpha1, *emptypb.Empty]{
+			InModifier: func(r *http.Request, in *runtimev1pb.MigrateActorRequestAlpha1) (*runtimev1pb.MigrateActorRequestAlpha1, error) {
+				in.ActorType = chi.URLParam(r, actorTypeParam)
+				in.ActorId = chi.URLParam(r, actorIDParam)
+				return in, nil
+			},
+		},
+	)
+}
+
 func (a *api) onActorStateTransaction(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -341,6 +381,21 @@ func (a *api) onDeleteActorTimer() http.HandlerFunc {
 				in.ActorType = chi.URLParam(r, actorTypeParam)
 				in.ActorId = chi.URLParam(r, actorIDParam)
 				in.Name = chi.URLParam(r, nameParam)
+				in.Durable = kitstrings.IsTruthy(r.URL.Query().Get(durableParam))
+				return in, nil
+			},
+		},
+	)
+}
+
+func (a *api) onDeleteActorTimers() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.UnregisterActorTimersAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.UnregisterActorTimersRequestAlpha1, *emptypb.Empty]{
+			InModifier: func(r *http.Request, in *runtimev1pb.UnregisterActorTimersRequestAlpha1) (*runtimev1pb.UnregisterActorTimersRequestAlpha1, error) {
+				in.ActorType = chi.URLParam(r, actorTypeParam)
+				in.ActorId = chi.URLParam(r, actorIDParam)
+				in.Durable = kitstrings.IsTruthy(r.URL.Query().Get(durableParam))
 				return in, nil
 			},
 		},
@@ -379,7 +434,7 @@ func (a *api) onDirectActorMessage(w http.ResponseWriter, r *http.Request) {
 		// Save headers to internal metadata
 		WithHTTPHeaders(r.Header)
 
-		// Unlike other actor calls, resiliency is handled here for invocation.
+	// Unlike other actor calls, resiliency is handled here for invocation.
 	// This is due to actor invocation involving a lookup for the host.
 	policyDef := a.universal.Resiliency().ActorPreLockPolicy(actorType, actorID)
 	policyRunner := resiliency.NewRunner[*internalsv1pb.InternalInvokeResponse](ctx, policyDef)
@@ -129,6 +129,7 @@ func TestAPIAllowlist(t *testing.T) {
 				}
 
 				allOtherEndpoints := []endpoints.Endpoint{}
+				allOtherEndpoints = append(allOtherEndpoints, a.constructRedriveEndpoints()...)
 				allOtherEndpoints = append(allOtherEndpoints, a.constructActorEndpoints()...)
 				allOtherEndpoints = append(allOtherEndpoints, a.constructBindingsEndpoints()...)
 				allOtherEndpoints = append(allOtherEndpoints, a.constructDirectMessagingEndpoints()...)
@@ -44,6 +44,8 @@ const (
 	EndpointGroupServiceInvocation EndpointGroupName = "invoke"
 	EndpointGroupState             EndpointGroupName = "state"
 	EndpointGroupPubsub            EndpointGroupName = "publish"
+	EndpointGroupRedrive           EndpointGroupName = "redrive"
+	EndpointGroupSubscriptions     EndpointGroupName = "subscriptions"
 	EndpointGroupBindings          EndpointGroupName = "bindings"
 	EndpointGroupSecrets           EndpointGroupName = "secrets"
 	EndpointGroupActors            EndpointGroupName = "actors"
@@ -39,6 +39,7 @@ import (
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/api/universal"
 	"github.com/dapr/dapr/pkg/channel/http"
+	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
 	stateLoader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -54,6 +55,7 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/utils"
 	kiterrors "github.com/dapr/kit/errors"
 )
@@ -73,6 +75,7 @@ type api struct {
 	pubsubAdapter         runtimePubsub.Adapter
 	outbox                outbox.Outbox
 	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	seekSubscriptionFn    func(ctx context.Context, name string, req pubsubLoader.SeekRequest) error
 	metricSpec            *config.MetricSpec
 	tracingSpec           config.TracingSpec
 	maxRequestBodySize    int64 // In bytes
@@ -100,8 +103,12 @@ const (
 	workflowName             = "workflowName"
 	instanceID               = "instanceID"
 	eventName                = "eventName"
+	taskIDParam              = "taskID"
+	workflowOptionPrefix     = "dapr.workflow."
+	searchAttributePrefix    = "searchAttributes."
 	consistencyParam         = "consistency"
 	concurrencyParam         = "concurrency"
+	durableParam             = "durable"
 	pubsubnameparam          = "pubsubname"
 	traceparentHeader        = "traceparent"
 	tracestateHeader         = "tracestate"
@@ -116,6 +123,7 @@ type APIOpts struct {
 	PubSubAdapter         runtimePubsub.Adapter
 	Outbox                outbox.Outbox
 	SendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	SeekSubscriptionFn    func(ctx context.Context, name string, req pubsubLoader.SeekRequest) error
 	TracingSpec           config.TracingSpec
 	MetricSpec            *config.MetricSpec
 	MaxRequestBodySize    int64 // In bytes
@@ -132,6 +140,7 @@ func NewAPI(opts APIOpts) API {
 		pubsubAdapter:         opts.PubSubAdapter,
 		outbox:                opts.Outbox,
 		sendToOutputBindingFn: opts.SendToOutputBindingFn,
+		seekSubscriptionFn:    opts.SeekSubscriptionFn,
 		tracingSpec:           opts.TracingSpec,
 		metricSpec:            opts.MetricSpec,
 		maxRequestBodySize:    opts.MaxRequestBodySize,
@@ -145,6 +154,8 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructPubSubEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructRedriveEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructSubscriptionEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructActorEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructDirectMessagingEndpoints()...)
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
@@ -295,6 +306,35 @@ func (a *api) constructPubSubEndpoints() []endpoints.Endpoint {
 	}
 }
 
+// constructRedriveEndpoints returns the endpoints of the redrive API, which
+// is a group of its own because it consumes from dead-letter topics, which
+// publishing alone doesn't allow.
+func (a *api) constructRedriveEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "redrive/{pubsubname}/*",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupRedrive,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendRedriveSpanAttributes,
+			},
+			Handler: a.onRedrive(),
+			Settings: endpoints.EndpointSettings{
+				Name: "RedriveDeadLetterTopic",
+			},
+		},
+	}
+}
+
+// appendRedriveSpanAttributes sets the dead-letter topic, which the route
+// holds in its wildcard, as the destination of the span.
+func appendRedriveSpanAttributes(r *nethttp.Request, m map[string]string) {
+	m[diagConsts.MessagingSystemSpanAttributeKey] = "pubsub"
+	m[diagConsts.MessagingDestinationSpanAttributeKey] = chi.URLParam(r, wildcardParam)
+}
+
 func appendBindingsSpanAttributes(r *nethttp.Request, m map[string]string) {
 	m[diagConsts.DBSystemSpanAttributeKey] = diagConsts.BindingBuildingBlockType
 	m[diagConsts.DBConnectionStringSpanAttributeKey] = diagConsts.BindingBuildingBlockType
@@ -1123,6 +1163,12 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 		log.Debug(err)
 		return
 	}
+	if _, _, metaErr = runtimePubsub.DeliveryTime(metadata, time.Now()); metaErr != nil {
+		err := apierrors.PubSub(pubsubName).WithMetadata(metadata).DeserializeError(metaErr)
+		respondWithError(w, err)
+		log.Debug(err)
+		return
+	}
 
 	data := body
 
@@ -1183,6 +1229,8 @@ func (a *api) onPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 			nerr = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.universal.AppID(), err)
 		case errors.As(err, &runtimePubsub.NotFoundError{}):
 			nerr = apierrors.PubSub(pubsubName).TestNotFound(topic, err)
+		case errors.As(err, &schema.ValidationError{}):
+			nerr = apierrors.PubSub(pubsubName).SchemaValidation(topic, err)
 		default:
 			nerr = apierrors.PubSub(pubsubName).PublishMessage(topic, err)
 		}
@@ -1218,6 +1266,12 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 		respondWithError(w, err)
 		return
 	}
+	if _, _, metaErr = runtimePubsub.DeliveryTime(metadata, time.Now()); metaErr != nil {
+		err := apierrors.PubSub(pubsubName).WithMetadata(metadata).DeserializeError(metaErr)
+		log.Debug(err)
+		respondWithError(w, err)
+		return
+	}
 
 	// Extract trace context from context.
 	span := diagUtils.SpanFromContext(r.Context())
@@ -1397,6 +1451,24 @@ func (a *api) onBulkPublish(w nethttp.ResponseWriter, r *nethttp.Request) {
 	respondWithEmpty(w)
 }
 
+// Route: POST "redrive/{pubsubname}/*"
+// The wildcard is the dead-letter topic. The body holds the topic,
+// maxMessages, filter and dryRun fields of the request, and the metadata of
+// the subscription to the dead-letter topic is passed as query parameters.
+func (a *api) onRedrive() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.RedriveDeadLetterTopicAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.RedriveDeadLetterTopicRequest, *runtimev1pb.RedriveDeadLetterTopicResponse]{
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.RedriveDeadLetterTopicRequest) (*runtimev1pb.RedriveDeadLetterTopicRequest, error) {
+				in.PubsubName = chi.URLParam(r, pubsubnameparam)
+				in.DeadLetterTopic = chi.URLParam(r, wildcardParam)
+				in.Metadata = getMetadataFromRequest(r)
+				return in, nil
+			},
+			ProtoResponseEmitUnpopulated: true,
+		})
+}
+
 // validateAndGetPubsubAndTopic takes input as request context and returns the pubsub interface, pubsub name, topic name,
 // or error status code and an ErrorResponse object.
 func (a *api) validateAndGetPubsubAndTopic(r *nethttp.Request) (pubsub.PubSub, string, string, error) {
@@ -1576,6 +1648,34 @@ func (a *api) onPostStateTransaction(w nethttp.ResponseWriter, r *nethttp.Reques
 			}
 
 			operations = append(operations, delReq)
+		case string(outbox.OperationPublish):
+			var msg outbox.Message
+			err := mapstructure.Decode(o.Request, &msg)
+			if err != nil {
+				msg := messages.ErrMalformedRequest.WithFormat(err)
+				respondWithError(w, msg)
+				log.Debug(msg)
+				return
+			}
+			if err = outbox.ValidateMessage(a.outbox, storeName, msg); err != nil {
+				nerr := apierrors.PubSubOutboxMessage(a.universal.AppID(), err)
+				respondWithError(w, nerr)
+				log.Debug(nerr)
+				return
+			}
+			err = runtimePubsub.IsOutboxMessageAllowed(a.outbox, a.universal.CompStore().GetPubSub, storeName, msg.Topic, a.universal.AppID())
+			if err != nil {
+				pubsubName, _ := a.outbox.PublishPubSub(storeName)
+				nerr := apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+				if errors.As(err, &runtimePubsub.NotAllowedError{}) {
+					nerr = apierrors.PubSub(pubsubName).PublishForbidden(msg.Topic, a.universal.AppID(), err)
+				}
+				respondWithError(w, nerr)
+				log.Debug(nerr)
+				return
+			}
+
+			operations = append(operations, msg)
 		default:
 			resp := messages.NewAPIErrorHTTP(fmt.Sprintf(messages.ErrNotSupportedStateOperation, o.Operation), errorcodes.StateNotSupportedOperation, nethttp.StatusBadRequest)
 			respondWithError(w, resp)
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	apiextensionsV1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -62,8 +63,10 @@ import (
 	httpEndpointsV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	"github.com/dapr/dapr/pkg/channel/http"
+	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/healthz"
@@ -72,6 +75,7 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/middleware"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
+	"github.com/dapr/dapr/pkg/outbox"
 	outboxfake "github.com/dapr/dapr/pkg/outbox/fake"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -79,10 +83,15 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/dapr/utils"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/ptr"
 )
@@ -177,6 +186,10 @@ func TestPubSubEndpoints(t *testing.T) {
 					return runtimePubsub.NotAllowedError{Topic: req.Topic, ID: "test"}
 				}
 
+				if req.PubsubName == "errschema" {
+					return schema.ValidationError{Topic: req.Topic, Err: errors.New("missing properties: 'orderId'")}
+				}
+
 				return nil
 			},
 		},
@@ -188,6 +201,7 @@ func TestPubSubEndpoints(t *testing.T) {
 	testAPI.universal.CompStore().AddPubSub("errorpubsub", &runtimePubsub.PubsubItem{Component: &mock})
 	testAPI.universal.CompStore().AddPubSub("errnotfound", &runtimePubsub.PubsubItem{Component: &mock})
 	testAPI.universal.CompStore().AddPubSub("errnotallowed", &runtimePubsub.PubsubItem{Component: &mock})
+	testAPI.universal.CompStore().AddPubSub("errschema", &runtimePubsub.PubsubItem{Component: &mock})
 
 	fakeServer.StartServer(testAPI.constructPubSubEndpoints(), nil)
 
@@ -324,9 +338,146 @@ func TestPubSubEndpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Payload does not match schema - 400", func(t *testing.T) {
+		apiPath := apiVersionV1 + "/publish/errschema/topic"
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"key": "value"}`), nil)
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SCHEMA_VALIDATION", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
+func TestRedriveEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		healthz: healthz.New(),
+		universal: universal.New(universal.Options{
+			AppID:         "fakeAPI",
+			Logger:        logger.NewLogger("fakeLogger"),
+			CompStore:     compstore.New(),
+			PubSubAdapter: &daprt.MockPubSubAdapter{},
+		}),
+	}
+
+	mockPubSub := daprt.MockPubSub{}
+	mockPubSub.On("Subscribe", mock.Anything, mock.Anything).Return(errors.New("subscribe failed"))
+	testAPI.universal.CompStore().AddPubSub("pubsubname", &runtimePubsub.PubsubItem{Component: &mockPubSub})
+
+	fakeServer.StartServer(testAPI.constructRedriveEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	apiPath := apiVersionV1alpha1 + "/redrive/pubsubname/dlq"
+
+	t.Run("Redrive with invalid filter - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"filter":"event.type =="}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REDRIVE_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Redrive with negative max messages - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"maxMessages":-1}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REDRIVE_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Redrive of unknown pubsub - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/redrive/unknown/dlq", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Redrive error - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"dryRun":true}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REDRIVE", resp.ErrorBody["errorCode"])
+		assert.Contains(t, resp.ErrorBody["message"], "subscribe failed")
+	})
+
+	t.Run("Span attributes hold the dead-letter topic", func(t *testing.T) {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add(pubsubnameparam, "pubsubname")
+		rctx.URLParams.Add(wildcardParam, "dlq")
+		r, err := nethttp.NewRequestWithContext(context.WithValue(t.Context(), chi.RouteCtxKey, rctx), nethttp.MethodPost, apiPath, nil)
+		require.NoError(t, err)
+
+		m := map[string]string{}
+		appendRedriveSpanAttributes(r, m)
+		assert.Equal(t, map[string]string{
+			diagConsts.MessagingSystemSpanAttributeKey:      "pubsub",
+			diagConsts.MessagingDestinationSpanAttributeKey: "dlq",
+		}, m)
+	})
+}
+
+func TestSeekSubscriptionEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	var gotName string
+	var gotReq pubsubLoader.SeekRequest
+	testAPI := &api{
+		healthz: healthz.New(),
+		seekSubscriptionFn: func(_ context.Context, name string, req pubsubLoader.SeekRequest) error {
+			gotName, gotReq = name, req
+			switch name {
+			case "unknown":
+				return runtimePubsub.SubscriptionNotFoundError{Name: name}
+			case "notseekable":
+				return runtimePubsub.SeekNotSupportedError{PubsubName: "pubsubname"}
+			case "failing":
+				return errors.New("seek failed")
+			default:
+				return nil
+			}
+		},
+	}
+
+	fakeServer.StartServer(testAPI.constructSubscriptionEndpoints(), nil)
+	defer fakeServer.Shutdown()
+
+	t.Run("Seek to offset - 204", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/mysub/seek?metadata.partition=1", []byte(`{"offset":"42"}`), nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, "mysub", gotName)
+		assert.Equal(t, "42", gotReq.Offset)
+		assert.Nil(t, gotReq.Timestamp)
+		assert.Equal(t, map[string]string{"partition": "1"}, gotReq.Metadata)
+	})
+
+	t.Run("Seek to timestamp - 204", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/mysub/seek", []byte(`{"timestamp":"2025-01-02T03:04:05Z"}`), nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		require.NotNil(t, gotReq.Timestamp)
+		assert.True(t, gotReq.Timestamp.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+	})
+
+	t.Run("Seek without position - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/mysub/seek", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SEEK_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Seek unknown subscription - 404", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/unknown/seek", []byte(`{"offset":"42"}`), nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Seek not supported - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/notseekable/seek", []byte(`{"offset":"42"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SEEK_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+		assert.Contains(t, resp.ErrorBody["message"], "only pluggable components")
+	})
+
+	t.Run("Seek error - 500", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiVersionV1alpha1+"/subscriptions/failing/seek", []byte(`{"offset":"42"}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_SEEK", resp.ErrorBody["errorCode"])
+	})
+}
+
 func TestBulkPubSubEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 	testAPI := &api{
@@ -625,6 +776,14 @@ func TestBulkPubSubEndpoints(t *testing.T) {
 		}
 	})
 
+	t.Run("Bulk Publish invalid delivery time - 400", func(t *testing.T) {
+		apiPath := apiVersionV1alpha1 + "/publish/bulk/pubsubname/topic?metadata.deliverAfter=soon"
+		resp := fakeServer.DoRequest("POST", apiPath, reqBytes, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_REQUEST_METADATA", resp.ErrorBody["errorCode"])
+		assert.Contains(t, resp.ErrorBody["message"], "deliverAfter")
+	})
+
 	t.Run("Bulk Publish invalid cloudevent - 400", func(t *testing.T) {
 		reqInvalidCE := []bulkPublishMessageEntry{
 			{
@@ -1330,6 +1489,23 @@ func TestV1ActorEndpoints(t *testing.T) {
 		assert.Equal(t, "ERR_ACTOR_REMINDER_NON_HOSTED", resp.ErrorBody["errorCode"])
 	})
 
+	t.Run("Reminder Create - 400 when reminder name is reserved", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders/dapr.state.ttl"
+
+		actors.WithReminders(func(context.Context) (reminders.Interface, error) {
+			return remindersfake.New().WithCreate(func(context.Context, *actorsapi.CreateReminderRequest) error {
+				return reminders.ErrReminderNameReserved
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("{}"), nil)
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDER_NAME_RESERVED", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Reminder Delete - 204 No Content", func(t *testing.T) {
 		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders/reminder1"
 
@@ -1497,6 +1673,98 @@ func TestV1ActorEndpoints(t *testing.T) {
 		assert.Equal(t, []byte{}, resp.RawBody, "Always give empty body with 204")
 	})
 
+	t.Run("Timer Create - durable", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/timers/timer1"
+
+		var durable bool
+		actors.WithTimers(func(context.Context) (timers.Interface, error) {
+			return timersfake.New().WithCreateFn(func(_ context.Context, req *actorsapi.CreateTimerRequest) error {
+				durable = req.Durable
+				return nil
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"dueTime":"1s","durable":true}`), nil)
+
+		// assert
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.True(t, durable)
+	})
+
+	t.Run("Timer Delete - durable", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/timers/timer1"
+
+		var deleted *actorsapi.DeleteTimerRequest
+		actors.WithTimers(func(context.Context) (timers.Interface, error) {
+			return timersfake.New().WithDeleteFn(func(_ context.Context, req *actorsapi.DeleteTimerRequest) {
+				deleted = req
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath+"?durable=true", nil, nil)
+
+		// assert
+		assert.Equal(t, 204, resp.StatusCode)
+		require.NotNil(t, deleted)
+		assert.Equal(t, "timer1", deleted.Name)
+		assert.True(t, deleted.Durable)
+	})
+
+	t.Run("Timers Delete - 204 No Content", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/timers?durable=true"
+
+		var deleted *actorsapi.DeleteAllTimersRequest
+		actors.WithTimers(func(context.Context) (timers.Interface, error) {
+			return timersfake.New().WithDeleteAllFn(func(_ context.Context, req *actorsapi.DeleteAllTimersRequest) error {
+				deleted = req
+				return nil
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 204, resp.StatusCode)
+		require.NotNil(t, deleted)
+		assert.Equal(t, "fakeActorType", deleted.ActorType)
+		assert.Equal(t, "fakeActorID", deleted.ActorID)
+	})
+
+	t.Run("Timers Delete - 400 without durable", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/timers"
+
+		actors.WithTimers(func(context.Context) (timers.Interface, error) {
+			return timersfake.New(), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_BAD_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Timers Delete - 500 on upstream error", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/timers?durable=true"
+
+		actors.WithTimers(func(context.Context) (timers.Interface, error) {
+			return timersfake.New().WithDeleteAllFn(func(context.Context, *actorsapi.DeleteAllTimersRequest) error {
+				return errors.New("UPSTREAM_ERROR")
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_TIMERS_DELETE", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Direct Message - Forwards downstream status", func(t *testing.T) {
 		apiPath := "v1.0/actors/fakeActorType/fakeActorID/method/method1"
 		fakeData := []byte("fakeData")
@@ -1538,6 +1806,36 @@ func TestV1ActorEndpoints(t *testing.T) {
 		assert.Equal(t, "ERR_ACTOR_INVOKE_METHOD", resp.ErrorBody["errorCode"])
 	})
 
+	t.Run("Migrate actor", func(t *testing.T) {
+		var got *actorsapi.MigrateActorRequest
+		actors.WithRouter(func(context.Context) (router.Interface, error) {
+			return routerfake.New().WithMigrateFn(func(_ context.Context, req *actorsapi.MigrateActorRequest) error {
+				if req.Version == "missing" {
+					return errors.New("version missing of actor type fakeActorType is not registered")
+				}
+				got = req
+				return nil
+			}), nil
+		})
+
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/migrate"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"version":"2"}`), nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, &actorsapi.MigrateActorRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			Version:   "2",
+		}, got)
+
+		resp = fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Empty(t, got.Version)
+
+		resp = fakeServer.DoRequest("POST", apiPath, []byte(`{"version":"missing"}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_MIGRATE", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -2594,6 +2892,245 @@ func TestV1Workflow(t *testing.T) {
 		assert.Nil(t, resp.ErrorBody)
 	})
 
+	/////////////////////////////////
+	// SIGNAL WITH START API TESTS //
+	/////////////////////////////////
+
+	t.Run("Signal with start with valid API path", func(t *testing.T) {
+		var gotReq *workflows.StartRequest
+		var gotEventName string
+		var gotEventData *wrapperspb.StringValue
+		wf.WithSignalWithStart(func(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error {
+			gotReq, gotEventName, gotEventData = req, eventName, eventData
+			return nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/myWorkflow/signalWithStart/myEvent?instanceID=myInstanceID&dapr.workflow.timeout=1h"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"input":{"a":1},"eventData":"hello"}`), nil)
+		assert.Equal(t, 202, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		assert.JSONEq(t, `{"instanceID":"myInstanceID"}`, string(resp.RawBody))
+		assert.Equal(t, "myWorkflow", gotReq.WorkflowName)
+		assert.Equal(t, "myInstanceID", *gotReq.InstanceID)
+		assert.JSONEq(t, `{"a":1}`, gotReq.WorkflowInput.GetValue())
+		assert.Equal(t, map[string]string{"dapr.workflow.timeout": "1h"}, gotReq.Options)
+		assert.Equal(t, "myEvent", gotEventName)
+		assert.JSONEq(t, `"hello"`, gotEventData.GetValue())
+	})
+
+	t.Run("Signal with start without instance ID", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr/myWorkflow/signalWithStart/myEvent"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_INSTANCE_ID_PROVIDED_MISSING", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Signal with start with malformed body", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr/myWorkflow/signalWithStart/myEvent?instanceID=myInstanceID"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`not json`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	//////////////////////
+	// UPDATE API TESTS //
+	//////////////////////
+
+	t.Run("Update with valid API path", func(t *testing.T) {
+		wf.WithUpdateWorkflow(func(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+			assert.Equal(t, "myInstanceID", instanceID)
+			assert.Equal(t, "myUpdate", eventName)
+			return wrapperspb.String(`{"updated":` + eventData.GetValue() + `}`), nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/myInstanceID/update/myUpdate"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`42`), nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		assert.JSONEq(t, `{"updated":42}`, string(resp.RawBody))
+	})
+
+	t.Run("Update of workflow which fails to respond", func(t *testing.T) {
+		wf.WithUpdateWorkflow(func(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+			return nil, errors.New("workflow completed before responding")
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/myInstanceID/update/myUpdate"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_UPDATE_WORKFLOW", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Signal with start and update are not available on the stable API", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0/workflows/dapr/myWorkflow/signalWithStart/myEvent?instanceID=myInstanceID", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+
+		resp = fakeServer.DoRequest("POST", "v1.0/workflows/dapr/myInstanceID/update/myUpdate", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+
+	////////////////////
+	// LIST API TESTS //
+	////////////////////
+
+	t.Run("List workflows by search attributes", func(t *testing.T) {
+		wf.WithListWorkflows(func(ctx context.Context, query *search.Query) (*search.QueryResponse, error) {
+			assert.Equal(t, "RUNNING", query.RuntimeStatus)
+			assert.Equal(t, 10, query.PageSize)
+			assert.Equal(t, search.Attributes{"customerId": float64(42), "region": "eu"}, query.SearchAttributes)
+			return &search.QueryResponse{
+				Workflows: []*search.Record{{InstanceID: "myInstanceID", RuntimeStatus: "RUNNING"}},
+				Token:     "next",
+			}, nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr?runtimeStatus=running&pageSize=10&searchAttributes.customerId=42&searchAttributes.region=eu"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		var body search.QueryResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		require.Len(t, body.Workflows, 1)
+		assert.Equal(t, "myInstanceID", body.Workflows[0].InstanceID)
+		assert.Equal(t, "next", body.Token)
+	})
+
+	t.Run("List workflows with invalid search attribute key", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr?searchAttributes.customer-id=42"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_SEARCH_ATTRIBUTE_INVALID", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("List workflows with invalid page size", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr?pageSize=abc"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("List workflows is not available on the stable API", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/workflows/dapr?searchAttributes.region=eu", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+
+	//////////////////////////////
+	// HISTORY/REPLAY API TESTS //
+	//////////////////////////////
+
+	t.Run("Export workflow history", func(t *testing.T) {
+		wf.WithWorkflowHistory(func(ctx context.Context, instanceID string) (*replay.History, error) {
+			return &replay.History{
+				InstanceID: instanceID,
+				Events: []*backend.HistoryEvent{{
+					EventId: -1,
+					EventType: &protos.HistoryEvent_ExecutionStarted{
+						ExecutionStarted: &protos.ExecutionStartedEvent{Name: "myWorkflow"},
+					},
+				}},
+			}, nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/myInstanceID/history"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		var body replay.History
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, "myInstanceID", body.InstanceID)
+		require.Len(t, body.Events, 1)
+		assert.Equal(t, "myWorkflow", body.Events[0].GetExecutionStarted().GetName())
+	})
+
+	t.Run("Replay workflow history", func(t *testing.T) {
+		wf.WithReplayWorkflow(func(ctx context.Context, history *replay.History) (*replay.Result, error) {
+			assert.Equal(t, "myInstanceID", history.InstanceID)
+			require.Len(t, history.Events, 1)
+			return &replay.Result{Divergences: []string{"replay scheduled activity 'Charge' which is not in the history"}}, nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/myInstanceID/replay"
+		body := []byte(`{"instanceID":"other","events":[{"eventId":-1,"executionStarted":{"name":"myWorkflow"}}]}`)
+		resp := fakeServer.DoRequest("POST", apiPath, body, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		assert.JSONEq(t, `{"deterministic":false,"divergences":["replay scheduled activity 'Charge' which is not in the history"]}`, string(resp.RawBody))
+	})
+
+	t.Run("Replay workflow with malformed history", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr/myInstanceID/replay"
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"events":[{"unknownField":1}]}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("History and replay are not available on the stable API", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/workflows/dapr/myInstanceID/history", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+
+		resp = fakeServer.DoRequest("POST", "v1.0/workflows/dapr/myInstanceID/replay", []byte(`{"events":[]}`), nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+
+	/////////////////////////
+	// HEARTBEAT API TESTS //
+	/////////////////////////
+
+	t.Run("Activity heartbeat with valid API path", func(t *testing.T) {
+		var gotInstanceID string
+		var gotTaskID int32
+		wf.WithActivityHeartbeat(func(ctx context.Context, instanceID string, taskID int32) error {
+			gotInstanceID, gotTaskID = instanceID, taskID
+			return nil
+		})
+
+		apiPath := "v1.0-alpha1/workflows/dapr/instanceID/activities/3/heartbeat"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+
+		// assert
+		assert.Nil(t, resp.ErrorBody)
+		assert.Equal(t, "instanceID", gotInstanceID)
+		assert.Equal(t, int32(3), gotTaskID)
+	})
+
+	t.Run("Activity heartbeat with invalid task ID", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/workflows/dapr/instanceID/activities/notanumber/heartbeat"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+
+		// assert
+		assert.Equal(t, "ERR_ACTIVITY_TASK_ID_INVALID", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Activity heartbeat is not available on the stable API", func(t *testing.T) {
+		apiPath := "v1.0/workflows/dapr/instanceID/activities/3/heartbeat"
+		resp := fakeServer.DoRequest("POST", apiPath, nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+
 	/////////////////////
 	// PURGE API TESTS //
 	/////////////////////
@@ -4324,6 +4861,123 @@ func TestV1TransactionEndpoints(t *testing.T) {
 		assert.Equal(t, 500, resp.StatusCode, "Dapr should return 500")
 		assert.Equal(t, "ERR_STATE_TRANSACTION", resp.ErrorBody["errorCode"], apiPath)
 	})
+
+	t.Run("Outbox message without outbox - 400 ERR_OUTBOX_MESSAGE", func(t *testing.T) {
+		apiPath := fmt.Sprintf("v1.0/state/%s/transaction", storeName)
+		inputBodyBytes, err := json.Marshal(stateTransactionRequestBody{
+			Operations: []stateTransactionRequestBodyOperation{
+				{
+					Operation: string(outbox.OperationPublish),
+					Request: map[string]interface{}{
+						"topic": "orders",
+						"data":  fakeBodyObject,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		resp := fakeServer.DoRequest("POST", apiPath, inputBodyBytes, nil)
+
+		assert.Equal(t, 400, resp.StatusCode, "Dapr should return 400")
+		assert.Equal(t, "ERR_OUTBOX_MESSAGE", resp.ErrorBody["errorCode"], apiPath)
+	})
+
+	t.Run("Outbox messages - 204 No Content", func(t *testing.T) {
+		var published []state.TransactionalStateOperation
+		compStore.AddPubSub("outboxps", &runtimePubsub.PubsubItem{
+			Component:         &daprt.MockPubSub{},
+			ScopedPublishings: []string{"orders"},
+		})
+		t.Cleanup(func() {
+			compStore.DeletePubSub("outboxps")
+		})
+		testAPI.outbox = outboxfake.New().
+			WithEnabled(func(string) bool { return true }).
+			WithPublishPubSub(func(string) (string, bool) { return "outboxps", true }).
+			WithPublishInternal(func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
+				published = states
+				return states[:1], nil
+			})
+		t.Cleanup(func() {
+			testAPI.outbox = outboxfake.New()
+		})
+
+		apiPath := fmt.Sprintf("v1.0/state/%s/transaction", storeName)
+
+		t.Run("missing topic", func(t *testing.T) {
+			inputBodyBytes, err := json.Marshal(stateTransactionRequestBody{
+				Operations: []stateTransactionRequestBodyOperation{
+					{
+						Operation: string(outbox.OperationPublish),
+						Request: map[string]interface{}{
+							"data": fakeBodyObject,
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := fakeServer.DoRequest("POST", apiPath, inputBodyBytes, nil)
+
+			assert.Equal(t, 400, resp.StatusCode, "Dapr should return 400")
+			assert.Equal(t, "ERR_OUTBOX_MESSAGE", resp.ErrorBody["errorCode"], apiPath)
+		})
+
+		t.Run("topic not allowed", func(t *testing.T) {
+			inputBodyBytes, err := json.Marshal(stateTransactionRequestBody{
+				Operations: []stateTransactionRequestBodyOperation{
+					{
+						Operation: string(outbox.OperationPublish),
+						Request: map[string]interface{}{
+							"topic": "payments",
+							"data":  fakeBodyObject,
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := fakeServer.DoRequest("POST", apiPath, inputBodyBytes, nil)
+
+			assert.Equal(t, 403, resp.StatusCode, "Dapr should return 403")
+			assert.Equal(t, "ERR_PUBSUB_FORBIDDEN", resp.ErrorBody["errorCode"], apiPath)
+		})
+
+		t.Run("publish", func(t *testing.T) {
+			inputBodyBytes, err := json.Marshal(stateTransactionRequestBody{
+				Operations: []stateTransactionRequestBodyOperation{
+					{
+						Operation: string(state.OperationUpsert),
+						Request: map[string]interface{}{
+							"key":   "fakeKey1",
+							"value": fakeBodyObject,
+						},
+					},
+					{
+						Operation: string(outbox.OperationPublish),
+						Request: map[string]interface{}{
+							"topic":       "orders",
+							"data":        fakeBodyObject,
+							"contentType": "application/json",
+							"metadata":    map[string]string{"ttlInSeconds": "10"},
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			resp := fakeServer.DoRequest("POST", apiPath, inputBodyBytes, nil)
+
+			assert.Equal(t, 204, resp.StatusCode, "Dapr should return 204")
+			require.Len(t, published, 2)
+			msg, ok := published[1].(outbox.Message)
+			require.True(t, ok)
+			assert.Equal(t, "orders", msg.Topic)
+			assert.Equal(t, "application/json", msg.ContentType)
+			assert.Equal(t, map[string]string{"ttlInSeconds": "10"}, msg.Metadata)
+		})
+	})
 	fakeServer.Shutdown()
 }
 
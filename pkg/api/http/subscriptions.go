@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	pubsubLoader "github.com/dapr/dapr/pkg/components/pubsub"
+	diagConsts "github.com/dapr/dapr/pkg/diagnostics/consts"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const subscriptionNameParam = "subscriptionName"
+
+// constructSubscriptionEndpoints returns the endpoints of the subscriptions
+// API, which is a group of its own because seeking replays the messages of a
+// subscription, which publishing alone doesn't allow.
+func (a *api) constructSubscriptionEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "subscriptions/{subscriptionName}/seek",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupSubscriptions,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendSubscriptionSpanAttributes,
+			},
+			Handler: a.onSeekSubscription,
+			Settings: endpoints.EndpointSettings{
+				Name: "SeekSubscription",
+			},
+		},
+	}
+}
+
+func appendSubscriptionSpanAttributes(r *nethttp.Request, m map[string]string) {
+	m[diagConsts.MessagingSystemSpanAttributeKey] = "pubsub"
+	m[diagConsts.MessagingSubscriptionSpanAttributeKey] = chi.URLParam(r, subscriptionNameParam)
+}
+
+type seekRequest struct {
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+	Offset    string     `json:"offset,omitempty"`
+}
+
+// onSeekSubscription resets the position of a declarative subscription to a
+// timestamp or offset, after which its messages are replayed from there.
+// Only subscriptions of pluggable components which advertise the SEEK feature
+// can seek; other components return ERR_PUBSUB_SEEK_NOT_SUPPORTED.
+func (a *api) onSeekSubscription(w nethttp.ResponseWriter, r *nethttp.Request) {
+	name := strings.TrimSpace(chi.URLParam(r, subscriptionNameParam))
+
+	var body seekRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		nerr := apierrors.PubSub("").SeekRequest(name, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	req := pubsubLoader.SeekRequest{
+		Timestamp: body.Timestamp,
+		Offset:    body.Offset,
+		Metadata:  getMetadataFromRequest(r),
+	}
+	if err := req.Validate(); err != nil {
+		nerr := apierrors.PubSub("").SeekRequest(name, err)
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	if a.seekSubscriptionFn == nil {
+		nerr := apierrors.PubSub("").WithMetadata(nil).NotConfigured()
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	err := a.seekSubscriptionFn(r.Context(), name, req)
+	if err != nil {
+		var nerr error
+		var notSupported runtimePubsub.SeekNotSupportedError
+		var notFound runtimePubsub.NotFoundError
+		switch {
+		case errors.As(err, &runtimePubsub.SubscriptionNotFoundError{}):
+			nerr = apierrors.Basic(codes.NotFound, nethttp.StatusNotFound, errorcodes.PubSubSubscriptionNotFound,
+				fmt.Sprintf("subscription %s is not found", name))
+		case errors.As(err, &notFound):
+			nerr = apierrors.PubSub(notFound.PubsubName).WithMetadata(nil).NotFound()
+		case errors.As(err, &notSupported):
+			nerr = apierrors.PubSub(notSupported.PubsubName).SeekNotSupported(name, err)
+		default:
+			nerr = apierrors.PubSub("").Seek(name, err)
+		}
+		log.Debug(nerr)
+		respondWithError(w, nerr)
+		return
+	}
+
+	respondWithEmpty(w)
+}
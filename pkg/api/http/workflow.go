@@ -14,15 +14,20 @@ limitations under the License.
 package http
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
 )
 
 var (
@@ -48,6 +53,16 @@ var (
 // Instance ID: Identifier of the specific run
 func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onListWorkflowsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListWorkflows",
+			},
+		},
 		{
 			Methods: []string{http.MethodGet},
 			Route:   "workflows/{workflowComponent}/{instanceID}",
@@ -108,6 +123,56 @@ func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 				Name: "RaiseEventWorkflow",
 			},
 		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/activities/{taskID}/heartbeat",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onWorkflowActivityHeartbeatHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "WorkflowActivityHeartbeat",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{workflowName}/signalWithStart/{eventName}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onSignalWithStartWorkflowHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "SignalWithStartWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/update/{eventName}",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onUpdateWorkflowHandler,
+			Settings: endpoints.EndpointSettings{
+				Name: "UpdateWorkflow",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}/{instanceID}/history",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onWorkflowHistoryHandler,
+			Settings: endpoints.EndpointSettings{
+				Name: "WorkflowHistory",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/{instanceID}/replay",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onReplayWorkflowHandler,
+			Settings: endpoints.EndpointSettings{
+				Name: "ReplayWorkflow",
+			},
+		},
 		{
 			Methods: []string{http.MethodPost},
 			Route:   "workflows/{workflowComponent}/{workflowName}/start",
@@ -276,6 +341,8 @@ func (a *api) onStartWorkflowHandler() http.HandlerFunc {
 				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
 				in.InstanceId = r.URL.Query().Get(instanceID)
 
+				in.Options = workflowOptions(r)
+
 				// We accept the HTTP request body as the input to the workflow
 				// without making any assumptions about its format.
 				var err error
@@ -289,6 +356,217 @@ func (a *api) onStartWorkflowHandler() http.HandlerFunc {
 		})
 }
 
+// workflowOptions returns the start workflow options, such as timeouts, which
+// are passed as query parameters.
+func workflowOptions(r *http.Request) map[string]string {
+	var options map[string]string
+	for k, v := range r.URL.Query() {
+		if strings.HasPrefix(k, workflowOptionPrefix) && len(v) > 0 {
+			if options == nil {
+				options = make(map[string]string)
+			}
+			options[k] = v[0]
+		}
+	}
+	return options
+}
+
+// signalWithStartBody is the body of a signal with start request. Both the
+// input of the workflow and the data of the event are optional.
+type signalWithStartBody struct {
+	Input     json.RawMessage `json:"input,omitempty"`
+	EventData json.RawMessage `json:"eventData,omitempty"`
+}
+
+// Route: POST "workflows/{workflowComponent}/{workflowName}/signalWithStart/{eventName}?instanceID={instanceID}"
+func (a *api) onSignalWithStartWorkflowHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.SignalWithStartWorkflowAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.SignalWithStartWorkflowRequest, *runtimev1pb.StartWorkflowResponse]{
+			// The body holds the raw JSON of the input and the event data.
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.SignalWithStartWorkflowRequest) (*runtimev1pb.SignalWithStartWorkflowRequest, error) {
+				var body signalWithStartBody
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					return nil, messages.ErrBodyRead.WithFormat(err)
+				}
+				if len(data) > 0 {
+					if err = json.Unmarshal(data, &body); err != nil {
+						return nil, messages.ErrMalformedRequest.WithFormat(err)
+					}
+				}
+
+				in.Start = &runtimev1pb.StartWorkflowRequest{
+					InstanceId:        r.URL.Query().Get(instanceID),
+					WorkflowComponent: chi.URLParam(r, workflowComponent),
+					WorkflowName:      chi.URLParam(r, workflowName),
+					Options:           workflowOptions(r),
+					Input:             body.Input,
+				}
+				in.EventName = chi.URLParam(r, eventName)
+				in.EventData = body.EventData
+				return in, nil
+			},
+			SuccessStatusCode: http.StatusAccepted,
+		})
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/update/{eventName}"
+func (a *api) onUpdateWorkflowHandler(w http.ResponseWriter, r *http.Request) {
+	// We accept the HTTP request body as the payload of the update without
+	// making any assumptions about its format.
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondWithError(w, messages.ErrBodyRead.WithFormat(err))
+		return
+	}
+
+	resp, err := a.universal.UpdateWorkflowAlpha1(r.Context(), &runtimev1pb.UpdateWorkflowRequest{
+		InstanceId:        chi.URLParam(r, instanceID),
+		WorkflowComponent: chi.URLParam(r, workflowComponent),
+		EventName:         chi.URLParam(r, eventName),
+		EventData:         data,
+	})
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithData(w, http.StatusOK, resp.GetData())
+}
+
+// Route: GET "workflows/{workflowComponent}?runtimeStatus={status}&searchAttributes.{key}={value}&pageSize={size}&token={token}"
+// Search attribute values are parsed as JSON numbers or booleans when
+// possible, and are strings otherwise.
+func (a *api) onListWorkflowsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ListWorkflowsAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ListWorkflowsRequest, *runtimev1pb.ListWorkflowsResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ListWorkflowsRequest) (*runtimev1pb.ListWorkflowsRequest, error) {
+				params := r.URL.Query()
+				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
+				in.RuntimeStatus = params.Get("runtimeStatus")
+				in.Token = params.Get("token")
+
+				if v := params.Get("pageSize"); v != "" {
+					pageSize, err := strconv.ParseInt(v, 10, 32)
+					if err != nil || pageSize < 0 {
+						return nil, messages.ErrMalformedRequest.WithFormat("invalid pageSize: " + v)
+					}
+					in.PageSize = int32(pageSize)
+				}
+
+				for k, v := range params {
+					key, ok := strings.CutPrefix(k, searchAttributePrefix)
+					if !ok || len(v) == 0 {
+						continue
+					}
+					value, err := structpb.NewValue(searchAttributeValue(v[0]))
+					if err != nil {
+						return nil, messages.ErrInvalidSearchAttribute.WithFormat(err)
+					}
+					if in.SearchAttributes == nil {
+						in.SearchAttributes = make(map[string]*structpb.Value)
+					}
+					in.SearchAttributes[key] = value
+				}
+				return in, nil
+			},
+		})
+}
+
+// searchAttributeValue returns the typed value of a search attribute passed
+// as a query parameter.
+func searchAttributeValue(v string) any {
+	var value any
+	if err := json.Unmarshal([]byte(v), &value); err == nil {
+		switch value.(type) {
+		case float64, bool:
+			return value
+		}
+	}
+	return v
+}
+
+// Route: GET "workflows/{workflowComponent}/{instanceID}/history"
+// The history is returned with the events encoded with the protobuf JSON
+// mapping, as replay.History.
+func (a *api) onWorkflowHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := a.universal.GetWorkflowHistoryAlpha1(r.Context(), &runtimev1pb.GetWorkflowHistoryRequest{
+		InstanceId:        chi.URLParam(r, instanceID),
+		WorkflowComponent: chi.URLParam(r, workflowComponent),
+	})
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	events, err := replay.DecodeEvents(resp.GetEvents())
+	if err != nil {
+		respondWithError(w, messages.ErrWorkflowHistory.WithFormat(resp.GetInstanceId(), err))
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, &replay.History{
+		InstanceID: resp.GetInstanceId(),
+		Events:     events,
+	})
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/replay"
+// The request body is a history exported with the history endpoint.
+func (a *api) onReplayWorkflowHandler(w http.ResponseWriter, r *http.Request) {
+	var history replay.History
+	if err := json.NewDecoder(r.Body).Decode(&history); err != nil {
+		respondWithError(w, messages.ErrMalformedRequest.WithFormat(err))
+		return
+	}
+
+	events, err := replay.EncodeEvents(history.Events)
+	if err != nil {
+		respondWithError(w, messages.ErrMalformedRequest.WithFormat(err))
+		return
+	}
+
+	resp, err := a.universal.ReplayWorkflowAlpha1(r.Context(), &runtimev1pb.ReplayWorkflowRequest{
+		InstanceId:        chi.URLParam(r, instanceID),
+		WorkflowComponent: chi.URLParam(r, workflowComponent),
+		Events:            events,
+	})
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, &replay.Result{
+		Deterministic: resp.GetDeterministic(),
+		Divergences:   resp.GetDivergences(),
+	})
+}
+
+// Route: POST "workflows/{workflowComponent}/{instanceID}/activities/{taskID}/heartbeat"
+func (a *api) onWorkflowActivityHeartbeatHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.WorkflowActivityHeartbeatAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.WorkflowActivityHeartbeatRequest, *emptypb.Empty]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.WorkflowActivityHeartbeatRequest) (*runtimev1pb.WorkflowActivityHeartbeatRequest, error) {
+				in.InstanceId = chi.URLParam(r, instanceID)
+				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
+
+				taskID, err := strconv.ParseInt(chi.URLParam(r, taskIDParam), 10, 32)
+				if err != nil {
+					return nil, messages.ErrInvalidActivityTaskID.WithFormat(chi.URLParam(r, taskIDParam))
+				}
+				in.TaskId = int32(taskID)
+				return in, nil
+			},
+			SuccessStatusCode: http.StatusNoContent,
+		})
+}
+
 // Route: GET "workflows/{workflowComponent}/{instanceID}"
 func (a *api) onGetWorkflowHandler() http.HandlerFunc {
 	return UniversalHTTPHandler(
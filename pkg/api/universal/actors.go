@@ -60,6 +60,7 @@ func (a *Universal) RegisterActorTimer(ctx context.Context, in *runtimev1pb.Regi
 		TTL:       in.GetTtl(),
 		Callback:  in.GetCallback(),
 		Data:      data,
+		Durable:   in.GetDurable(),
 	}
 
 	err = timers.Create(ctx, req)
@@ -81,12 +82,69 @@ func (a *Universal) UnregisterActorTimer(ctx context.Context, in *runtimev1pb.Un
 		Name:      in.GetName(),
 		ActorID:   in.GetActorId(),
 		ActorType: in.GetActorType(),
+		Durable:   in.GetDurable(),
 	}
 
 	timers.Delete(ctx, req)
 	return nil, nil
 }
 
+func (a *Universal) UnregisterActorTimersAlpha1(ctx context.Context, in *runtimev1pb.UnregisterActorTimersRequestAlpha1) (*emptypb.Empty, error) {
+	timers, err := a.ActorTimers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.GetActorType()) == 0 || len(in.GetActorId()) == 0 {
+		err = messages.ErrActorInstanceMissing
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if !in.GetDurable() {
+		err = messages.ErrBadRequest.WithFormat("only durable timers can be unregistered all at once")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	err = timers.DeleteAll(ctx, &api.DeleteAllTimersRequest{
+		ActorType: in.GetActorType(),
+		ActorID:   in.GetActorId(),
+	})
+	if err != nil {
+		err = messages.ErrActorTimersDelete.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (a *Universal) MigrateActorAlpha1(ctx context.Context, in *runtimev1pb.MigrateActorRequestAlpha1) (*emptypb.Empty, error) {
+	router, err := a.ActorRouter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.GetActorType()) == 0 || len(in.GetActorId()) == 0 {
+		err = messages.ErrActorInstanceMissing
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	err = router.Migrate(ctx, &api.MigrateActorRequest{
+		ActorType: in.GetActorType(),
+		ActorID:   in.GetActorId(),
+		Version:   in.GetVersion(),
+	})
+	if err != nil {
+		err = messages.ErrActorMigrate.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
 func (a *Universal) RegisterActorReminder(ctx context.Context, in *runtimev1pb.RegisterActorReminderRequest) (*emptypb.Empty, error) {
 	r, err := a.ActorReminders(ctx)
 	if err != nil {
@@ -122,6 +180,11 @@ func (a *Universal) RegisterActorReminder(ctx context.Context, in *runtimev1pb.R
 
 	err = r.Create(ctx, req)
 	if err != nil {
+		if errors.Is(err, reminders.ErrReminderNameReserved) {
+			err = messages.ErrActorReminderNameReserved.WithFormat(req.Name)
+			a.logger.Debug(err)
+			return nil, err
+		}
 		if errors.Is(err, reminders.ErrReminderOpActorNotHosted) {
 			a.logger.Debug(messages.ErrActorReminderOpActorNotHosted)
 			return nil, messages.ErrActorReminderOpActorNotHosted
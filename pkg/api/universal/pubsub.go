@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	"github.com/dapr/dapr/pkg/expr"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/redrive"
+)
+
+// RedriveDeadLetterTopicAlpha1 moves the messages of a dead-letter topic back
+// to the topics they were originally published to.
+func (a *Universal) RedriveDeadLetterTopicAlpha1(ctx context.Context, in *runtimev1pb.RedriveDeadLetterTopicRequest) (*runtimev1pb.RedriveDeadLetterTopicResponse, error) {
+	pubsubName, topic := in.GetPubsubName(), in.GetDeadLetterTopic()
+
+	if a.pubsubAdapter == nil {
+		err := apierrors.PubSub(pubsubName).WithMetadata(nil).NotConfigured()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if pubsubName == "" {
+		err := apierrors.PubSub(pubsubName).WithMetadata(in.GetMetadata()).NameEmpty()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	thepubsub, ok := a.compStore.GetPubSub(pubsubName)
+	if !ok {
+		err := apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if topic == "" {
+		err := apierrors.PubSub(pubsubName).WithMetadata(in.GetMetadata()).TopicEmpty()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if in.GetMaxMessages() < 0 {
+		err := apierrors.PubSub(pubsubName).RedriveRequest(topic, errors.New("maxMessages must not be negative"))
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	req := &redrive.Request{
+		PubsubName:      pubsubName,
+		DeadLetterTopic: topic,
+		Topic:           in.GetTopic(),
+		MaxMessages:     int(in.GetMaxMessages()),
+		DryRun:          in.GetDryRun(),
+		Metadata:        in.GetMetadata(),
+	}
+	if in.GetFilter() != "" {
+		req.Filter = new(expr.Expr)
+		if err := req.Filter.DecodeString(in.GetFilter()); err != nil {
+			err = apierrors.PubSub(pubsubName).RedriveRequest(topic, err)
+			a.logger.Debug(err)
+			return nil, err
+		}
+	}
+
+	// The redrive subscribes to the dead-letter topic with the component, and
+	// so with the consumer group, of the app. It would compete with the
+	// subscriptions of the app to the same topic for its messages, so the
+	// messages held by the redrive would be delivered to the app instead.
+	if a.isSubscribed(pubsubName, topic) {
+		err := apierrors.PubSub(pubsubName).RedriveSubscribed(topic, errors.New("the app subscribes to the dead-letter topic"))
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	res, err := redrive.Redrive(ctx, redrive.Options{
+		Pubsub:    thepubsub,
+		Publisher: a.pubsubAdapter,
+		AppID:     a.appID,
+		Namespace: a.namespace,
+	}, req)
+	if err != nil {
+		if errors.As(err, &rtpubsub.NotAllowedError{}) {
+			err = apierrors.PubSub(pubsubName).PublishForbidden(topic, a.appID, err)
+		} else {
+			err = apierrors.PubSub(pubsubName).Redrive(topic, err)
+		}
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	resp := &runtimev1pb.RedriveDeadLetterTopicResponse{
+		Messages: make([]*runtimev1pb.RedrivenMessage, len(res.Messages)),
+		Skipped:  int32(res.Skipped), //nolint:gosec
+		DryRun:   res.DryRun,
+	}
+	for i, m := range res.Messages {
+		resp.Messages[i] = &runtimev1pb.RedrivenMessage{
+			Id:    m.ID,
+			Type:  m.Type,
+			Topic: m.Topic,
+		}
+	}
+	return resp, nil
+}
+
+// isSubscribed returns true if the app subscribes to the topic of the pubsub,
+// with a declarative, programmatic or streaming subscription.
+func (a *Universal) isSubscribed(pubsubName, topic string) bool {
+	subs := append(a.compStore.ListSubscriptionsAppByPubSub(pubsubName), a.compStore.ListSubscriptionsStreamByPubSub(pubsubName)...)
+	for _, sub := range subs {
+		if sub.Topic == topic {
+			return true
+		}
+	}
+	return false
+}
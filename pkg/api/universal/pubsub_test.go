@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	daprt "github.com/dapr/dapr/pkg/testing"
+)
+
+func TestRedriveDeadLetterTopic(t *testing.T) {
+	mockPubSub := daprt.MockPubSub{}
+	mockPubSub.On("Subscribe", mock.Anything, mock.Anything).Return(errors.New("subscribe failed"))
+
+	fakeAPI := &Universal{
+		logger:        testLogger,
+		compStore:     compstore.New(),
+		pubsubAdapter: &daprt.MockPubSubAdapter{},
+	}
+	fakeAPI.compStore.AddPubSub("pubsub", &rtpubsub.PubsubItem{Component: &mockPubSub})
+	fakeAPI.compStore.SetProgramaticSubscriptions(rtpubsub.Subscription{
+		PubsubName: "pubsub",
+		Topic:      "subscribed",
+	})
+
+	t.Run("dead-letter topics the app subscribes to are not redriven", func(t *testing.T) {
+		_, err := fakeAPI.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "pubsub",
+			DeadLetterTopic: "subscribed",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockPubSub.AssertNotCalled(t, "Subscribe", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dead-letter topics the app doesn't subscribe to are redriven", func(t *testing.T) {
+		_, err := fakeAPI.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "pubsub",
+			DeadLetterTopic: "dlq",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Contains(t, err.Error(), "subscribe failed")
+	})
+
+	t.Run("negative max messages", func(t *testing.T) {
+		_, err := fakeAPI.RedriveDeadLetterTopicAlpha1(t.Context(), &runtimev1pb.RedriveDeadLetterTopicRequest{
+			PubsubName:      "pubsub",
+			DeadLetterTopic: "dlq",
+			MaxMessages:     -1,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
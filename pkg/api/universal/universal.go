@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/logger"
@@ -46,6 +47,7 @@ type Options struct {
 	Scheduler                   schedclient.Interface
 	Actors                      actors.Interface
 	WorkflowEngine              wfengine.Interface
+	PubSubAdapter               rtpubsub.Adapter
 }
 
 // Universal contains the implementation of gRPC APIs that are also used by the HTTP server.
@@ -62,6 +64,7 @@ type Universal struct {
 	globalConfig                *config.Configuration
 	workflowEngine              wfengine.Interface
 	scheduler                   schedclient.Interface
+	pubsubAdapter               rtpubsub.Adapter
 
 	extendedMetadataLock sync.RWMutex
 	actors               actors.Interface
@@ -82,6 +85,7 @@ func New(opts Options) *Universal {
 		scheduler:                   opts.Scheduler,
 		actors:                      opts.Actors,
 		workflowEngine:              opts.WorkflowEngine,
+		pubsubAdapter:               opts.PubSubAdapter,
 	}
 }
 
@@ -16,10 +16,14 @@ package universal
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"unicode"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -27,6 +31,8 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/kit/ptr"
 )
@@ -180,6 +186,261 @@ func (a *Universal) RaiseEventWorkflow(ctx context.Context, in *runtimev1pb.Rais
 	return emptyResponse, nil
 }
 
+// SignalWithStartWorkflowAlpha1 is the API handler for raising an event to a
+// workflow, atomically starting the workflow first if it does not exist or is
+// completed.
+func (a *Universal) SignalWithStartWorkflowAlpha1(ctx context.Context, in *runtimev1pb.SignalWithStartWorkflowRequest) (*runtimev1pb.StartWorkflowResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+
+	// The instance ID is required as the event is raised to an existing
+	// workflow with the same ID.
+	start := in.GetStart()
+	if err := a.validateInstanceID(start.GetInstanceId(), true /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if start.GetWorkflowName() == "" {
+		err := messages.ErrWorkflowNameMissing
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if in.GetEventName() == "" {
+		err := messages.ErrMissingWorkflowEventName
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	iid := start.GetInstanceId()
+	req := workflows.StartRequest{
+		InstanceID:    &iid,
+		Options:       start.GetOptions(),
+		WorkflowName:  start.GetWorkflowName(),
+		WorkflowInput: wrapperspb.String(string(start.GetInput())),
+	}
+
+	var data *wrapperspb.StringValue
+	if in.GetEventData() != nil {
+		data = wrapperspb.String(string(in.GetEventData()))
+	}
+
+	if err := a.workflowEngine.SignalWithStart(ctx, &req, in.GetEventName(), data); err != nil {
+		err = messages.ErrSignalWithStartWorkflow.WithFormat(iid, err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	return &runtimev1pb.StartWorkflowResponse{InstanceId: iid}, nil
+}
+
+// UpdateWorkflowAlpha1 is the API handler for delivering an update to a
+// running workflow. It blocks until the workflow responds to the update by
+// sending an event with the same name to its own instance, and returns the
+// data of the response.
+func (a *Universal) UpdateWorkflowAlpha1(ctx context.Context, in *runtimev1pb.UpdateWorkflowRequest) (*runtimev1pb.UpdateWorkflowResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if in.GetEventName() == "" {
+		err := messages.ErrMissingWorkflowEventName
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	var data *wrapperspb.StringValue
+	if in.GetEventData() != nil {
+		data = wrapperspb.String(string(in.GetEventData()))
+	}
+
+	resp, err := a.workflowEngine.UpdateWorkflow(ctx, in.GetInstanceId(), in.GetEventName(), data)
+	if err != nil {
+		err = messages.ErrUpdateWorkflow.WithFormat(in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if resp == nil {
+		return &runtimev1pb.UpdateWorkflowResponse{}, nil
+	}
+	return &runtimev1pb.UpdateWorkflowResponse{Data: []byte(resp.GetValue())}, nil
+}
+
+// ListWorkflowsAlpha1 is the API handler for listing the workflows matching
+// a runtime status and search attributes.
+func (a *Universal) ListWorkflowsAlpha1(ctx context.Context, in *runtimev1pb.ListWorkflowsRequest) (*runtimev1pb.ListWorkflowsResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+
+	if in.GetPageSize() < 0 {
+		err := messages.ErrMalformedRequest.WithFormat(fmt.Sprintf("invalid pageSize: %d", in.GetPageSize()))
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	query := &search.Query{
+		RuntimeStatus: strings.ToUpper(in.GetRuntimeStatus()),
+		PageSize:      int(in.GetPageSize()),
+		Token:         in.GetToken(),
+	}
+	for k, v := range in.GetSearchAttributes() {
+		if err := search.ValidateKey(k); err != nil {
+			err = messages.ErrInvalidSearchAttribute.WithFormat(err)
+			a.logger.Debug(err)
+			return nil, err
+		}
+		switch v.GetKind().(type) {
+		case *structpb.Value_StringValue, *structpb.Value_NumberValue, *structpb.Value_BoolValue:
+		default:
+			err := messages.ErrInvalidSearchAttribute.WithFormat(fmt.Sprintf("search attribute '%s' must be a string, number or boolean", k))
+			a.logger.Debug(err)
+			return nil, err
+		}
+		if query.SearchAttributes == nil {
+			query.SearchAttributes = make(search.Attributes, len(in.GetSearchAttributes()))
+		}
+		query.SearchAttributes[k] = v.AsInterface()
+	}
+
+	resp, err := a.workflowEngine.ListWorkflows(ctx, query)
+	if err != nil {
+		err = messages.ErrListWorkflows.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	res := &runtimev1pb.ListWorkflowsResponse{
+		Workflows: make([]*runtimev1pb.WorkflowSearchRecord, 0, len(resp.Workflows)),
+		Token:     resp.Token,
+	}
+	for _, r := range resp.Workflows {
+		attrs, err := structpb.NewStruct(r.SearchAttributes)
+		if err != nil {
+			err = messages.ErrListWorkflows.WithFormat(err)
+			a.logger.Debug(err)
+			return nil, err
+		}
+		record := &runtimev1pb.WorkflowSearchRecord{
+			InstanceId:       r.InstanceID,
+			WorkflowName:     r.WorkflowName,
+			RuntimeStatus:    r.RuntimeStatus,
+			SearchAttributes: attrs.GetFields(),
+		}
+		if !r.LastUpdatedAt.IsZero() {
+			record.LastUpdatedAt = timestamppb.New(r.LastUpdatedAt)
+		}
+		res.Workflows = append(res.Workflows, record)
+	}
+	return res, nil
+}
+
+// GetWorkflowHistoryAlpha1 is the API handler for exporting the history of
+// the current execution of a workflow, which can be replayed with
+// ReplayWorkflowAlpha1.
+func (a *Universal) GetWorkflowHistoryAlpha1(ctx context.Context, in *runtimev1pb.GetWorkflowHistoryRequest) (*runtimev1pb.GetWorkflowHistoryResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	history, err := a.workflowEngine.WorkflowHistory(ctx, in.GetInstanceId())
+	if err != nil {
+		if errors.Is(err, api.ErrInstanceNotFound) {
+			err = messages.ErrWorkflowInstanceNotFound.WithFormat(in.GetInstanceId())
+		} else {
+			err = messages.ErrWorkflowHistory.WithFormat(in.GetInstanceId(), err)
+		}
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	events, err := replay.EncodeEvents(history.Events)
+	if err != nil {
+		err = messages.ErrWorkflowHistory.WithFormat(in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	return &runtimev1pb.GetWorkflowHistoryResponse{
+		InstanceId: history.InstanceID,
+		Events:     events,
+	}, nil
+}
+
+// ReplayWorkflowAlpha1 is the API handler for replaying an exported workflow
+// history against the workflow code of the connected worker, to check the
+// workflow code for determinism.
+func (a *Universal) ReplayWorkflowAlpha1(ctx context.Context, in *runtimev1pb.ReplayWorkflowRequest) (*runtimev1pb.ReplayWorkflowResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if len(in.GetEvents()) == 0 {
+		err := messages.ErrMalformedRequest.WithFormat(replay.ErrEmptyHistory)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	events, err := replay.DecodeEvents(in.GetEvents())
+	if err != nil {
+		err = messages.ErrMalformedRequest.WithFormat(err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	result, err := a.workflowEngine.ReplayWorkflow(ctx, &replay.History{
+		InstanceID: in.GetInstanceId(),
+		Events:     events,
+	})
+	if err != nil {
+		err = messages.ErrReplayWorkflow.WithFormat(in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	return &runtimev1pb.ReplayWorkflowResponse{
+		Deterministic: result.Deterministic,
+		Divergences:   result.Divergences,
+	}, nil
+}
+
+// WorkflowActivityHeartbeatAlpha1 is the API handler for recording the
+// liveness of a running workflow activity. Activities of workflows started
+// with an activity heartbeat timeout are failed if they miss a heartbeat.
+func (a *Universal) WorkflowActivityHeartbeatAlpha1(ctx context.Context, in *runtimev1pb.WorkflowActivityHeartbeatRequest) (*emptypb.Empty, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	emptyResponse := &emptypb.Empty{}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return emptyResponse, err
+	}
+
+	if in.GetTaskId() < 0 {
+		err := messages.ErrInvalidActivityTaskID.WithFormat(strconv.Itoa(int(in.GetTaskId())))
+		a.logger.Debug(err)
+		return emptyResponse, err
+	}
+
+	if err := a.workflowEngine.ActivityHeartbeat(ctx, in.GetInstanceId(), in.GetTaskId()); err != nil {
+		err = messages.ErrWorkflowActivityHeartbeat.WithFormat(in.GetTaskId(), in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return emptyResponse, err
+	}
+	return emptyResponse, nil
+}
+
 // PauseWorkflow is the API handler for pausing a workflow
 func (a *Universal) PauseWorkflow(ctx context.Context, in *runtimev1pb.PauseWorkflowRequest) (*emptypb.Empty, error) {
 	if _, err := a.ActorRouter(ctx); err != nil {
@@ -17,7 +17,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/components-contrib/workflows"
 	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
@@ -25,6 +28,11 @@ import (
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
 )
 
@@ -269,6 +277,255 @@ func TestRaiseEventWorkflowApi(t *testing.T) {
 	}
 }
 
+func TestSignalWithStartWorkflowApi(t *testing.T) {
+	fakeWorkflowName := "fakeWorkflow"
+	fakeEventName := "fake_event_name"
+
+	testCases := []struct {
+		testName      string
+		instanceID    string
+		workflowName  string
+		eventName     string
+		expectedError error
+	}{
+		{
+			testName:      "No instance ID provided in signal with start request",
+			instanceID:    "",
+			workflowName:  fakeWorkflowName,
+			eventName:     fakeEventName,
+			expectedError: messages.ErrMissingOrEmptyInstance,
+		},
+		{
+			testName:      "No workflow name provided in signal with start request",
+			instanceID:    fakeInstanceID,
+			workflowName:  "",
+			eventName:     fakeEventName,
+			expectedError: messages.ErrWorkflowNameMissing,
+		},
+		{
+			testName:      "No event name provided in signal with start request",
+			instanceID:    fakeInstanceID,
+			workflowName:  fakeWorkflowName,
+			eventName:     "",
+			expectedError: messages.ErrMissingWorkflowEventName,
+		},
+		{
+			testName:     "All is well in signal with start request",
+			instanceID:   fakeInstanceID,
+			workflowName: fakeWorkflowName,
+			eventName:    fakeEventName,
+		},
+	}
+
+	var gotReq *workflows.StartRequest
+	var gotEventName string
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithSignalWithStart(func(_ context.Context, req *workflows.StartRequest, eventName string, _ *wrapperspb.StringValue) error {
+			gotReq, gotEventName = req, eventName
+			return nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := &runtimev1pb.SignalWithStartWorkflowRequest{
+				Start: &runtimev1pb.StartWorkflowRequest{
+					WorkflowComponent: fakeComponentName,
+					InstanceId:        tt.instanceID,
+					WorkflowName:      tt.workflowName,
+					Input:             []byte("fake_input"),
+				},
+				EventName: tt.eventName,
+				EventData: []byte("fake_event"),
+			}
+			resp, err := fakeAPI.SignalWithStartWorkflowAlpha1(t.Context(), req)
+
+			if tt.expectedError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.instanceID, resp.GetInstanceId())
+				require.Equal(t, tt.instanceID, *gotReq.InstanceID)
+				require.Equal(t, tt.workflowName, gotReq.WorkflowName)
+				require.Equal(t, tt.eventName, gotEventName)
+			} else {
+				require.ErrorIs(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestUpdateWorkflowApi(t *testing.T) {
+	fakeEventName := "fake_event_name"
+
+	testCases := []struct {
+		testName         string
+		instanceID       string
+		eventName        string
+		expectedResponse []byte
+		expectedError    error
+	}{
+		{
+			testName:      "No instance ID provided in update request",
+			instanceID:    "",
+			eventName:     fakeEventName,
+			expectedError: messages.ErrMissingOrEmptyInstance,
+		},
+		{
+			testName:      "No event name provided in update request",
+			instanceID:    fakeInstanceID,
+			eventName:     "",
+			expectedError: messages.ErrMissingWorkflowEventName,
+		},
+		{
+			testName:         "All is well in update request",
+			instanceID:       fakeInstanceID,
+			eventName:        fakeEventName,
+			expectedResponse: []byte("fake_input_response"),
+		},
+	}
+
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithUpdateWorkflow(func(_ context.Context, _ string, _ string, data *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+			return wrapperspb.String(data.GetValue() + "_response"), nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := &runtimev1pb.UpdateWorkflowRequest{
+				WorkflowComponent: fakeComponentName,
+				InstanceId:        tt.instanceID,
+				EventName:         tt.eventName,
+				EventData:         []byte("fake_input"),
+			}
+			resp, err := fakeAPI.UpdateWorkflowAlpha1(t.Context(), req)
+
+			if tt.expectedError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedResponse, resp.GetData())
+			} else {
+				require.ErrorIs(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestListWorkflowsApi(t *testing.T) {
+	var gotQuery *search.Query
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithListWorkflows(func(_ context.Context, query *search.Query) (*search.QueryResponse, error) {
+			gotQuery = query
+			return &search.QueryResponse{Workflows: []*search.Record{{InstanceID: fakeInstanceID}}}, nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	t.Run("Invalid search attribute key", func(t *testing.T) {
+		_, err := fakeAPI.ListWorkflowsAlpha1(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			SearchAttributes: map[string]*structpb.Value{"customer.id": structpb.NewStringValue("42")},
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidSearchAttribute)
+	})
+
+	t.Run("Invalid search attribute value", func(t *testing.T) {
+		_, err := fakeAPI.ListWorkflowsAlpha1(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			SearchAttributes: map[string]*structpb.Value{"customerId": structpb.NewListValue(&structpb.ListValue{})},
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidSearchAttribute)
+	})
+
+	t.Run("All is well in list request", func(t *testing.T) {
+		resp, err := fakeAPI.ListWorkflowsAlpha1(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			RuntimeStatus:    "running",
+			SearchAttributes: map[string]*structpb.Value{"customerId": structpb.NewNumberValue(42)},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetWorkflows(), 1)
+		assert.Equal(t, fakeInstanceID, resp.GetWorkflows()[0].GetInstanceId())
+		assert.Equal(t, "RUNNING", gotQuery.RuntimeStatus)
+		assert.Equal(t, search.Attributes{"customerId": float64(42)}, gotQuery.SearchAttributes)
+	})
+}
+
+func TestWorkflowHistoryApi(t *testing.T) {
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithWorkflowHistory(func(_ context.Context, instanceID string) (*replay.History, error) {
+			if instanceID != fakeInstanceID {
+				return nil, api.ErrInstanceNotFound
+			}
+			return &replay.History{InstanceID: instanceID, Events: []*backend.HistoryEvent{{EventId: -1}}}, nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	t.Run("No instance ID provided in history request", func(t *testing.T) {
+		_, err := fakeAPI.GetWorkflowHistoryAlpha1(t.Context(), &runtimev1pb.GetWorkflowHistoryRequest{})
+		require.ErrorIs(t, err, messages.ErrMissingOrEmptyInstance)
+	})
+
+	t.Run("Workflow not found", func(t *testing.T) {
+		_, err := fakeAPI.GetWorkflowHistoryAlpha1(t.Context(), &runtimev1pb.GetWorkflowHistoryRequest{InstanceId: "unknown"})
+		require.ErrorIs(t, err, messages.ErrWorkflowInstanceNotFound)
+	})
+
+	t.Run("All is well in history request", func(t *testing.T) {
+		resp, err := fakeAPI.GetWorkflowHistoryAlpha1(t.Context(), &runtimev1pb.GetWorkflowHistoryRequest{InstanceId: fakeInstanceID})
+		require.NoError(t, err)
+		assert.Equal(t, fakeInstanceID, resp.GetInstanceId())
+		assert.Len(t, resp.GetEvents(), 1)
+	})
+}
+
+func TestReplayWorkflowApi(t *testing.T) {
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithReplayWorkflow(func(_ context.Context, history *replay.History) (*replay.Result, error) {
+			assert.Equal(t, fakeInstanceID, history.InstanceID)
+			return &replay.Result{Deterministic: true}, nil
+		}),
+		actors: actorsfake.New(),
+	}
+	events := []*backend.HistoryEvent{{
+		EventId: -1,
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{Name: "wf"},
+		},
+	}}
+	encoded, err := replay.EncodeEvents(events)
+	require.NoError(t, err)
+
+	t.Run("No instance ID provided in replay request", func(t *testing.T) {
+		_, err := fakeAPI.ReplayWorkflowAlpha1(t.Context(), &runtimev1pb.ReplayWorkflowRequest{Events: encoded})
+		require.ErrorIs(t, err, messages.ErrMissingOrEmptyInstance)
+	})
+
+	t.Run("Empty history", func(t *testing.T) {
+		_, err := fakeAPI.ReplayWorkflowAlpha1(t.Context(), &runtimev1pb.ReplayWorkflowRequest{InstanceId: fakeInstanceID})
+		require.ErrorIs(t, err, messages.ErrMalformedRequest)
+	})
+
+	t.Run("Invalid events", func(t *testing.T) {
+		_, err := fakeAPI.ReplayWorkflowAlpha1(t.Context(), &runtimev1pb.ReplayWorkflowRequest{InstanceId: fakeInstanceID, Events: [][]byte{{0xff}}})
+		require.ErrorIs(t, err, messages.ErrMalformedRequest)
+	})
+
+	t.Run("All is well in replay request", func(t *testing.T) {
+		res, err := fakeAPI.ReplayWorkflowAlpha1(t.Context(), &runtimev1pb.ReplayWorkflowRequest{InstanceId: fakeInstanceID, Events: encoded})
+		require.NoError(t, err)
+		assert.True(t, res.GetDeterministic())
+	})
+}
+
 func TestPauseWorkflowApi(t *testing.T) {
 	testCases := []struct {
 		testName          string
@@ -65,6 +65,27 @@ type ConfigurationSpec struct {
 	WasmSpec *WasmSpec `json:"wasm,omitempty"`
 	// +optional
 	WorkflowSpec *WorkflowSpec `json:"workflow,omitempty"`
+	// +optional
+	ActorSpec *ActorSpec `json:"actors,omitempty"`
+}
+
+// ActorSpec defines the configuration for Dapr actors.
+type ActorSpec struct {
+	// lifecycleEvents configures the publishing of actor activation and deactivation events to a pub/sub topic.
+	// +optional
+	LifecycleEvents *ActorLifecycleEventsSpec `json:"lifecycleEvents,omitempty"`
+}
+
+// ActorLifecycleEventsSpec defines the pub/sub topic actor lifecycle events are published to.
+type ActorLifecycleEventsSpec struct {
+	// pubsubName is the name of the pub/sub component to publish lifecycle events to.
+	PubsubName string `json:"pubsubName"`
+	// topic is the name of the topic to publish lifecycle events to.
+	Topic string `json:"topic"`
+	// actorTypes is the list of actor types to publish lifecycle events for.
+	// If omitted, events are published for all actor types hosted by this Dapr instance.
+	// +optional
+	ActorTypes []string `json:"actorTypes,omitempty"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -79,6 +100,112 @@ type WorkflowSpec struct {
 	// If omitted, the default value of 100 will be used.
 	// +optional
 	MaxConcurrentActivityInvocations int32 `json:"maxConcurrentActivityInvocations,omitempty"`
+	// searchAttributesStateStore is the name of the state store in which the search attributes of workflows are indexed.
+	// Listing workflows by search attributes requires the state store to support queries.
+	// If omitted, the actor state store is used.
+	// +optional
+	SearchAttributesStateStore string `json:"searchAttributesStateStore,omitempty"`
+	// historyLimits configures the limits on the history of workflows, and what happens when a workflow exceeds them.
+	// If omitted, the history of workflows is not limited.
+	// +optional
+	HistoryLimits *WorkflowHistoryLimitsSpec `json:"historyLimits,omitempty"`
+	// limits are the concurrency and rate limits of the invocations of workflows and activities with a given name.
+	// Invocations beyond a limit are queued until they are allowed by the limit.
+	// +optional
+	Limits []WorkflowLimitSpec `json:"limits,omitempty"`
+	// payloadOffloading configures the offloading of large workflow payloads to external storage.
+	// If omitted, payloads are stored in the history of workflows.
+	// +optional
+	PayloadOffloading *WorkflowPayloadOffloadingSpec `json:"payloadOffloading,omitempty"`
+	// lifecycleEvents configures the publishing of workflow and activity lifecycle events to a pub/sub topic.
+	// +optional
+	LifecycleEvents *WorkflowLifecycleEventsSpec `json:"lifecycleEvents,omitempty"`
+}
+
+// WorkflowLifecycleEventsSpec defines the pub/sub topic workflow lifecycle events are published to.
+type WorkflowLifecycleEventsSpec struct {
+	// pubsubName is the name of the pub/sub component to publish lifecycle events to.
+	PubsubName string `json:"pubsubName"`
+	// topic is the name of the topic to publish lifecycle events to.
+	Topic string `json:"topic"`
+	// workflowNames is the list of workflow names to publish lifecycle events for.
+	// If omitted, events are published for all workflows.
+	// +optional
+	WorkflowNames []string `json:"workflowNames,omitempty"`
+}
+
+// WorkflowPayloadOffloadingSpec configures the offloading of the inputs, outputs and event data of workflows
+// and activities which exceed a size threshold.
+type WorkflowPayloadOffloadingSpec struct {
+	// thresholdBytes is the size, in bytes, above which payloads are offloaded.
+	// If omitted, payloads are not offloaded.
+	// +optional
+	ThresholdBytes int64 `json:"thresholdBytes,omitempty"`
+	// stateStore is the name of the state store in which payloads are offloaded.
+	// Exactly one of stateStore and binding must be set.
+	// +optional
+	StateStore string `json:"stateStore,omitempty"`
+	// binding is the name of the output binding, supporting the "create", "get" and "delete" operations,
+	// to which payloads are offloaded.
+	// Exactly one of stateStore and binding must be set.
+	// +optional
+	Binding string `json:"binding,omitempty"`
+	// bindingKeyMetadata is the name of the metadata property with which the key of a payload is passed to the binding.
+	// If omitted, the default value of "key" will be used.
+	// +optional
+	BindingKeyMetadata string `json:"bindingKeyMetadata,omitempty"`
+}
+
+// WorkflowLimitSpec configures the limits of the invocations of the workflows or activities with a given name.
+type WorkflowLimitSpec struct {
+	// workflowName is the name of the workflows to which the limit applies.
+	// Exactly one of workflowName and activityName must be set.
+	// +optional
+	WorkflowName string `json:"workflowName,omitempty"`
+	// activityName is the name of the activities to which the limit applies.
+	// Exactly one of workflowName and activityName must be set.
+	// +optional
+	ActivityName string `json:"activityName,omitempty"`
+	// maxConcurrentInvocations is the maximum number of concurrent invocations. For workflows, it is the
+	// maximum number of running instances.
+	// If omitted, the number of concurrent invocations is not limited.
+	// +optional
+	MaxConcurrentInvocations int32 `json:"maxConcurrentInvocations,omitempty"`
+	// maxInvocationsPerSecond is the maximum number of invocations started per second. For workflows, it is
+	// the maximum number of instances started per second.
+	// If omitted, the rate of invocations is not limited.
+	// +optional
+	MaxInvocationsPerSecond int32 `json:"maxInvocationsPerSecond,omitempty"`
+	// scope is the scope in which the limit is enforced: "sidecar" enforces the limit in every Dapr instance,
+	// "cluster" enforces the limit across all the Dapr instances of the app.
+	// If omitted, the limit is enforced in every Dapr instance.
+	// +kubebuilder:validation:Enum=sidecar;cluster
+	// +optional
+	Scope string `json:"scope,omitempty"`
+}
+
+// WorkflowHistoryLimitsSpec configures the limits on the history of workflows.
+type WorkflowHistoryLimitsSpec struct {
+	// maxEvents is the maximum number of events in the history of a workflow.
+	// If omitted, the number of events is not limited.
+	// +optional
+	MaxEvents int32 `json:"maxEvents,omitempty"`
+	// maxSizeBytes is the maximum total size, in bytes, of the events in the history of a workflow.
+	// If omitted, the size of the history is not limited.
+	// +optional
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+	// warningThresholdPercent is the percentage of a limit above which a warning is logged for a workflow.
+	// If omitted, the default value of 80 will be used.
+	// +optional
+	WarningThresholdPercent int32 `json:"warningThresholdPercent,omitempty"`
+	// onLimitExceeded is the action taken when a workflow exceeds a limit: "fail" fails the workflow,
+	// "continueAsNew" continues the workflow as new from its carry-over state. A workflow sets its carry-over
+	// state by sending an event named "dapr.workflow.set_carryover_state" to its own instance; the data of the
+	// latest such event is the input of the new execution, or the original input is used if none was sent.
+	// If omitted, workflows are failed.
+	// +kubebuilder:validation:Enum=fail;continueAsNew
+	// +optional
+	OnLimitExceeded string `json:"onLimitExceeded,omitempty"`
 }
 
 // APISpec describes the configuration for Dapr APIs.
@@ -24,6 +24,46 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActorLifecycleEventsSpec) DeepCopyInto(out *ActorLifecycleEventsSpec) {
+	*out = *in
+	if in.ActorTypes != nil {
+		in, out := &in.ActorTypes, &out.ActorTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorLifecycleEventsSpec.
+func (in *ActorLifecycleEventsSpec) DeepCopy() *ActorLifecycleEventsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActorLifecycleEventsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActorSpec) DeepCopyInto(out *ActorSpec) {
+	*out = *in
+	if in.LifecycleEvents != nil {
+		in, out := &in.LifecycleEvents, &out.LifecycleEvents
+		*out = new(ActorLifecycleEventsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorSpec.
+func (in *ActorSpec) DeepCopy() *ActorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIAccessRule) DeepCopyInto(out *APIAccessRule) {
 	*out = *in
@@ -314,7 +354,12 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	if in.WorkflowSpec != nil {
 		in, out := &in.WorkflowSpec, &out.WorkflowSpec
 		*out = new(WorkflowSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActorSpec != nil {
+		in, out := &in.ActorSpec, &out.ActorSpec
+		*out = new(ActorSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -770,9 +815,94 @@ func (in *WasmSpec) DeepCopy() *WasmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowHistoryLimitsSpec) DeepCopyInto(out *WorkflowHistoryLimitsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowHistoryLimitsSpec.
+func (in *WorkflowHistoryLimitsSpec) DeepCopy() *WorkflowHistoryLimitsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowHistoryLimitsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowLimitSpec) DeepCopyInto(out *WorkflowLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowLimitSpec.
+func (in *WorkflowLimitSpec) DeepCopy() *WorkflowLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowLifecycleEventsSpec) DeepCopyInto(out *WorkflowLifecycleEventsSpec) {
+	*out = *in
+	if in.WorkflowNames != nil {
+		in, out := &in.WorkflowNames, &out.WorkflowNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowLifecycleEventsSpec.
+func (in *WorkflowLifecycleEventsSpec) DeepCopy() *WorkflowLifecycleEventsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowLifecycleEventsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowPayloadOffloadingSpec) DeepCopyInto(out *WorkflowPayloadOffloadingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowPayloadOffloadingSpec.
+func (in *WorkflowPayloadOffloadingSpec) DeepCopy() *WorkflowPayloadOffloadingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowPayloadOffloadingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
+	if in.HistoryLimits != nil {
+		in, out := &in.HistoryLimits, &out.HistoryLimits
+		*out = new(WorkflowHistoryLimitsSpec)
+		**out = **in
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]WorkflowLimitSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.PayloadOffloading != nil {
+		in, out := &in.PayloadOffloading, &out.PayloadOffloading
+		*out = new(WorkflowPayloadOffloadingSpec)
+		**out = **in
+	}
+	if in.LifecycleEvents != nil {
+		in, out := &in.LifecycleEvents, &out.LifecycleEvents
+		*out = new(WorkflowLifecycleEventsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
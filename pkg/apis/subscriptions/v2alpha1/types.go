@@ -58,6 +58,72 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional ordered list of retry tiers that failed events are
+	// republished through before being sent to the dead letter topic.
+	// Retry tiers must have distinct topics, and aren't supported with
+	// bulk subscriptions.
+	// +optional
+	RetryTopics []RetryTopic `json:"retryTopics,omitempty"`
+	// The optional maximum number of messages delivered to the app at the
+	// same time for this topic.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+	// The optional maximum number of messages delivered to the app per second
+	// for this topic.
+	// +optional
+	MaxMessagesPerSecond int32 `json:"maxMessagesPerSecond,omitempty"`
+	// The optional transformation of the events of this topic before they
+	// are delivered to the app.
+	// +optional
+	Transform *Transform `json:"transform,omitempty"`
+	// The optional ordered delivery of the events of this topic. Events with
+	// the same ordering key are delivered serially, in the order they were
+	// received.
+	// +optional
+	Ordering *Ordering `json:"ordering,omitempty"`
+}
+
+// Ordering configures the ordered delivery of the events of a topic.
+type Ordering struct {
+	// The CEL expression, evaluated with the variable "event", the cloud
+	// event, which gives the ordering key of the event. For example
+	// "event.data.customerId".
+	Key string `json:"key"`
+	// The optional maximum number of events waiting to be delivered per
+	// ordering key. Receiving further events for a full key blocks until
+	// there is space. Defaults to 100.
+	// +optional
+	MaxQueue int32 `json:"maxQueue,omitempty"`
+}
+
+// Transform reshapes the events of a topic before they are delivered to the
+// app. Expressions are CEL expressions evaluated with the variables "event",
+// the cloud event, and "data", its payload.
+type Transform struct {
+	// The optional expression selecting the payload delivered to the app from
+	// the event, unwrapping it from an envelope. For example "data.detail".
+	// +optional
+	Unwrap string `json:"unwrap,omitempty"`
+	// The optional fields of the payload delivered to the app, from the field
+	// name, with dots creating nested objects, to the expression computing
+	// the field value. When set, the payload is replaced by an object with
+	// these fields only.
+	// +optional
+	Fields map[string]string `json:"fields,omitempty"`
+	// The optional headers injected into the message delivered to the app,
+	// from the header name to the expression computing the header value.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// RetryTopic is a single delay tier used to retry failed events.
+type RetryTopic struct {
+	// The delay before the event is redelivered, e.g. "10s" or "1m".
+	Delay string `json:"delay"`
+	// The topic the event is republished to for this tier.
+	// Defaults to "<topic>-retry-<delay>".
+	// +optional
+	Topic string `json:"topic,omitempty"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
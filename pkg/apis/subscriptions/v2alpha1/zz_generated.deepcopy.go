@@ -39,6 +39,36 @@ func (in *BulkSubscribe) DeepCopy() *BulkSubscribe {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ordering) DeepCopyInto(out *Ordering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ordering.
+func (in *Ordering) DeepCopy() *Ordering {
+	if in == nil {
+		return nil
+	}
+	out := new(Ordering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopic) DeepCopyInto(out *RetryTopic) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopic.
+func (in *RetryTopic) DeepCopy() *RetryTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Routes) DeepCopyInto(out *Routes) {
 	*out = *in
@@ -149,6 +179,21 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 	}
 	in.Routes.DeepCopyInto(&out.Routes)
 	out.BulkSubscribe = in.BulkSubscribe
+	if in.RetryTopics != nil {
+		in, out := &in.RetryTopics, &out.RetryTopics
+		*out = make([]RetryTopic, len(*in))
+		copy(*out, *in)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(Transform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ordering != nil {
+		in, out := &in.Ordering, &out.Ordering
+		*out = new(Ordering)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
@@ -160,3 +205,32 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Transform) DeepCopyInto(out *Transform) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transform.
+func (in *Transform) DeepCopy() *Transform {
+	if in == nil {
+		return nil
+	}
+	out := new(Transform)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,6 +20,8 @@ import (
 	"io"
 	"sync"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/components/pluggable"
 	proto "github.com/dapr/dapr/pkg/proto/components/v1"
@@ -114,6 +116,20 @@ func (p *grpcPubSub) BulkPublish(ctx context.Context, req *pubsub.BulkPublishReq
 	return pubsub.BulkPublishResponse{FailedEntries: failedEntries}, nil
 }
 
+// Seek resets the position of the subscription to the topic.
+func (p *grpcPubSub) Seek(ctx context.Context, req *SeekRequest) error {
+	protoReq := &proto.SeekRequest{
+		Topic:    req.Topic,
+		Offset:   req.Offset,
+		Metadata: req.Metadata,
+	}
+	if req.Timestamp != nil {
+		protoReq.Timestamp = timestamppb.New(*req.Timestamp)
+	}
+	_, err := p.Client.Seek(ctx, protoReq)
+	return err
+}
+
 type messageHandler = func(*proto.PullMessagesResponse)
 
 // adaptHandler returns a non-error function that handle the message with the given handler and ack when returns.
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	guuid "github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,8 @@ type server struct {
 	onAckReceived   func(*proto.PullMessagesRequest)
 	pullCalled      atomic.Int64
 	pullErr         error
+	onSeekCalled    func(*proto.SeekRequest)
+	seekErr         error
 }
 
 //nolint:nosnakecase
@@ -109,6 +112,13 @@ func (s *server) Ping(context.Context, *proto.PingRequest) (*proto.PingResponse,
 	return &proto.PingResponse{}, s.pingErr
 }
 
+func (s *server) Seek(_ context.Context, req *proto.SeekRequest) (*proto.SeekResponse, error) {
+	if s.onSeekCalled != nil {
+		s.onSeekCalled(req)
+	}
+	return &proto.SeekResponse{}, s.seekErr
+}
+
 func TestPubSubPluggableCalls(t *testing.T) {
 	getPubSub := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
 		proto.RegisterPubSubServer(s, svc)
@@ -210,6 +220,33 @@ func TestPubSubPluggableCalls(t *testing.T) {
 		assert.Equal(t, int64(1), svc.publishCalled.Load())
 	})
 
+	t.Run("seek should call seek grpc method", func(t *testing.T) {
+		ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		var got *proto.SeekRequest
+		svc := &server{
+			onSeekCalled: func(req *proto.SeekRequest) {
+				got = req
+			},
+		}
+		ps, cleanup, err := getPubSub(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		require.NoError(t, ps.Seek(t.Context(), &SeekRequest{
+			Topic:     "fakeTopic",
+			Timestamp: &ts,
+			Metadata:  map[string]string{"partition": "1"},
+		}))
+		require.NotNil(t, got)
+		assert.Equal(t, "fakeTopic", got.GetTopic())
+		assert.Equal(t, ts, got.GetTimestamp().AsTime())
+		assert.Empty(t, got.GetOffset())
+		assert.Equal(t, map[string]string{"partition": "1"}, got.GetMetadata())
+
+		svc.seekErr = errors.New("fake-seek-err")
+		require.Error(t, ps.Seek(t.Context(), &SeekRequest{Topic: "fakeTopic", Offset: "1"}))
+	})
+
 	t.Run("subscribe should callback handler when new messages arrive", func(t *testing.T) {
 		const fakeTopic, fakeData1, fakeData2 = "fakeTopic", "fakeData1", "fakeData2"
 		var (
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// FeatureSeek is the feature advertised by pubsub components which can reset
+// the position of a subscription. Components advertising it implement Seeker.
+// The feature and the interface are defined by Dapr rather than
+// components-contrib, so only pluggable components, through the Seek RPC,
+// can seek for now.
+const FeatureSeek pubsub.Feature = "SEEK"
+
+// SeekRequest resets the position of the subscription to a topic, either to a
+// timestamp or to a broker specific offset.
+type SeekRequest struct {
+	Topic     string            `json:"topic"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+	Offset    string            `json:"offset,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// Seeker is implemented by pubsub components which can reset the position of
+// a subscription, after which the messages from that position are delivered
+// again.
+type Seeker interface {
+	Seek(ctx context.Context, req *SeekRequest) error
+}
+
+// Validate returns an error if the request doesn't set exactly one of the
+// timestamp and the offset.
+func (r *SeekRequest) Validate() error {
+	switch {
+	case r.Timestamp != nil && r.Offset != "":
+		return errors.New("only one of timestamp and offset can be set")
+	case r.Timestamp == nil && r.Offset == "":
+		return errors.New("one of timestamp and offset is required")
+	default:
+		return nil
+	}
+}
@@ -23,6 +23,11 @@ type ApplicationConfig struct {
 	DrainRebalancedActors      bool             `json:"drainRebalancedActors"`
 	Reentrancy                 ReentrancyConfig `json:"reentrancy,omitempty"`
 	RemindersStoragePartitions int              `json:"remindersStoragePartitions"`
+	// Version of the actor types implementation hosted by this app. When set,
+	// multiple versions of an actor type can be deployed side-by-side.
+	ActorTypeVersion string `json:"actorTypeVersion,omitempty"`
+	// Routing policy between the registered versions of an actor type.
+	ActorTypeVersionRouting ActorVersionRouting `json:"actorTypeVersionRouting,omitempty"`
 
 	// Duplicate of the above config so we can assign it to individual entities.
 	EntityConfigs []EntityConfig `json:"entitiesConfig,omitempty"`
@@ -38,8 +43,25 @@ type EntityConfig struct {
 	// Duration. example: "1h".
 	ActorIdleTimeout string `json:"actorIdleTimeout"`
 	// Duration. example: "30s".
-	DrainOngoingCallTimeout    string           `json:"drainOngoingCallTimeout"`
-	DrainRebalancedActors      bool             `json:"drainRebalancedActors"`
-	Reentrancy                 ReentrancyConfig `json:"reentrancy,omitempty"`
-	RemindersStoragePartitions int              `json:"remindersStoragePartitions"`
+	DrainOngoingCallTimeout    string              `json:"drainOngoingCallTimeout"`
+	DrainRebalancedActors      bool                `json:"drainRebalancedActors"`
+	Reentrancy                 ReentrancyConfig    `json:"reentrancy,omitempty"`
+	RemindersStoragePartitions int                 `json:"remindersStoragePartitions"`
+	ActorTypeVersion           string              `json:"actorTypeVersion,omitempty"`
+	ActorTypeVersionRouting    ActorVersionRouting `json:"actorTypeVersionRouting,omitempty"`
 }
+
+// ActorVersionRouting is the policy used to route actors between side-by-side
+// deployments of different versions of the same actor type.
+type ActorVersionRouting string
+
+const (
+	// ActorVersionRoutingNewest routes all actors to the newest registered
+	// version of the actor type.
+	ActorVersionRoutingNewest ActorVersionRouting = "newest"
+	// ActorVersionRoutingPinned pins every actor to the version it was first
+	// activated on. Actors stay on that version until they are explicitly
+	// migrated, or until all hosts of that version are removed, at which point
+	// they are pinned to the newest version.
+	ActorVersionRoutingPinned ActorVersionRouting = "pinned"
+)
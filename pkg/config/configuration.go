@@ -131,6 +131,29 @@ type ConfigurationSpec struct {
 	LoggingSpec         *LoggingSpec        `json:"logging,omitempty"         yaml:"logging,omitempty"`
 	WasmSpec            *WasmSpec           `json:"wasm,omitempty"            yaml:"wasm,omitempty"`
 	WorkflowSpec        *WorkflowSpec       `json:"workflow,omitempty"        yaml:"workflow,omitempty"`
+	ActorSpec           *ActorSpec          `json:"actors,omitempty"          yaml:"actors,omitempty"`
+}
+
+// ActorSpec defines the configuration for Dapr actors.
+type ActorSpec struct {
+	// lifecycleEvents configures the publishing of actor activation and deactivation events to a pub/sub topic.
+	LifecycleEvents *ActorLifecycleEventsSpec `json:"lifecycleEvents,omitempty" yaml:"lifecycleEvents,omitempty"`
+}
+
+// ActorLifecycleEventsSpec defines the pub/sub topic actor lifecycle events are published to.
+type ActorLifecycleEventsSpec struct {
+	// pubsubName is the name of the pub/sub component to publish lifecycle events to.
+	PubsubName string `json:"pubsubName" yaml:"pubsubName"`
+	// topic is the name of the topic to publish lifecycle events to.
+	Topic string `json:"topic" yaml:"topic"`
+	// actorTypes is the list of actor types to publish lifecycle events for.
+	// If omitted, events are published for all actor types hosted by this Dapr instance.
+	ActorTypes []string `json:"actorTypes,omitempty" yaml:"actorTypes,omitempty"`
+}
+
+// Enabled returns true if actor lifecycle events are configured to be published.
+func (a *ActorLifecycleEventsSpec) Enabled() bool {
+	return a != nil && a.PubsubName != "" && a.Topic != ""
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -143,6 +166,97 @@ type WorkflowSpec struct {
 	// Attempted invocations beyond this will be queued until the number of concurrent invocations drops below this value.
 	// If omitted, the default value of 100 will be used.
 	MaxConcurrentActivityInvocations int32 `json:"maxConcurrentActivityInvocations,omitempty" yaml:"maxConcurrentActivityInvocations,omitempty"`
+	// searchAttributesStateStore is the name of the state store in which the search attributes of workflows are indexed.
+	// Listing workflows by search attributes requires the state store to support queries.
+	// If omitted, the actor state store is used.
+	SearchAttributesStateStore string `json:"searchAttributesStateStore,omitempty" yaml:"searchAttributesStateStore,omitempty"`
+	// historyLimits configures the limits on the history of workflows, and what happens when a workflow exceeds them.
+	// If omitted, the history of workflows is not limited.
+	HistoryLimits *WorkflowHistoryLimitsSpec `json:"historyLimits,omitempty" yaml:"historyLimits,omitempty"`
+	// limits are the concurrency and rate limits of the invocations of workflows and activities with a given name.
+	// Invocations beyond a limit are queued until they are allowed by the limit.
+	Limits []WorkflowLimitSpec `json:"limits,omitempty" yaml:"limits,omitempty"`
+	// payloadOffloading configures the offloading of large workflow payloads to external storage.
+	// If omitted, payloads are stored in the history of workflows.
+	PayloadOffloading *WorkflowPayloadOffloadingSpec `json:"payloadOffloading,omitempty" yaml:"payloadOffloading,omitempty"`
+	// lifecycleEvents configures the publishing of workflow and activity lifecycle events to a pub/sub topic.
+	LifecycleEvents *WorkflowLifecycleEventsSpec `json:"lifecycleEvents,omitempty" yaml:"lifecycleEvents,omitempty"`
+}
+
+// WorkflowLifecycleEventsSpec defines the pub/sub topic workflow lifecycle events are published to.
+type WorkflowLifecycleEventsSpec struct {
+	// pubsubName is the name of the pub/sub component to publish lifecycle events to.
+	PubsubName string `json:"pubsubName" yaml:"pubsubName"`
+	// topic is the name of the topic to publish lifecycle events to.
+	Topic string `json:"topic" yaml:"topic"`
+	// workflowNames is the list of workflow names to publish lifecycle events for.
+	// If omitted, events are published for all workflows.
+	WorkflowNames []string `json:"workflowNames,omitempty" yaml:"workflowNames,omitempty"`
+}
+
+// Enabled returns true if workflow lifecycle events are configured to be published.
+func (w *WorkflowLifecycleEventsSpec) Enabled() bool {
+	return w != nil && w.PubsubName != "" && w.Topic != ""
+}
+
+// WorkflowPayloadOffloadingSpec configures the offloading of the inputs, outputs and event data of workflows
+// and activities which exceed a size threshold. Offloaded payloads are replaced by a reference in the history
+// of the workflow and are resolved when the workflow state is loaded.
+type WorkflowPayloadOffloadingSpec struct {
+	// thresholdBytes is the size, in bytes, above which payloads are offloaded.
+	// If omitted, payloads are not offloaded.
+	ThresholdBytes int64 `json:"thresholdBytes,omitempty" yaml:"thresholdBytes,omitempty"`
+	// stateStore is the name of the state store in which payloads are offloaded.
+	// Exactly one of stateStore and binding must be set.
+	StateStore string `json:"stateStore,omitempty" yaml:"stateStore,omitempty"`
+	// binding is the name of the output binding, supporting the "create", "get" and "delete" operations,
+	// to which payloads are offloaded.
+	// Exactly one of stateStore and binding must be set.
+	Binding string `json:"binding,omitempty" yaml:"binding,omitempty"`
+	// bindingKeyMetadata is the name of the metadata property with which the key of a payload is passed to the binding.
+	// If omitted, the default value of "key" will be used.
+	BindingKeyMetadata string `json:"bindingKeyMetadata,omitempty" yaml:"bindingKeyMetadata,omitempty"`
+}
+
+// WorkflowLimitSpec configures the limits of the invocations of the workflows or activities with a given name.
+type WorkflowLimitSpec struct {
+	// workflowName is the name of the workflows to which the limit applies.
+	// Exactly one of workflowName and activityName must be set.
+	WorkflowName string `json:"workflowName,omitempty" yaml:"workflowName,omitempty"`
+	// activityName is the name of the activities to which the limit applies.
+	// Exactly one of workflowName and activityName must be set.
+	ActivityName string `json:"activityName,omitempty" yaml:"activityName,omitempty"`
+	// maxConcurrentInvocations is the maximum number of concurrent invocations. For workflows, it is the
+	// maximum number of running instances.
+	// If omitted, the number of concurrent invocations is not limited.
+	MaxConcurrentInvocations int32 `json:"maxConcurrentInvocations,omitempty" yaml:"maxConcurrentInvocations,omitempty"`
+	// maxInvocationsPerSecond is the maximum number of invocations started per second. For workflows, it is
+	// the maximum number of instances started per second.
+	// If omitted, the rate of invocations is not limited.
+	MaxInvocationsPerSecond int32 `json:"maxInvocationsPerSecond,omitempty" yaml:"maxInvocationsPerSecond,omitempty"`
+	// scope is the scope in which the limit is enforced: "sidecar" enforces the limit in every Dapr instance,
+	// "cluster" enforces the limit across all the Dapr instances of the app.
+	// If omitted, the limit is enforced in every Dapr instance.
+	Scope string `json:"scope,omitempty" yaml:"scope,omitempty"`
+}
+
+// WorkflowHistoryLimitsSpec configures the limits on the history of workflows.
+type WorkflowHistoryLimitsSpec struct {
+	// maxEvents is the maximum number of events in the history of a workflow.
+	// If omitted, the number of events is not limited.
+	MaxEvents int32 `json:"maxEvents,omitempty" yaml:"maxEvents,omitempty"`
+	// maxSizeBytes is the maximum total size, in bytes, of the events in the history of a workflow.
+	// If omitted, the size of the history is not limited.
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty" yaml:"maxSizeBytes,omitempty"`
+	// warningThresholdPercent is the percentage of a limit above which a warning is logged for a workflow.
+	// If omitted, the default value of 80 will be used.
+	WarningThresholdPercent int32 `json:"warningThresholdPercent,omitempty" yaml:"warningThresholdPercent,omitempty"`
+	// onLimitExceeded is the action taken when a workflow exceeds a limit: "fail" fails the workflow,
+	// "continueAsNew" continues the workflow as new from its carry-over state. A workflow sets its carry-over
+	// state by sending an event named "dapr.workflow.set_carryover_state" to its own instance; the data of the
+	// latest such event is the input of the new execution, or the original input is used if none was sent.
+	// If omitted, workflows are failed.
+	OnLimitExceeded string `json:"onLimitExceeded,omitempty" yaml:"onLimitExceeded,omitempty"`
 }
 
 func (w *WorkflowSpec) GetMaxConcurrentWorkflowInvocations() int32 {
@@ -781,6 +895,15 @@ func (c *Configuration) GetWorkflowSpec() WorkflowSpec {
 	return *c.Spec.WorkflowSpec
 }
 
+// GetActorSpec returns the Actor spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c *Configuration) GetActorSpec() ActorSpec {
+	if c == nil || c.Spec.ActorSpec == nil {
+		return ActorSpec{}
+	}
+	return *c.Spec.ActorSpec
+}
+
 // ToYAML returns the Configuration represented as YAML.
 func (c *Configuration) ToYAML() (string, error) {
 	b, err := yaml.Marshal(c)
@@ -203,6 +203,11 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 		workflowSpec := config.GetWorkflowSpec()
 		assert.Equal(t, int32(32), workflowSpec.MaxConcurrentWorkflowInvocations)
 		assert.Equal(t, int32(64), workflowSpec.MaxConcurrentActivityInvocations)
+		require.NotNil(t, workflowSpec.LifecycleEvents)
+		assert.True(t, workflowSpec.LifecycleEvents.Enabled())
+		assert.Equal(t, "mypubsub", workflowSpec.LifecycleEvents.PubsubName)
+		assert.Equal(t, "workflow-events", workflowSpec.LifecycleEvents.Topic)
+		assert.Equal(t, []string{"myworkflow"}, workflowSpec.LifecycleEvents.WorkflowNames)
 	})
 
 	t.Run("workflow spec - defaults", func(t *testing.T) {
@@ -214,6 +219,24 @@ func TestLoadStandaloneConfiguration(t *testing.T) {
 		// These are the documented default values. Changes to these defaults require changes to
 		assert.Equal(t, int32(2147483647), workflowSpec.MaxConcurrentWorkflowInvocations)
 		assert.Equal(t, int32(2147483647), workflowSpec.MaxConcurrentActivityInvocations)
+		assert.False(t, workflowSpec.LifecycleEvents.Enabled())
+	})
+
+	t.Run("actor spec - configured", func(t *testing.T) {
+		config, err := LoadStandaloneConfiguration("./testdata/actor_config.yaml")
+		require.NoError(t, err)
+		lifecycleEvents := config.GetActorSpec().LifecycleEvents
+		require.NotNil(t, lifecycleEvents)
+		assert.True(t, lifecycleEvents.Enabled())
+		assert.Equal(t, "mypubsub", lifecycleEvents.PubsubName)
+		assert.Equal(t, "actor-events", lifecycleEvents.Topic)
+		assert.Equal(t, []string{"mytype"}, lifecycleEvents.ActorTypes)
+	})
+
+	t.Run("actor spec - defaults", func(t *testing.T) {
+		config, err := LoadStandaloneConfiguration("./testdata/mtls_config.yaml")
+		require.NoError(t, err)
+		assert.False(t, config.GetActorSpec().LifecycleEvents.Enabled())
 	})
 
 	t.Run("multiple configurations", func(t *testing.T) {
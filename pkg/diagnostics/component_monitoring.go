@@ -35,6 +35,7 @@ const (
 type componentMetrics struct {
 	pubsubIngressCount          *stats.Int64Measure
 	pubsubIngressLatency        *stats.Float64Measure
+	pubsubIngressDuplicateCount *stats.Int64Measure
 	bulkPubsubIngressCount      *stats.Int64Measure
 	bulkPubsubEventIngressCount *stats.Int64Measure
 	bulkPubsubIngressLatency    *stats.Float64Measure
@@ -80,6 +81,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/pubsub_ingress/latencies",
 			"The consuming app event processing latency.",
 			stats.UnitMilliseconds),
+		pubsubIngressDuplicateCount: stats.Int64(
+			"component/pubsub_ingress/duplicate/count",
+			"The number of incoming messages skipped as duplicates of already processed messages.",
+			stats.UnitDimensionless),
 		bulkPubsubIngressCount: stats.Int64(
 			"component/pubsub_ingress/bulk/count",
 			"The number of incoming bulk subscribe calls arriving from the bulk pub/sub component.",
@@ -180,6 +185,7 @@ func (c *componentMetrics) Init(appID, namespace string, latencyDistribution *vi
 	return view.Register(
 		diagUtils.NewMeasureView(c.pubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.pubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey, statusKey}, view.Count()),
+		diagUtils.NewMeasureView(c.pubsubIngressDuplicateCount, []tag.Key{appIDKey, componentKey, namespaceKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.bulkPubsubIngressLatency, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.bulkPubsubIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
 		diagUtils.NewMeasureView(c.bulkPubsubEventIngressCount, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey, topicKey}, view.Count()),
@@ -224,6 +230,17 @@ func (c *componentMetrics) PubsubIngressEvent(ctx context.Context, component, pr
 	}
 }
 
+// PubsubIngressDuplicateEvent records the metrics for a pub/sub ingress event
+// skipped as a duplicate.
+func (c *componentMetrics) PubsubIngressDuplicateEvent(ctx context.Context, component, topic string) {
+	if c.enabled {
+		stats.RecordWithTags(
+			ctx,
+			diagUtils.WithTags(c.pubsubIngressDuplicateCount.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, topicKey, topic),
+			c.pubsubIngressDuplicateCount.M(1))
+	}
+}
+
 // BulkPubsubIngressEvent records the metrics for a bulk pub/sub ingress event.
 func (c *componentMetrics) BulkPubsubIngressEvent(ctx context.Context, component, topic string, elapsed float64) {
 	if c.enabled {
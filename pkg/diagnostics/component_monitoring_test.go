@@ -49,6 +49,18 @@ func TestPubSub(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 	})
 
+	t.Run("record ingress duplicate count", func(t *testing.T) {
+		c := componentsMetrics()
+
+		c.PubsubIngressDuplicateEvent(t.Context(), componentName, "A")
+
+		viewData, _ := view.RetrieveData("component/pubsub_ingress/duplicate/count")
+		v := view.Find("component/pubsub_ingress/duplicate/count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+		assert.Equal(t, int64(1), viewData[0].Data.(*view.CountData).Value)
+	})
+
 	t.Run("record ingress latency", func(t *testing.T) {
 		c := componentsMetrics()
 
@@ -35,6 +35,8 @@ const (
 	GrpcServiceSpanAttributeKey          = string(semconv.RPCServiceKey)
 	NetPeerNameSpanAttributeKey          = string(semconv.NetPeerNameKey)
 	RPCSystemSpanAttributeKey            = string(semconv.RPCSystemKey)
+	// Defined by semantic conventions newer than the ones imported above.
+	MessagingSubscriptionSpanAttributeKey = "messaging.destination.subscription.name"
 
 	DaprAPISpanAttributeKey           = "dapr.api"
 	DaprAPIStatusCodeSpanAttributeKey = "dapr.status_code"
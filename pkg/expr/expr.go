@@ -2,11 +2,13 @@ package expr
 
 import (
 	"encoding/json"
+	"reflect"
 	"strings"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
 	exprProto "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const missingVariableMessage = "undeclared reference to '"
@@ -77,6 +79,30 @@ func (e *Expr) Eval(variables map[string]interface{}) (interface{}, error) {
 	return out.Value(), nil
 }
 
+// EvalJSON evaluates the expression like Eval, but converts the result to a
+// JSON-compatible value, so maps and lists built by the expression can be
+// serialized.
+func (e *Expr) EvalJSON(variables map[string]interface{}) (interface{}, error) {
+	if e.program == nil {
+		err := e.DecodeString(e.expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out, _, err := e.program.Eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*structpb.Value).AsInterface(), nil
+}
+
 func (e *Expr) Expr() string {
 	return e.expr
 }
@@ -27,6 +27,25 @@ func TestEval(t *testing.T) {
 	assert.True(t, result.(bool))
 }
 
+func TestEvalJSON(t *testing.T) {
+	var e expr.Expr
+	err := e.DecodeString(`{"id": input.id, "tags": [input.tag, "static"], "nested": {"count": input.count + 1}}`)
+	require.NoError(t, err)
+	result, err := e.EvalJSON(map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":    "abc",
+			"tag":   "t1",
+			"count": 1,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":     "abc",
+		"tags":   []interface{}{"t1", "static"},
+		"nested": map[string]interface{}{"count": float64(2)},
+	}, result)
+}
+
 func TestJSONMarshal(t *testing.T) {
 	var e expr.Expr
 	exprBytes := []byte(`"(has(input.test) && input.test == 1234) || (has(result.test) && result.test == 5678)"`)
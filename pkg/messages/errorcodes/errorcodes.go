@@ -55,9 +55,13 @@ var (
 	ActorReminderDelete           = ErrorCode{"ERR_ACTOR_REMINDER_DELETE", "", CategoryActor}        // Error deleting actor reminder
 	ActorReminderGet              = ErrorCode{"ERR_ACTOR_REMINDER_GET", "", CategoryActor}           // Error getting actor reminder
 	ActorReminderNonHosted        = ErrorCode{"ERR_ACTOR_REMINDER_NON_HOSTED", "", CategoryActor}    // Reminder operation on non-hosted actor type
+	ActorReminderNameReserved     = ErrorCode{"ERR_ACTOR_REMINDER_NAME_RESERVED", "", CategoryActor} // Reminder name reserved for internal reminders
 	ActorTimerCreate              = ErrorCode{"ERR_ACTOR_TIMER_CREATE", "", CategoryActor}           // Error creating actor timer
+	ActorTimersDelete             = ErrorCode{"ERR_ACTOR_TIMERS_DELETE", "", CategoryActor}          // Error deleting actor timers
+	ActorMigrate                  = ErrorCode{"ERR_ACTOR_MIGRATE", "", CategoryActor}                // Error migrating actor to another version
 	ErrActorNoAppChannel          = ErrorCode{"ERR_ACTOR_NO_APP_CHANNEL", "", CategoryActor}         // App channel not initialized
 	ErrActorMaxStackDepthExceeded = ErrorCode{"ERR_ACTOR_STACK_DEPTH", "", CategoryActor}            // Maximum actor call stack depth exceeded
+	ErrActorDeadlock              = ErrorCode{"ERR_ACTOR_DEADLOCK", "", CategoryActor}               // Actor call chain would deadlock
 	ErrActorNoPlacement           = ErrorCode{"ERR_ACTOR_NO_PLACEMENT", "", CategoryActor}           // Placement service not configured
 	ErrActorRuntimeClosed         = ErrorCode{"ERR_ACTOR_RUNTIME_CLOSED", "", CategoryActor}         // Actor runtime is closed
 	ErrActorNamespaceRequired     = ErrorCode{"ERR_ACTOR_NAMESPACE_REQUIRED", "", CategoryActor}     // Actors must have a namespace configured when running in Kubernetes mode
@@ -71,6 +75,14 @@ var (
 	WorkflowTerminate                 = ErrorCode{"ERR_TERMINATE_WORKFLOW", "", CategoryWorkflow}           // Error terminating workflow
 	WorkflowPurge                     = ErrorCode{"ERR_PURGE_WORKFLOW", "", CategoryWorkflow}               // Error purging workflow
 	WorkflowRaiseEvent                = ErrorCode{"ERR_RAISE_EVENT_WORKFLOW", "", CategoryWorkflow}         // Error raising event in workflow
+	WorkflowSignalWithStart           = ErrorCode{"ERR_SIGNAL_WITH_START", "", CategoryWorkflow}            // Error signaling or starting workflow
+	WorkflowUpdate                    = ErrorCode{"ERR_UPDATE_WORKFLOW", "", CategoryWorkflow}              // Error updating workflow
+	WorkflowList                      = ErrorCode{"ERR_LIST_WORKFLOWS", "", CategoryWorkflow}               // Error listing workflows
+	WorkflowSearchAttributeInvalid    = ErrorCode{"ERR_SEARCH_ATTRIBUTE_INVALID", "", CategoryWorkflow}     // Invalid workflow search attribute
+	WorkflowHistory                   = ErrorCode{"ERR_GET_WORKFLOW_HISTORY", "", CategoryWorkflow}         // Error exporting workflow history
+	WorkflowReplay                    = ErrorCode{"ERR_REPLAY_WORKFLOW", "", CategoryWorkflow}              // Error replaying workflow history
+	WorkflowActivityHeartbeat         = ErrorCode{"ERR_WORKFLOW_ACTIVITY_HEARTBEAT", "", CategoryWorkflow}  // Error recording workflow activity heartbeat
+	WorkflowActivityTaskIDInvalid     = ErrorCode{"ERR_ACTIVITY_TASK_ID_INVALID", "", CategoryWorkflow}     // Invalid workflow activity task ID
 	WorkflowComponentMissing          = ErrorCode{"ERR_WORKFLOW_COMPONENT_MISSING", "", CategoryWorkflow}   // Missing workflow component
 	WorkflowComponentNotFound         = ErrorCode{"ERR_WORKFLOW_COMPONENT_NOT_FOUND", "", CategoryWorkflow} // Workflow component not found
 	WorkflowEventNameMissing          = ErrorCode{"ERR_WORKFLOW_EVENT_NAME_MISSING", "", CategoryWorkflow}  // Missing workflow event name
@@ -116,19 +128,28 @@ var (
 	SecretPermissionDenied   = ErrorCode{"ERR_PERMISSION_DENIED", "", CategorySecret}            // Permission denied by policy
 
 	// ### Pub/Sub and messaging errors
-	PubSubEmpty                 = ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub}                          // Pubsub name is empty
-	PubSubNotFound              = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub}                       // Pubsub not found
-	PubSubTestNotFound          = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub}                  // Pubsub not found
-	PubSubNotConfigured         = ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub}             // Pubsub not configured
-	PubSubTopicNameEmpty        = ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub}                // Topic name is empty
-	PubsubForbidden             = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub}                       // Access to topic forbidden for APP ID
-	PubsubPublishMessage        = ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub}           // Error publishing message
-	PubSubRequestMetadata       = ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub} // Error deserializing metadata
-	PubSubCloudEventsSer        = ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub}     // Error creating CloudEvent
-	PubSubEventsSerEnvelope     = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub}               // Error marshalling Cloud Event envelope
-	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                 // Error marshalling events to bytes
-	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}               // Error unmarshalling events
-	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                              // Error publishing message to outbox
+	PubSubEmpty                 = ErrorCode{"ERR_PUBSUB_EMPTY", "DAPR_PUBSUB_NAME_EMPTY", CategoryPubsub}                              // Pubsub name is empty
+	PubSubNotFound              = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_NOT_FOUND", CategoryPubsub}                           // Pubsub not found
+	PubSubTestNotFound          = ErrorCode{"ERR_PUBSUB_NOT_FOUND", "DAPR_PUBSUB_TEST_NOT_FOUND", CategoryPubsub}                      // Pubsub not found
+	PubSubNotConfigured         = ErrorCode{"ERR_PUBSUB_NOT_CONFIGURED", "DAPR_PUBSUB_NOT_CONFIGURED", CategoryPubsub}                 // Pubsub not configured
+	PubSubTopicNameEmpty        = ErrorCode{"ERR_TOPIC_NAME_EMPTY", "DAPR_PUBSUB_TOPIC_NAME_EMPTY", CategoryPubsub}                    // Topic name is empty
+	PubsubForbidden             = ErrorCode{"ERR_PUBSUB_FORBIDDEN", "DAPR_PUBSUB_FORBIDDEN", CategoryPubsub}                           // Access to topic forbidden for APP ID
+	PubsubPublishMessage        = ErrorCode{"ERR_PUBSUB_PUBLISH_MESSAGE", "DAPR_PUBSUB_PUBLISH_MESSAGE", CategoryPubsub}               // Error publishing message
+	PubSubRequestMetadata       = ErrorCode{"ERR_PUBSUB_REQUEST_METADATA", "DAPR_PUBSUB_METADATA_DESERIALIZATION", CategoryPubsub}     // Error deserializing metadata
+	PubSubCloudEventsSer        = ErrorCode{"ERR_PUBSUB_CLOUD_EVENTS_SER", "DAPR_PUBSUB_CLOUD_EVENT_CREATION", CategoryPubsub}         // Error creating CloudEvent
+	PubSubEventsSerEnvelope     = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_ENVELOPE", CategoryPubsub}                   // Error marshalling Cloud Event envelope
+	PubSubEventsMarshalEvents   = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_MARSHAL_EVENTS", CategoryPubsub}                     // Error marshalling events to bytes
+	PubSubEventsUnmarshalEvents = ErrorCode{"ERR_PUBSUB_EVENTS_SER", "DAPR_PUBSUB_UNMARSHAL_EVENTS", CategoryPubsub}                   // Error unmarshalling events
+	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                                  // Error publishing message to outbox
+	PubsubOutboxMessage         = ErrorCode{"ERR_OUTBOX_MESSAGE", "DAPR_PUBSUB_OUTBOX_MESSAGE", CategoryPubsub}                        // Invalid outbox message in a state transaction
+	PubSubRedrive               = ErrorCode{"ERR_PUBSUB_REDRIVE", "DAPR_PUBSUB_REDRIVE", CategoryPubsub}                               // Error redriving dead-letter topic
+	PubSubRedriveRequest        = ErrorCode{"ERR_PUBSUB_REDRIVE_REQUEST", "DAPR_PUBSUB_REDRIVE_REQUEST", CategoryPubsub}               // Invalid redrive request
+	PubSubRedriveSubscribed     = ErrorCode{"ERR_PUBSUB_REDRIVE_SUBSCRIBED", "DAPR_PUBSUB_REDRIVE_SUBSCRIBED", CategoryPubsub}         // Redrive of a dead-letter topic the app subscribes to
+	PubSubSchemaValidation      = ErrorCode{"ERR_PUBSUB_SCHEMA_VALIDATION", "DAPR_PUBSUB_SCHEMA_VALIDATION", CategoryPubsub}           // Payload does not match the topic schema
+	PubSubSeek                  = ErrorCode{"ERR_PUBSUB_SEEK", "DAPR_PUBSUB_SEEK", CategoryPubsub}                                     // Error seeking subscription
+	PubSubSeekRequest           = ErrorCode{"ERR_PUBSUB_SEEK_REQUEST", "DAPR_PUBSUB_SEEK_REQUEST", CategoryPubsub}                     // Invalid seek request
+	PubSubSeekNotSupported      = ErrorCode{"ERR_PUBSUB_SEEK_NOT_SUPPORTED", "DAPR_PUBSUB_SEEK_NOT_SUPPORTED", CategoryPubsub}         // Pubsub does not support seeking
+	PubSubSubscriptionNotFound  = ErrorCode{"ERR_PUBSUB_SUBSCRIPTION_NOT_FOUND", "DAPR_PUBSUB_SUBSCRIPTION_NOT_FOUND", CategoryPubsub} // Subscription not found
 
 	// ### Conversation API
 	ConversationInvalidParms  = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}  // Invalid parameters for conversation component
@@ -99,10 +99,14 @@ var (
 	ErrActorStateGet                 = APIError{"error getting actor state: %s", errorcodes.ActorStateGet, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorStateTransactionSave     = APIError{"error saving actor transaction state: %s", errorcodes.ActorStateTransactionSave, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderCreate           = APIError{"error creating actor reminder: %s", errorcodes.ActorReminderCreate, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrActorReminderNameReserved     = APIError{"actor reminder name %s is reserved for internal use", errorcodes.ActorReminderNameReserved, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrActorReminderGet              = APIError{"error getting actor reminder: %s", errorcodes.ActorReminderGet, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorReminderDelete           = APIError{"error deleting actor reminder: %s", errorcodes.ActorReminderDelete, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorTimerCreate              = APIError{"error creating actor timer: %s", errorcodes.ActorTimerCreate, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrActorTimersDelete             = APIError{"error deleting actor timers: %s", errorcodes.ActorTimersDelete, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrActorMigrate                  = APIError{"error migrating actor: %s", errorcodes.ActorMigrate, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrActorMaxStackDepthExceeded    = APIError{"maximum stack depth exceeded", errorcodes.ErrActorMaxStackDepthExceeded, http.StatusInternalServerError, grpcCodes.ResourceExhausted}
+	ErrActorDeadlock                 = APIError{"actor %s is already waiting in the call chain %s and reentrancy is not enabled for its actor type", errorcodes.ErrActorDeadlock, http.StatusConflict, grpcCodes.Aborted}
 	ErrActorNoPlacement              = APIError{"placement service is not configured", errorcodes.ErrActorNoPlacement, http.StatusBadRequest, grpcCodes.Unavailable}
 	ErrActorRuntimeClosed            = APIError{"actor runtime is closed", errorcodes.ErrActorRuntimeClosed, http.StatusServiceUnavailable, grpcCodes.Unavailable}
 	ErrActorNamespaceRequired        = APIError{"actors must have a namespace configured when running in Kubernetes mode", errorcodes.ErrActorNamespaceRequired, http.StatusPreconditionFailed, grpcCodes.FailedPrecondition}
@@ -130,9 +134,17 @@ var (
 	ErrTerminateWorkflow             = APIError{"error terminating workflow '%s': %s", errorcodes.WorkflowTerminate, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrMissingWorkflowEventName      = APIError{"missing workflow event name", errorcodes.WorkflowEventNameMissing, http.StatusBadRequest, grpcCodes.InvalidArgument}
 	ErrRaiseEventWorkflow            = APIError{"error raising event on workflow '%s': %s", errorcodes.WorkflowRaiseEvent, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrSignalWithStartWorkflow       = APIError{"error signaling or starting workflow '%s': %s", errorcodes.WorkflowSignalWithStart, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrListWorkflows                 = APIError{"error listing workflows: %s", errorcodes.WorkflowList, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrInvalidSearchAttribute        = APIError{"%s", errorcodes.WorkflowSearchAttributeInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrWorkflowHistory               = APIError{"error exporting history of workflow '%s': %s", errorcodes.WorkflowHistory, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrReplayWorkflow                = APIError{"error replaying history of workflow '%s': %s", errorcodes.WorkflowReplay, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrUpdateWorkflow                = APIError{"error updating workflow '%s': %s", errorcodes.WorkflowUpdate, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrPauseWorkflow                 = APIError{"error pausing workflow %s: %s", errorcodes.WorkflowPause, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrResumeWorkflow                = APIError{"error resuming workflow %s: %s", errorcodes.WorkflowResume, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrPurgeWorkflow                 = APIError{"error purging workflow %s: %s", errorcodes.WorkflowPurge, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrWorkflowActivityHeartbeat     = APIError{"error recording heartbeat of activity %d of workflow %s: %s", errorcodes.WorkflowActivityHeartbeat, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrInvalidActivityTaskID         = APIError{"workflow activity task ID '%s' is invalid", errorcodes.WorkflowActivityTaskIDInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
 
 	// Conversation
 	ErrConversationNotFound      = APIError{"failed finding conversation component %s", errorcodes.ConversationNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
@@ -23,6 +23,7 @@ import (
 type Fake struct {
 	addOrUpdateOutboxFn         func(stateStore v1alpha1.Component)
 	enabledFn                   func(stateStore string) bool
+	publishPubSubFn             func(stateStore string) (string, bool)
 	publishInternalFn           func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	subscribeToInternalTopicsFn func(ctx context.Context, appID string) error
 }
@@ -31,6 +32,7 @@ func New() *Fake {
 	return &Fake{
 		addOrUpdateOutboxFn: func(stateStore v1alpha1.Component) {},
 		enabledFn:           func(stateStore string) bool { return false },
+		publishPubSubFn:     func(stateStore string) (string, bool) { return "", false },
 		publishInternalFn: func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
 			return nil, nil
 		},
@@ -48,6 +50,11 @@ func (f *Fake) WithEnabled(fn func(stateStore string) bool) *Fake {
 	return f
 }
 
+func (f *Fake) WithPublishPubSub(fn func(stateStore string) (string, bool)) *Fake {
+	f.publishPubSubFn = fn
+	return f
+}
+
 func (f *Fake) WithPublishInternal(fn func(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)) *Fake {
 	f.publishInternalFn = fn
 	return f
@@ -66,6 +73,10 @@ func (f *Fake) Enabled(stateStore string) bool {
 	return f.enabledFn(stateStore)
 }
 
+func (f *Fake) PublishPubSub(stateStore string) (string, bool) {
+	return f.publishPubSubFn(stateStore)
+}
+
 func (f *Fake) PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error) {
 	return f.publishInternalFn(ctx, stateStore, states, source, traceID, traceState)
 }
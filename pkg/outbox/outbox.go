@@ -15,6 +15,8 @@ package outbox
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
@@ -24,6 +26,49 @@ import (
 type Outbox interface {
 	AddOrUpdateOutbox(stateStore v1alpha1.Component)
 	Enabled(stateStore string) bool
+	PublishPubSub(stateStore string) (string, bool)
 	PublishInternal(ctx context.Context, stateStore string, states []state.TransactionalStateOperation, source, traceID, traceState string) ([]state.TransactionalStateOperation, error)
 	SubscribeToInternalTopics(ctx context.Context, appID string) error
 }
+
+// OperationPublish is the type of the transaction operations which publish a
+// message through the outbox, alongside the state operations.
+const OperationPublish state.OperationType = "publish"
+
+// Message is a message published to a topic through the outbox of a state
+// store once the transaction it's part of is committed. Messages are carried
+// in the transaction operations, and replaced by PublishInternal with the
+// state operations which track their delivery.
+type Message struct {
+	Topic       string            `json:"topic"`
+	Data        any               `json:"data"`
+	ContentType string            `json:"contentType,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// GetKey returns the topic of the message, implementing state.StateRequest.
+func (m Message) GetKey() string {
+	return m.Topic
+}
+
+// GetMetadata returns the metadata of the message, implementing state.StateRequest.
+func (m Message) GetMetadata() map[string]string {
+	return m.Metadata
+}
+
+// Operation returns OperationPublish, implementing state.TransactionalStateOperation.
+func (m Message) Operation() state.OperationType {
+	return OperationPublish
+}
+
+// ValidateMessage returns an error if the message can't be published through
+// the outbox of the state store.
+func ValidateMessage(o Outbox, stateStore string, msg Message) error {
+	if msg.Topic == "" {
+		return errors.New("message topic is required")
+	}
+	if o == nil || !o.Enabled(stateStore) {
+		return fmt.Errorf("outbox is not configured on state store %s", stateStore)
+	}
+	return nil
+}
@@ -37,6 +37,7 @@ const (
 	PubSub_BulkPublish_FullMethodName  = "/dapr.proto.components.v1.PubSub/BulkPublish"
 	PubSub_PullMessages_FullMethodName = "/dapr.proto.components.v1.PubSub/PullMessages"
 	PubSub_Ping_FullMethodName         = "/dapr.proto.components.v1.PubSub/Ping"
+	PubSub_Seek_FullMethodName         = "/dapr.proto.components.v1.PubSub/Seek"
 )
 
 // PubSubClient is the client API for PubSub service.
@@ -59,6 +60,10 @@ type PubSubClient interface {
 	PullMessages(ctx context.Context, opts ...grpc.CallOption) (PubSub_PullMessagesClient, error)
 	// Ping the pubsub. Used for liveness porpuses.
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// Seek resets the position of the subscription to a topic, after which the
+	// messages from that position are delivered again. Only called when the
+	// component advertises the SEEK feature.
+	Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*SeekResponse, error)
 }
 
 type pubSubClient struct {
@@ -145,6 +150,15 @@ func (c *pubSubClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *pubSubClient) Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*SeekResponse, error) {
+	out := new(SeekResponse)
+	err := c.cc.Invoke(ctx, PubSub_Seek_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PubSubServer is the server API for PubSub service.
 // All implementations should embed UnimplementedPubSubServer
 // for forward compatibility
@@ -165,6 +179,10 @@ type PubSubServer interface {
 	PullMessages(PubSub_PullMessagesServer) error
 	// Ping the pubsub. Used for liveness porpuses.
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// Seek resets the position of the subscription to a topic, after which the
+	// messages from that position are delivered again. Only called when the
+	// component advertises the SEEK feature.
+	Seek(context.Context, *SeekRequest) (*SeekResponse, error)
 }
 
 // UnimplementedPubSubServer should be embedded to have forward compatible implementations.
@@ -189,6 +207,9 @@ func (UnimplementedPubSubServer) PullMessages(PubSub_PullMessagesServer) error {
 func (UnimplementedPubSubServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedPubSubServer) Seek(context.Context, *SeekRequest) (*SeekResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seek not implemented")
+}
 
 // UnsafePubSubServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PubSubServer will
@@ -317,6 +338,24 @@ func _PubSub_Ping_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PubSub_Seek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PubSubServer).Seek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PubSub_Seek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PubSubServer).Seek(ctx, req.(*SeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PubSub_ServiceDesc is the grpc.ServiceDesc for PubSub service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +383,10 @@ var PubSub_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _PubSub_Ping_Handler,
 		},
+		{
+			MethodName: "Seek",
+			Handler:    _PubSub_Seek_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -33,65 +33,74 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Dapr_InvokeService_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeService"
-	Dapr_GetState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetState"
-	Dapr_GetBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
-	Dapr_SaveState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/SaveState"
-	Dapr_QueryStateAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
-	Dapr_DeleteState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/DeleteState"
-	Dapr_DeleteBulkState_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
-	Dapr_ExecuteStateTransaction_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
-	Dapr_PublishEvent_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
-	Dapr_BulkPublishEventAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
-	Dapr_SubscribeTopicEventsAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
-	Dapr_InvokeBinding_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
-	Dapr_GetSecret_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetSecret"
-	Dapr_GetBulkSecret_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
-	Dapr_RegisterActorTimer_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
-	Dapr_UnregisterActorTimer_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
-	Dapr_RegisterActorReminder_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
-	Dapr_UnregisterActorReminder_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
-	Dapr_GetActorState_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetActorState"
-	Dapr_ExecuteActorStateTransaction_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
-	Dapr_InvokeActor_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
-	Dapr_GetConfigurationAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
-	Dapr_GetConfiguration_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
-	Dapr_SubscribeConfigurationAlpha1_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
-	Dapr_SubscribeConfiguration_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
-	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
-	Dapr_UnsubscribeConfiguration_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
-	Dapr_TryLockAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
-	Dapr_UnlockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
-	Dapr_EncryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
-	Dapr_DecryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
-	Dapr_GetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
-	Dapr_SetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
-	Dapr_SubtleGetKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
-	Dapr_SubtleEncryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
-	Dapr_SubtleDecryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
-	Dapr_SubtleWrapKeyAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
-	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
-	Dapr_SubtleSignAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
-	Dapr_SubtleVerifyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
-	Dapr_StartWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
-	Dapr_GetWorkflowAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
-	Dapr_PurgeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
-	Dapr_TerminateWorkflowAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
-	Dapr_PauseWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
-	Dapr_ResumeWorkflowAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
-	Dapr_RaiseEventWorkflowAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
-	Dapr_StartWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
-	Dapr_GetWorkflowBeta1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
-	Dapr_PurgeWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
-	Dapr_TerminateWorkflowBeta1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
-	Dapr_PauseWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
-	Dapr_ResumeWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
-	Dapr_RaiseEventWorkflowBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
-	Dapr_Shutdown_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/Shutdown"
-	Dapr_ScheduleJobAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
-	Dapr_GetJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
-	Dapr_DeleteJobAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
-	Dapr_ConverseAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
+	Dapr_InvokeService_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/InvokeService"
+	Dapr_GetState_FullMethodName                        = "/dapr.proto.runtime.v1.Dapr/GetState"
+	Dapr_GetBulkState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
+	Dapr_SaveState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/SaveState"
+	Dapr_QueryStateAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
+	Dapr_DeleteState_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/DeleteState"
+	Dapr_DeleteBulkState_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
+	Dapr_ExecuteStateTransaction_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	Dapr_PublishEvent_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
+	Dapr_BulkPublishEventAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
+	Dapr_SubscribeTopicEventsAlpha1_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	Dapr_RedriveDeadLetterTopicAlpha1_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/RedriveDeadLetterTopicAlpha1"
+	Dapr_InvokeBinding_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
+	Dapr_GetSecret_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetSecret"
+	Dapr_GetBulkSecret_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
+	Dapr_RegisterActorTimer_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
+	Dapr_UnregisterActorTimer_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
+	Dapr_RegisterActorReminder_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
+	Dapr_UnregisterActorReminder_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
+	Dapr_GetActorState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetActorState"
+	Dapr_ExecuteActorStateTransaction_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
+	Dapr_InvokeActor_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
+	Dapr_MigrateActorAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/MigrateActorAlpha1"
+	Dapr_UnregisterActorTimersAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimersAlpha1"
+	Dapr_GetConfigurationAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
+	Dapr_GetConfiguration_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
+	Dapr_SubscribeConfigurationAlpha1_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
+	Dapr_SubscribeConfiguration_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
+	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName  = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
+	Dapr_UnsubscribeConfiguration_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
+	Dapr_TryLockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
+	Dapr_UnlockAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	Dapr_EncryptAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
+	Dapr_DecryptAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
+	Dapr_GetMetadata_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
+	Dapr_SetMetadata_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
+	Dapr_SubtleGetKeyAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
+	Dapr_SubtleEncryptAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
+	Dapr_SubtleDecryptAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
+	Dapr_SubtleWrapKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
+	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
+	Dapr_SubtleSignAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
+	Dapr_SubtleVerifyAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
+	Dapr_StartWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
+	Dapr_GetWorkflowAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
+	Dapr_PurgeWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
+	Dapr_TerminateWorkflowAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
+	Dapr_PauseWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
+	Dapr_ResumeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
+	Dapr_RaiseEventWorkflowAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
+	Dapr_StartWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
+	Dapr_GetWorkflowBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
+	Dapr_PurgeWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
+	Dapr_TerminateWorkflowBeta1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
+	Dapr_PauseWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
+	Dapr_ResumeWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
+	Dapr_RaiseEventWorkflowBeta1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	Dapr_WorkflowActivityHeartbeatAlpha1_FullMethodName = "/dapr.proto.runtime.v1.Dapr/WorkflowActivityHeartbeatAlpha1"
+	Dapr_SignalWithStartWorkflowAlpha1_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/SignalWithStartWorkflowAlpha1"
+	Dapr_UpdateWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/UpdateWorkflowAlpha1"
+	Dapr_ListWorkflowsAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/ListWorkflowsAlpha1"
+	Dapr_GetWorkflowHistoryAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/GetWorkflowHistoryAlpha1"
+	Dapr_ReplayWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ReplayWorkflowAlpha1"
+	Dapr_Shutdown_FullMethodName                        = "/dapr.proto.runtime.v1.Dapr/Shutdown"
+	Dapr_ScheduleJobAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
+	Dapr_GetJobAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
+	Dapr_DeleteJobAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
+	Dapr_ConverseAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
 )
 
 // DaprClient is the client API for Dapr service.
@@ -122,6 +131,9 @@ type DaprClient interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_SubscribeTopicEventsAlpha1Client, error)
+	// Moves the messages of a dead-letter topic back to the topics they were
+	// originally published to.
+	RedriveDeadLetterTopicAlpha1(ctx context.Context, in *RedriveDeadLetterTopicRequest, opts ...grpc.CallOption) (*RedriveDeadLetterTopicResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -142,6 +154,13 @@ type DaprClient interface {
 	ExecuteActorStateTransaction(ctx context.Context, in *ExecuteActorStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// InvokeActor calls a method on an actor.
 	InvokeActor(ctx context.Context, in *InvokeActorRequest, opts ...grpc.CallOption) (*InvokeActorResponse, error)
+	// MigrateActorAlpha1 pins an actor to a version of its actor type, and
+	// deactivates it on the version it was pinned to. Other Dapr instances may
+	// keep routing calls to the previous version for up to 5 seconds, until
+	// their cached pin of the actor expires.
+	MigrateActorAlpha1(ctx context.Context, in *MigrateActorRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UnregisterActorTimersAlpha1 unregisters all durable timers of an actor.
+	UnregisterActorTimersAlpha1(ctx context.Context, in *UnregisterActorTimersRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetConfiguration gets configuration from configuration store.
 	GetConfigurationAlpha1(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
 	// GetConfiguration gets configuration from configuration store.
@@ -215,6 +234,22 @@ type DaprClient interface {
 	ResumeWorkflowBeta1(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEventWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Records the liveness of a running workflow activity
+	WorkflowActivityHeartbeatAlpha1(ctx context.Context, in *WorkflowActivityHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Raises an event to a workflow instance, starting it first if it does not
+	// exist or is completed
+	SignalWithStartWorkflowAlpha1(ctx context.Context, in *SignalWithStartWorkflowRequest, opts ...grpc.CallOption) (*StartWorkflowResponse, error)
+	// Delivers an update to a running workflow instance and waits for the
+	// workflow to respond to it
+	UpdateWorkflowAlpha1(ctx context.Context, in *UpdateWorkflowRequest, opts ...grpc.CallOption) (*UpdateWorkflowResponse, error)
+	// Lists the workflow instances matching a runtime status and search
+	// attributes
+	ListWorkflowsAlpha1(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error)
+	// Exports the history of the current execution of a workflow instance
+	GetWorkflowHistoryAlpha1(ctx context.Context, in *GetWorkflowHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryResponse, error)
+	// Replays an exported workflow history against the workflow code of the
+	// connected worker, to check the workflow code for determinism
+	ReplayWorkflowAlpha1(ctx context.Context, in *ReplayWorkflowRequest, opts ...grpc.CallOption) (*ReplayWorkflowResponse, error)
 	// Shutdown the sidecar
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -356,6 +391,15 @@ func (x *daprSubscribeTopicEventsAlpha1Client) Recv() (*SubscribeTopicEventsResp
 	return m, nil
 }
 
+func (c *daprClient) RedriveDeadLetterTopicAlpha1(ctx context.Context, in *RedriveDeadLetterTopicRequest, opts ...grpc.CallOption) (*RedriveDeadLetterTopicResponse, error) {
+	out := new(RedriveDeadLetterTopicResponse)
+	err := c.cc.Invoke(ctx, Dapr_RedriveDeadLetterTopicAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, Dapr_InvokeBinding_FullMethodName, in, out, opts...)
@@ -446,6 +490,24 @@ func (c *daprClient) InvokeActor(ctx context.Context, in *InvokeActorRequest, op
 	return out, nil
 }
 
+func (c *daprClient) MigrateActorAlpha1(ctx context.Context, in *MigrateActorRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_MigrateActorAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) UnregisterActorTimersAlpha1(ctx context.Context, in *UnregisterActorTimersRequestAlpha1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_UnregisterActorTimersAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) GetConfigurationAlpha1(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
 	out := new(GetConfigurationResponse)
 	err := c.cc.Invoke(ctx, Dapr_GetConfigurationAlpha1_FullMethodName, in, out, opts...)
@@ -840,6 +902,60 @@ func (c *daprClient) RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEvent
 	return out, nil
 }
 
+func (c *daprClient) WorkflowActivityHeartbeatAlpha1(ctx context.Context, in *WorkflowActivityHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Dapr_WorkflowActivityHeartbeatAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) SignalWithStartWorkflowAlpha1(ctx context.Context, in *SignalWithStartWorkflowRequest, opts ...grpc.CallOption) (*StartWorkflowResponse, error) {
+	out := new(StartWorkflowResponse)
+	err := c.cc.Invoke(ctx, Dapr_SignalWithStartWorkflowAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) UpdateWorkflowAlpha1(ctx context.Context, in *UpdateWorkflowRequest, opts ...grpc.CallOption) (*UpdateWorkflowResponse, error) {
+	out := new(UpdateWorkflowResponse)
+	err := c.cc.Invoke(ctx, Dapr_UpdateWorkflowAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ListWorkflowsAlpha1(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error) {
+	out := new(ListWorkflowsResponse)
+	err := c.cc.Invoke(ctx, Dapr_ListWorkflowsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetWorkflowHistoryAlpha1(ctx context.Context, in *GetWorkflowHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowHistoryResponse, error) {
+	out := new(GetWorkflowHistoryResponse)
+	err := c.cc.Invoke(ctx, Dapr_GetWorkflowHistoryAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ReplayWorkflowAlpha1(ctx context.Context, in *ReplayWorkflowRequest, opts ...grpc.CallOption) (*ReplayWorkflowResponse, error) {
+	out := new(ReplayWorkflowResponse)
+	err := c.cc.Invoke(ctx, Dapr_ReplayWorkflowAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_Shutdown_FullMethodName, in, out, opts...)
@@ -913,6 +1029,9 @@ type DaprServer interface {
 	// SubscribeTopicEventsAlpha1 subscribes to a PubSub topic and receives topic
 	// events from it.
 	SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error
+	// Moves the messages of a dead-letter topic back to the topics they were
+	// originally published to.
+	RedriveDeadLetterTopicAlpha1(context.Context, *RedriveDeadLetterTopicRequest) (*RedriveDeadLetterTopicResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -933,6 +1052,13 @@ type DaprServer interface {
 	ExecuteActorStateTransaction(context.Context, *ExecuteActorStateTransactionRequest) (*emptypb.Empty, error)
 	// InvokeActor calls a method on an actor.
 	InvokeActor(context.Context, *InvokeActorRequest) (*InvokeActorResponse, error)
+	// MigrateActorAlpha1 pins an actor to a version of its actor type, and
+	// deactivates it on the version it was pinned to. Other Dapr instances may
+	// keep routing calls to the previous version for up to 5 seconds, until
+	// their cached pin of the actor expires.
+	MigrateActorAlpha1(context.Context, *MigrateActorRequestAlpha1) (*emptypb.Empty, error)
+	// UnregisterActorTimersAlpha1 unregisters all durable timers of an actor.
+	UnregisterActorTimersAlpha1(context.Context, *UnregisterActorTimersRequestAlpha1) (*emptypb.Empty, error)
 	// GetConfiguration gets configuration from configuration store.
 	GetConfigurationAlpha1(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
 	// GetConfiguration gets configuration from configuration store.
@@ -1006,6 +1132,22 @@ type DaprServer interface {
 	ResumeWorkflowBeta1(context.Context, *ResumeWorkflowRequest) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error)
+	// Records the liveness of a running workflow activity
+	WorkflowActivityHeartbeatAlpha1(context.Context, *WorkflowActivityHeartbeatRequest) (*emptypb.Empty, error)
+	// Raises an event to a workflow instance, starting it first if it does not
+	// exist or is completed
+	SignalWithStartWorkflowAlpha1(context.Context, *SignalWithStartWorkflowRequest) (*StartWorkflowResponse, error)
+	// Delivers an update to a running workflow instance and waits for the
+	// workflow to respond to it
+	UpdateWorkflowAlpha1(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error)
+	// Lists the workflow instances matching a runtime status and search
+	// attributes
+	ListWorkflowsAlpha1(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error)
+	// Exports the history of the current execution of a workflow instance
+	GetWorkflowHistoryAlpha1(context.Context, *GetWorkflowHistoryRequest) (*GetWorkflowHistoryResponse, error)
+	// Replays an exported workflow history against the workflow code of the
+	// connected worker, to check the workflow code for determinism
+	ReplayWorkflowAlpha1(context.Context, *ReplayWorkflowRequest) (*ReplayWorkflowResponse, error)
 	// Shutdown the sidecar
 	Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -1055,6 +1197,9 @@ func (UnimplementedDaprServer) BulkPublishEventAlpha1(context.Context, *BulkPubl
 func (UnimplementedDaprServer) SubscribeTopicEventsAlpha1(Dapr_SubscribeTopicEventsAlpha1Server) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeTopicEventsAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) RedriveDeadLetterTopicAlpha1(context.Context, *RedriveDeadLetterTopicRequest) (*RedriveDeadLetterTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedriveDeadLetterTopicAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -1085,6 +1230,12 @@ func (UnimplementedDaprServer) ExecuteActorStateTransaction(context.Context, *Ex
 func (UnimplementedDaprServer) InvokeActor(context.Context, *InvokeActorRequest) (*InvokeActorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeActor not implemented")
 }
+func (UnimplementedDaprServer) MigrateActorAlpha1(context.Context, *MigrateActorRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateActorAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) UnregisterActorTimersAlpha1(context.Context, *UnregisterActorTimersRequestAlpha1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterActorTimersAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) GetConfigurationAlpha1(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationAlpha1 not implemented")
 }
@@ -1184,6 +1335,24 @@ func (UnimplementedDaprServer) ResumeWorkflowBeta1(context.Context, *ResumeWorkf
 func (UnimplementedDaprServer) RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaiseEventWorkflowBeta1 not implemented")
 }
+func (UnimplementedDaprServer) WorkflowActivityHeartbeatAlpha1(context.Context, *WorkflowActivityHeartbeatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowActivityHeartbeatAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) SignalWithStartWorkflowAlpha1(context.Context, *SignalWithStartWorkflowRequest) (*StartWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalWithStartWorkflowAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) UpdateWorkflowAlpha1(context.Context, *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ListWorkflowsAlpha1(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) GetWorkflowHistoryAlpha1(context.Context, *GetWorkflowHistoryRequest) (*GetWorkflowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowHistoryAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ReplayWorkflowAlpha1(context.Context, *ReplayWorkflowRequest) (*ReplayWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWorkflowAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
 }
@@ -1417,6 +1586,24 @@ func (x *daprSubscribeTopicEventsAlpha1Server) Recv() (*SubscribeTopicEventsRequ
 	return m, nil
 }
 
+func _Dapr_RedriveDeadLetterTopicAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveDeadLetterTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).RedriveDeadLetterTopicAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_RedriveDeadLetterTopicAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).RedriveDeadLetterTopicAlpha1(ctx, req.(*RedriveDeadLetterTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -1597,6 +1784,42 @@ func _Dapr_InvokeActor_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_MigrateActorAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateActorRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).MigrateActorAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_MigrateActorAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).MigrateActorAlpha1(ctx, req.(*MigrateActorRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_UnregisterActorTimersAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterActorTimersRequestAlpha1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).UnregisterActorTimersAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_UnregisterActorTimersAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).UnregisterActorTimersAlpha1(ctx, req.(*UnregisterActorTimersRequestAlpha1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_GetConfigurationAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -2213,6 +2436,114 @@ func _Dapr_RaiseEventWorkflowBeta1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_WorkflowActivityHeartbeatAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowActivityHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).WorkflowActivityHeartbeatAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_WorkflowActivityHeartbeatAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).WorkflowActivityHeartbeatAlpha1(ctx, req.(*WorkflowActivityHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_SignalWithStartWorkflowAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalWithStartWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).SignalWithStartWorkflowAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_SignalWithStartWorkflowAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).SignalWithStartWorkflowAlpha1(ctx, req.(*SignalWithStartWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_UpdateWorkflowAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).UpdateWorkflowAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_UpdateWorkflowAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).UpdateWorkflowAlpha1(ctx, req.(*UpdateWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ListWorkflowsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListWorkflowsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ListWorkflowsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListWorkflowsAlpha1(ctx, req.(*ListWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetWorkflowHistoryAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetWorkflowHistoryAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetWorkflowHistoryAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetWorkflowHistoryAlpha1(ctx, req.(*GetWorkflowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ReplayWorkflowAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReplayWorkflowAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ReplayWorkflowAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReplayWorkflowAlpha1(ctx, req.(*ReplayWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
@@ -2350,6 +2681,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkPublishEventAlpha1",
 			Handler:    _Dapr_BulkPublishEventAlpha1_Handler,
 		},
+		{
+			MethodName: "RedriveDeadLetterTopicAlpha1",
+			Handler:    _Dapr_RedriveDeadLetterTopicAlpha1_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Dapr_InvokeBinding_Handler,
@@ -2390,6 +2725,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InvokeActor",
 			Handler:    _Dapr_InvokeActor_Handler,
 		},
+		{
+			MethodName: "MigrateActorAlpha1",
+			Handler:    _Dapr_MigrateActorAlpha1_Handler,
+		},
+		{
+			MethodName: "UnregisterActorTimersAlpha1",
+			Handler:    _Dapr_UnregisterActorTimersAlpha1_Handler,
+		},
 		{
 			MethodName: "GetConfigurationAlpha1",
 			Handler:    _Dapr_GetConfigurationAlpha1_Handler,
@@ -2506,6 +2849,30 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RaiseEventWorkflowBeta1",
 			Handler:    _Dapr_RaiseEventWorkflowBeta1_Handler,
 		},
+		{
+			MethodName: "WorkflowActivityHeartbeatAlpha1",
+			Handler:    _Dapr_WorkflowActivityHeartbeatAlpha1_Handler,
+		},
+		{
+			MethodName: "SignalWithStartWorkflowAlpha1",
+			Handler:    _Dapr_SignalWithStartWorkflowAlpha1_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowAlpha1",
+			Handler:    _Dapr_UpdateWorkflowAlpha1_Handler,
+		},
+		{
+			MethodName: "ListWorkflowsAlpha1",
+			Handler:    _Dapr_ListWorkflowsAlpha1_Handler,
+		},
+		{
+			MethodName: "GetWorkflowHistoryAlpha1",
+			Handler:    _Dapr_GetWorkflowHistoryAlpha1_Handler,
+		},
+		{
+			MethodName: "ReplayWorkflowAlpha1",
+			Handler:    _Dapr_ReplayWorkflowAlpha1_Handler,
+		},
 		{
 			MethodName: "Shutdown",
 			Handler:    _Dapr_Shutdown_Handler,
@@ -37,6 +37,12 @@ func (x *BulkPublishRequest) AppendSpanAttributes(rpcMethod string, m map[string
 	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetTopic()
 }
 
+func (x *RedriveDeadLetterTopicRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
+	m[diagConsts.MessagingSystemSpanAttributeKey] = diagConsts.PubsubBuildingBlockType
+	m[diagConsts.MessagingDestinationSpanAttributeKey] = x.GetDeadLetterTopic()
+}
+
 func (x *InvokeBindingRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	m[diagConsts.DBNameSpanAttributeKey] = x.GetName()
 	m[diagConsts.GrpcServiceSpanAttributeKey] = diagConsts.DaprGRPCDaprService
@@ -118,12 +124,18 @@ func (*GetJobRequest) AppendSpanAttributes(rpcMethod string, m map[string]string
 func (*GetMetadataRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*GetWorkflowHistoryRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*GetWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
 func (*InvokeActorRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*ListWorkflowsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*PauseWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -142,6 +154,9 @@ func (*RegisterActorReminderRequest) AppendSpanAttributes(rpcMethod string, m ma
 func (*RegisterActorTimerRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*ReplayWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*ResumeWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -154,6 +169,9 @@ func (*SetMetadataRequest) AppendSpanAttributes(rpcMethod string, m map[string]s
 func (*ShutdownRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*SignalWithStartWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*StartWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -208,3 +226,9 @@ func (*UnregisterActorTimerRequest) AppendSpanAttributes(rpcMethod string, m map
 func (*UnsubscribeConfigurationRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*UpdateWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+func (*WorkflowActivityHeartbeatRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
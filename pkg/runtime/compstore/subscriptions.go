@@ -80,6 +80,15 @@ func (c *ComponentStore) AddStreamSubscription(comp *subapi.Subscription, connec
 		c.lock.Unlock()
 	}()
 
+	var transform *rtpubsub.Transform
+	if t := comp.Spec.Transform; t != nil {
+		var err error
+		transform, err = rtpubsub.CreateTransform(t.Unwrap, t.Fields, t.Headers)
+		if err != nil {
+			return err
+		}
+	}
+
 	sub := &DeclarativeSubscription{
 		Comp: comp,
 		NamedSubscription: &NamedSubscription{
@@ -91,9 +100,17 @@ func (c *ComponentStore) AddStreamSubscription(comp *subapi.Subscription, connec
 				DeadLetterTopic: comp.Spec.DeadLetterTopic,
 				Metadata:        comp.Spec.Metadata,
 				Rules:           []*rtpubsub.Rule{{Path: "/"}},
+				Limits: rtpubsub.Limits{
+					MaxConcurrency:       comp.Spec.MaxConcurrency,
+					MaxMessagesPerSecond: comp.Spec.MaxMessagesPerSecond,
+				},
+				Transform: transform,
 			},
 		},
 	}
+	if o := comp.Spec.Ordering; o != nil {
+		sub.Ordering = &rtpubsub.Ordering{Key: o.Key, MaxQueue: o.MaxQueue}
+	}
 	c.subscriptions.streams[comp.Name] = append(c.subscriptions.streams[comp.Name], sub)
 
 	return nil
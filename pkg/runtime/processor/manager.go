@@ -22,6 +22,7 @@ import (
 	"github.com/dapr/components-contrib/bindings"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	comppubsub "github.com/dapr/dapr/pkg/components/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
@@ -48,6 +49,8 @@ type SubscribeManager interface {
 	StopAppSubscriptions()
 	StopAllSubscriptionsForever()
 	ReloadDeclaredAppSubscription(name, pubsubName string) error
+	SeekDeclaredAppSubscription(ctx context.Context, name string, req comppubsub.SeekRequest) error
+	SeekStreamerSubscription(ctx context.Context, pubsubName string, req *comppubsub.SeekRequest) error
 	StartStreamerSubscription(sub *subapi.Subscription, connectionID rtpubsub.ConnectionID) error
 	StopStreamerSubscription(sub *subapi.Subscription, connectionID rtpubsub.ConnectionID)
 	ReloadPubSub(string) error
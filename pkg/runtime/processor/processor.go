@@ -107,6 +107,11 @@ type Options struct {
 	Adapter         rtpubsub.Adapter
 	AdapterStreamer rtpubsub.AdapterStreamer
 
+	// EnableDelayedDeliveryFn is called when a pubsub component which doesn't
+	// delay messages natively is loaded, so its delayed messages are
+	// scheduled.
+	EnableDelayedDeliveryFn func()
+
 	// Reporter is the reporter for the operator.
 	Reporter registry.Reporter
 }
@@ -220,6 +225,8 @@ func New(opts Options) *Processor {
 				Meta:           opts.Meta,
 				ComponentStore: opts.ComponentStore,
 				Subscriber:     subscriber,
+
+				EnableDelayedDeliveryFn: opts.EnableDelayedDeliveryFn,
 			}),
 			components.CategorySecretStore: secret,
 			components.CategoryStateStore:  state,
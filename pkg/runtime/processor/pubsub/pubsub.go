@@ -27,7 +27,9 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/meta"
 	"github.com/dapr/dapr/pkg/runtime/processor/subscriber"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/scopes"
+	kitstrings "github.com/dapr/kit/strings"
 )
 
 type Options struct {
@@ -36,6 +38,8 @@ type Options struct {
 	Meta           *meta.Meta
 	ComponentStore *compstore.ComponentStore
 	Subscriber     *subscriber.Subscriber
+
+	EnableDelayedDeliveryFn func()
 }
 
 type pubsub struct {
@@ -45,6 +49,8 @@ type pubsub struct {
 	compStore  *compstore.ComponentStore
 	subscriber *subscriber.Subscriber
 
+	enableDelayedDeliveryFn func()
+
 	lock sync.RWMutex
 }
 
@@ -55,6 +61,8 @@ func New(opts Options) *pubsub {
 		meta:       opts.Meta,
 		compStore:  opts.ComponentStore,
 		subscriber: opts.Subscriber,
+
+		enableDelayedDeliveryFn: opts.EnableDelayedDeliveryFn,
 	}
 }
 
@@ -82,6 +90,12 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 	}
 	properties["consumerID"] = consumerID
 
+	schemaConfig, err := schema.ConfigFromMetadata(properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = pubSub.Init(ctx, contribpubsub.Metadata{Base: baseMetadata})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -90,12 +104,14 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 
 	pubsubName := comp.ObjectMeta.Name
 	pubsubItem := &rtpubsub.PubsubItem{
-		Component:           pubSub,
-		ScopedSubscriptions: scopes.GetScopedTopics(scopes.SubscriptionScopes, p.appID, properties),
-		ScopedPublishings:   scopes.GetScopedTopics(scopes.PublishingScopes, p.appID, properties),
-		AllowedTopics:       scopes.GetAllowedTopics(properties),
-		ProtectedTopics:     scopes.GetProtectedTopics(properties),
-		NamespaceScoped:     meta.ContainsNamespace(comp.Spec.Metadata),
+		Component:             pubSub,
+		ScopedSubscriptions:   scopes.GetScopedTopics(scopes.SubscriptionScopes, p.appID, properties),
+		ScopedPublishings:     scopes.GetScopedTopics(scopes.PublishingScopes, p.appID, properties),
+		AllowedTopics:         scopes.GetAllowedTopics(properties),
+		ProtectedTopics:       scopes.GetProtectedTopics(properties),
+		NamespaceScoped:       meta.ContainsNamespace(comp.Spec.Metadata),
+		NativeDelayedDelivery: kitstrings.IsTruthy(properties[rtpubsub.MetadataNativeDelayedDelivery]),
+		Schema:                schemaConfig,
 	}
 
 	p.compStore.AddPubSub(pubsubName, pubsubItem)
@@ -105,6 +121,10 @@ func (p *pubsub) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
 	}
 
+	if !pubsubItem.NativeDelayedDelivery && p.enableDelayedDeliveryFn != nil {
+		p.enableDelayedDeliveryFn()
+	}
+
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
 	return nil
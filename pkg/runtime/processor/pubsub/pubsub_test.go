@@ -16,6 +16,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -462,6 +463,58 @@ func TestConsumerID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEnableDelayedDelivery(t *testing.T) {
+	for name, native := range map[string]bool{
+		"scheduled delayed delivery": false,
+		"native delayed delivery":    true,
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockPubSub := new(daprt.MockPubSub)
+			mockPubSub.On("Init", mock.Anything).Return(nil)
+
+			compStore := compstore.New()
+			registry := registry.New(registry.NewOptions())
+			registry.PubSubs().RegisterComponent(func(_ logger.Logger) contribpubsub.PubSub {
+				return mockPubSub
+			}, "mockPubSub")
+
+			var enabled bool
+			ps := New(Options{
+				Subscriber: subscriber.New(subscriber.Options{
+					Channels:   new(channels.Channels),
+					Resiliency: resiliency.New(logger.NewLogger("test")),
+					CompStore:  compStore,
+					IsHTTP:     true,
+				}),
+				Registry:       registry.PubSubs(),
+				Meta:           meta.New(meta.Options{}),
+				AppID:          TestRuntimeConfigID,
+				ComponentStore: compStore,
+				EnableDelayedDeliveryFn: func() {
+					enabled = true
+				},
+			})
+
+			require.NoError(t, ps.Init(t.Context(), componentsV1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: TestPubsubName,
+				},
+				Spec: componentsV1alpha1.ComponentSpec{
+					Type:    "pubsub.mockPubSub",
+					Version: "v1",
+					Metadata: []commonapi.NameValuePair{{
+						Name: runtimePubsub.MetadataNativeDelayedDelivery,
+						Value: commonapi.DynamicValue{
+							JSON: v1.JSON{Raw: []byte(strconv.FormatBool(native))},
+						},
+					}},
+				},
+			}))
+			assert.Equal(t, !native, enabled)
+		})
+	}
+}
+
 // helper to populate subscription array for 2 pubsubs.
 // 'topics' are the topics for the first pubsub.
 // 'topics2' are the topics for the second pubsub.
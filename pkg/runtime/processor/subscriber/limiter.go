@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/time/rate"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+)
+
+// Subscription metadata keys which set the delivery limits of a subscription.
+// They are used by subscriptions which can't set the limits on the
+// subscription itself, such as programmatic gRPC and streaming subscriptions.
+const (
+	metadataKeyMaxConcurrency       = "maxConcurrency"
+	metadataKeyMaxMessagesPerSecond = "maxMessagesPerSecond"
+)
+
+// limitedPostman delivers messages with the wrapped postman, blocking while the
+// subscription is at its concurrency or rate limit. Messages are not buffered:
+// the component handler doesn't return until the message has been delivered,
+// which stops the component from pulling more messages from the broker.
+type limitedPostman struct {
+	postman.Interface
+
+	sem  chan struct{}
+	rate *rate.Limiter
+}
+
+// subscriptionLimits returns the delivery limits of the subscription, falling
+// back to the subscription metadata when no limit is set on the subscription.
+func subscriptionLimits(sub rtpubsub.Subscription) (rtpubsub.Limits, error) {
+	limits := sub.Limits
+
+	if limits.MaxConcurrency == 0 {
+		n, err := limitFromMetadata(sub.Metadata, metadataKeyMaxConcurrency)
+		if err != nil {
+			return limits, err
+		}
+		limits.MaxConcurrency = n
+	}
+	if limits.MaxMessagesPerSecond == 0 {
+		n, err := limitFromMetadata(sub.Metadata, metadataKeyMaxMessagesPerSecond)
+		if err != nil {
+			return limits, err
+		}
+		limits.MaxMessagesPerSecond = n
+	}
+
+	if limits.MaxConcurrency < 0 {
+		return limits, fmt.Errorf("invalid %s %d: must not be negative", metadataKeyMaxConcurrency, limits.MaxConcurrency)
+	}
+	if limits.MaxMessagesPerSecond < 0 {
+		return limits, fmt.Errorf("invalid %s %d: must not be negative", metadataKeyMaxMessagesPerSecond, limits.MaxMessagesPerSecond)
+	}
+
+	return limits, nil
+}
+
+func limitFromMetadata(md map[string]string, key string) (int32, error) {
+	val, ok := md[key]
+	if !ok || val == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(val, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, val, err)
+	}
+	return int32(n), nil
+}
+
+// newLimitedPostman wraps the postman with the given limits. The postman is
+// returned as is if the subscription has no limits.
+func newLimitedPostman(p postman.Interface, limits rtpubsub.Limits) postman.Interface {
+	if limits.MaxConcurrency <= 0 && limits.MaxMessagesPerSecond <= 0 {
+		return p
+	}
+
+	l := &limitedPostman{Interface: p}
+	if limits.MaxConcurrency > 0 {
+		l.sem = make(chan struct{}, limits.MaxConcurrency)
+	}
+	if limits.MaxMessagesPerSecond > 0 {
+		l.rate = rate.NewLimiter(rate.Limit(limits.MaxMessagesPerSecond), int(limits.MaxMessagesPerSecond))
+	}
+
+	return l
+}
+
+func (l *limitedPostman) Deliver(ctx context.Context, msg *rtpubsub.SubscribedMessage) error {
+	release, err := l.acquire(ctx, 1)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.Interface.Deliver(ctx, msg)
+}
+
+// DeliverBulk counts every message of the bulk request against the rate limit,
+// and the bulk request as a single delivery against the concurrency limit.
+func (l *limitedPostman) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest) error {
+	n := 1
+	if req.BulkSubMsg != nil && len(req.BulkSubMsg.PubSubMessages) > 0 {
+		n = len(req.BulkSubMsg.PubSubMessages)
+	}
+
+	release, err := l.acquire(ctx, n)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.Interface.DeliverBulk(ctx, req)
+}
+
+// acquire blocks until a delivery slot is free and n messages are allowed by
+// the rate limit. The returned function frees the delivery slot.
+func (l *limitedPostman) acquire(ctx context.Context, n int) (func(), error) {
+	release := func() {}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = func() { <-l.sem }
+	}
+
+	if l.rate != nil {
+		// WaitN fails if n exceeds the burst, so wait for large bulk requests
+		// in chunks.
+		for n > 0 {
+			chunk := min(n, l.rate.Burst())
+			if err := l.rate.WaitN(ctx, chunk); err != nil {
+				release()
+				return nil, err
+			}
+			n -= chunk
+		}
+	}
+
+	return release, nil
+}
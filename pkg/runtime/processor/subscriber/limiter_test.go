@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscriber
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+	postmanfake "github.com/dapr/dapr/pkg/runtime/subscription/postman/fake"
+	"github.com/dapr/dapr/pkg/runtime/subscription/todo"
+)
+
+func TestSubscriptionLimits(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		limits, err := subscriptionLimits(rtpubsub.Subscription{})
+		require.NoError(t, err)
+		assert.Equal(t, rtpubsub.Limits{}, limits)
+	})
+
+	t.Run("subscription limits take precedence over metadata", func(t *testing.T) {
+		limits, err := subscriptionLimits(rtpubsub.Subscription{
+			Limits: rtpubsub.Limits{MaxConcurrency: 2},
+			Metadata: map[string]string{
+				"maxConcurrency":       "5",
+				"maxMessagesPerSecond": "10",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rtpubsub.Limits{MaxConcurrency: 2, MaxMessagesPerSecond: 10}, limits)
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		_, err := subscriptionLimits(rtpubsub.Subscription{
+			Metadata: map[string]string{"maxConcurrency": "many"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		_, err := subscriptionLimits(rtpubsub.Subscription{
+			Limits: rtpubsub.Limits{MaxMessagesPerSecond: -1},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestLimitedPostman(t *testing.T) {
+	t.Run("no limits returns the postman", func(t *testing.T) {
+		fake := postmanfake.New()
+		assert.Same(t, fake, newLimitedPostman(fake, rtpubsub.Limits{}))
+	})
+
+	t.Run("concurrency is limited", func(t *testing.T) {
+		var inflight, maxInflight atomic.Int32
+		fake := postmanfake.New().WithDeliverFn(func(context.Context, *rtpubsub.SubscribedMessage) error {
+			n := inflight.Add(1)
+			for {
+				m := maxInflight.Load()
+				if n <= m || maxInflight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond * 20)
+			inflight.Add(-1)
+			return nil
+		})
+		p := newLimitedPostman(fake, rtpubsub.Limits{MaxConcurrency: 2})
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, p.Deliver(t.Context(), new(rtpubsub.SubscribedMessage)))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), maxInflight.Load())
+	})
+
+	t.Run("blocked delivery is cancelled with the context", func(t *testing.T) {
+		delivering := make(chan struct{})
+		release := make(chan struct{})
+		fake := postmanfake.New().WithDeliverFn(func(context.Context, *rtpubsub.SubscribedMessage) error {
+			close(delivering)
+			<-release
+			return nil
+		})
+		p := newLimitedPostman(fake, rtpubsub.Limits{MaxConcurrency: 1})
+
+		errCh := make(chan error)
+		go func() {
+			errCh <- p.Deliver(t.Context(), new(rtpubsub.SubscribedMessage))
+		}()
+		<-delivering
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*50)
+		defer cancel()
+		require.ErrorIs(t, p.Deliver(ctx, new(rtpubsub.SubscribedMessage)), context.DeadlineExceeded)
+
+		close(release)
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("rate is limited", func(t *testing.T) {
+		var delivered atomic.Int32
+		fake := postmanfake.New().WithDeliverFn(func(context.Context, *rtpubsub.SubscribedMessage) error {
+			delivered.Add(1)
+			return nil
+		})
+		p := newLimitedPostman(fake, rtpubsub.Limits{MaxMessagesPerSecond: 20})
+
+		start := time.Now()
+		for range 30 {
+			require.NoError(t, p.Deliver(t.Context(), new(rtpubsub.SubscribedMessage)))
+		}
+
+		// The first 20 messages are delivered as a burst, the other 10 take
+		// half a second.
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*400)
+		assert.Equal(t, int32(30), delivered.Load())
+	})
+
+	t.Run("bulk requests larger than the rate are delivered", func(t *testing.T) {
+		var delivered atomic.Int32
+		fake := postmanfake.New().WithDeliverBulkFn(func(context.Context, *postman.DeliverBulkRequest) error {
+			delivered.Add(1)
+			return nil
+		})
+		p := newLimitedPostman(fake, rtpubsub.Limits{MaxMessagesPerSecond: 20})
+
+		start := time.Now()
+		require.NoError(t, p.DeliverBulk(t.Context(), &postman.DeliverBulkRequest{
+			BulkSubMsg: &todo.BulkSubscribedMessage{
+				PubSubMessages: make([]todo.Message, 30),
+			},
+		}))
+
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*400)
+		assert.Equal(t, int32(1), delivered.Load())
+	})
+}
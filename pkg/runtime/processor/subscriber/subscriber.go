@@ -17,14 +17,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc"
 
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	comppubsub "github.com/dapr/dapr/pkg/components/pubsub"
 	"github.com/dapr/dapr/pkg/config"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
@@ -70,6 +73,11 @@ type Subscriber struct {
 	hasInitProg  bool
 	lock         sync.RWMutex
 	closed       atomic.Bool
+
+	// streamSeeks holds the position each streaming subscription last seeked
+	// to.
+	streamSeeks map[string]string
+	seekLock    sync.Mutex
 }
 
 type namedSubscription struct {
@@ -93,6 +101,7 @@ func New(opts Options) *Subscriber {
 		adapterStreamer: opts.AdapterStreamer,
 		appSubs:         make(map[string][]*namedSubscription),
 		streamSubs:      make(map[string]map[rtpubsub.ConnectionID]*namedSubscription),
+		streamSeeks:     make(map[string]string),
 	}
 }
 
@@ -227,6 +236,114 @@ func (s *Subscriber) ReloadDeclaredAppSubscription(name, pubsubName string) erro
 		return nil
 	}
 
+	s.stopDeclaredAppSubscription(name, pubsubName)
+	return s.startDeclaredAppSubscription(name, pubsubName)
+}
+
+// SeekDeclaredAppSubscription resets the position of the declarative
+// subscription with the given name. The subscription is stopped while the
+// component seeks, and restarted afterwards so that messages are replayed from
+// the new position.
+func (s *Subscriber) SeekDeclaredAppSubscription(ctx context.Context, name string, req comppubsub.SeekRequest) error {
+	sub, ps, err := s.stopForSeek(name, &req)
+	if err != nil {
+		return err
+	}
+
+	// The lock isn't held while the component seeks, as it may take a while.
+	err = rtpubsub.Seek(ctx, sub.PubsubName, ps.Component, &req)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// The subscription may have been restarted by a reload in the meantime.
+	if !s.appSubActive || s.closed.Load() || s.hasDeclaredAppSubscription(name, sub.PubsubName) {
+		return err
+	}
+
+	return errors.Join(err, s.startDeclaredAppSubscription(name, sub.PubsubName))
+}
+
+// stopForSeek stops the declarative subscription with the given name, and
+// completes the seek request with its topic and metadata.
+func (s *Subscriber) stopForSeek(name string, req *comppubsub.SeekRequest) (*compstore.DeclarativeSubscription, *rtpubsub.PubsubItem, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed.Load() {
+		return nil, nil, errors.New("subscriber is closed")
+	}
+
+	sub, ok := s.compStore.GetDeclarativeSubscription(name)
+	if !ok {
+		return nil, nil, rtpubsub.SubscriptionNotFoundError{Name: name}
+	}
+
+	ps, ok := s.compStore.GetPubSub(sub.PubsubName)
+	if !ok {
+		return nil, nil, rtpubsub.NotFoundError{PubsubName: sub.PubsubName}
+	}
+
+	if !rtpubsub.CanSeek(ps.Component) {
+		return nil, nil, rtpubsub.SeekNotSupportedError{PubsubName: sub.PubsubName}
+	}
+
+	req.Topic = sub.Topic
+	md := maps.Clone(sub.Metadata)
+	if md == nil {
+		md = make(map[string]string, len(req.Metadata))
+	}
+	maps.Copy(md, req.Metadata)
+	req.Metadata = md
+
+	s.stopDeclaredAppSubscription(name, sub.PubsubName)
+
+	return sub, ps, nil
+}
+
+// SeekStreamerSubscription resets the position of a streaming subscription
+// before it starts. A stream which reconnects with the position it already
+// seeked to doesn't replay the messages again.
+func (s *Subscriber) SeekStreamerSubscription(ctx context.Context, pubsubName string, req *comppubsub.SeekRequest) error {
+	ps, ok := s.compStore.GetPubSub(pubsubName)
+	if !ok {
+		return apierrors.PubSub(pubsubName).WithMetadata(nil).NotFound()
+	}
+
+	key := s.adapterStreamer.StreamerKey(pubsubName, req.Topic)
+	position := req.Offset
+	if req.Timestamp != nil {
+		position = req.Timestamp.Format(time.RFC3339Nano)
+	}
+
+	s.seekLock.Lock()
+	defer s.seekLock.Unlock()
+
+	if s.streamSeeks[key] == position {
+		return nil
+	}
+
+	if err := rtpubsub.Seek(ctx, pubsubName, ps.Component, req); err != nil {
+		if errors.As(err, &rtpubsub.SeekNotSupportedError{}) {
+			return apierrors.PubSub(pubsubName).SeekNotSupported(key, err)
+		}
+		return apierrors.PubSub(pubsubName).Seek(key, err)
+	}
+
+	s.streamSeeks[key] = position
+	return nil
+}
+
+func (s *Subscriber) hasDeclaredAppSubscription(name, pubsubName string) bool {
+	for _, appsub := range s.appSubs[pubsubName] {
+		if appsub.name != nil && name == *appsub.name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Subscriber) stopDeclaredAppSubscription(name, pubsubName string) {
 	for i, appsub := range s.appSubs[pubsubName] {
 		if appsub.name != nil && name == *appsub.name {
 			appsub.Stop()
@@ -234,7 +351,9 @@ func (s *Subscriber) ReloadDeclaredAppSubscription(name, pubsubName string) erro
 			break
 		}
 	}
+}
 
+func (s *Subscriber) startDeclaredAppSubscription(name, pubsubName string) error {
 	ps, ok := s.compStore.GetPubSub(pubsubName)
 	if !ok {
 		return nil
@@ -520,6 +639,13 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 			})
 		}
 	}
+
+	limits, err := subscriptionLimits(comp.Subscription)
+	if err != nil {
+		return nil, fmt.Errorf("invalid limits for subscription to topic %s: %w", comp.Topic, err)
+	}
+	postman = newLimitedPostman(postman, limits)
+
 	return subscription.New(subscription.Options{
 		AppID:           s.appID,
 		Namespace:       s.namespace,
@@ -534,5 +660,6 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 		AdapterStreamer: streamer,
 		ConnectionID:    comp.ConnectionID,
 		Postman:         postman,
+		CompStore:       s.compStore,
 	})
 }
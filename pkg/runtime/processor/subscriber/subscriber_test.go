@@ -14,6 +14,7 @@ limitations under the License.
 package subscriber
 
 import (
+	"context"
 	"encoding/json"
 	"slices"
 	"sync/atomic"
@@ -28,11 +29,13 @@ import (
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	comppubsub "github.com/dapr/dapr/pkg/components/pubsub"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/streamer"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
@@ -449,3 +452,108 @@ func TestReloadPubSub(t *testing.T) {
 	mockPubSub2.AssertNumberOfCalls(t, "unsubscribed", 3)
 	mockPubSub3.AssertNumberOfCalls(t, "unsubscribed", 3)
 }
+
+type seekablePubSub struct {
+	*daprt.InMemoryPubsub
+
+	seek func(*comppubsub.SeekRequest) error
+}
+
+func (s *seekablePubSub) Features() []contribpubsub.Feature {
+	return []contribpubsub.Feature{comppubsub.FeatureSeek}
+}
+
+func (s *seekablePubSub) Seek(_ context.Context, req *comppubsub.SeekRequest) error {
+	return s.seek(req)
+}
+
+func TestSeekDeclaredAppSubscription(t *testing.T) {
+	mockPubSub1 := new(daprt.InMemoryPubsub)
+	mockPubSub2 := new(daprt.InMemoryPubsub)
+	for _, ps := range []*daprt.InMemoryPubsub{mockPubSub1, mockPubSub2} {
+		ps.On("Init", mock.Anything).Return(nil)
+		ps.On("Subscribe", mock.AnythingOfType("pubsub.SubscribeRequest"), mock.AnythingOfType("pubsub.Handler")).Return(nil)
+		ps.On("unsubscribed", "topic1").Return(nil)
+		require.NoError(t, ps.Init(t.Context(), contribpubsub.Metadata{}))
+	}
+
+	var seekReqs []*comppubsub.SeekRequest
+	var runningOnSeek int
+	var lockedOnSeek bool
+	var subs *Subscriber
+	seekable := &seekablePubSub{InMemoryPubsub: mockPubSub1}
+	seekable.seek = func(req *comppubsub.SeekRequest) error {
+		seekReqs = append(seekReqs, req)
+		runningOnSeek = len(subs.appSubs["seekable"])
+		lockedOnSeek = !subs.lock.TryLock()
+		if !lockedOnSeek {
+			subs.lock.Unlock()
+		}
+		return nil
+	}
+
+	compStore := compstore.New()
+	compStore.AddPubSub("seekable", &rtpubsub.PubsubItem{Component: seekable})
+	compStore.AddPubSub("notseekable", &rtpubsub.PubsubItem{Component: mockPubSub2})
+	for _, name := range []string{"seekable", "notseekable"} {
+		compStore.AddDeclarativeSubscription(&subapi.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "sub-" + name},
+		}, rtpubsub.Subscription{
+			PubsubName: name,
+			Topic:      "topic1",
+			Metadata:   map[string]string{"consumerID": "app"},
+			Rules:      []*rtpubsub.Rule{{Path: "/"}},
+		})
+	}
+
+	subs = New(Options{
+		CompStore:       compStore,
+		IsHTTP:          true,
+		Resiliency:      resiliency.New(logger.NewLogger("test")),
+		Namespace:       "ns1",
+		AppID:           TestRuntimeConfigID,
+		Channels:        new(channels.Channels).WithAppChannel(new(channelt.MockAppChannel)),
+		AdapterStreamer: streamer.New(t.Context(), streamer.Options{}),
+	})
+	subs.hasInitProg = true
+	require.NoError(t, subs.StartAppSubscriptions())
+	t.Cleanup(subs.StopAllSubscriptionsForever)
+
+	offset := comppubsub.SeekRequest{Offset: "42", Metadata: map[string]string{"partition": "1"}}
+
+	t.Run("subscription not found", func(t *testing.T) {
+		err := subs.SeekDeclaredAppSubscription(t.Context(), "unknown", offset)
+		require.ErrorAs(t, err, &rtpubsub.SubscriptionNotFoundError{})
+	})
+
+	t.Run("pubsub does not support seeking", func(t *testing.T) {
+		err := subs.SeekDeclaredAppSubscription(t.Context(), "sub-notseekable", offset)
+		require.ErrorAs(t, err, &rtpubsub.SeekNotSupportedError{})
+		assert.Len(t, subs.appSubs["notseekable"], 1)
+	})
+
+	t.Run("subscription is restarted after seeking", func(t *testing.T) {
+		require.NoError(t, subs.SeekDeclaredAppSubscription(t.Context(), "sub-seekable", offset))
+
+		require.Len(t, seekReqs, 1)
+		assert.Equal(t, "topic1", seekReqs[0].Topic)
+		assert.Equal(t, "42", seekReqs[0].Offset)
+		assert.Equal(t, map[string]string{"consumerID": "app", "partition": "1"}, seekReqs[0].Metadata)
+		assert.Equal(t, 0, runningOnSeek)
+		assert.False(t, lockedOnSeek)
+		assert.Len(t, subs.appSubs["seekable"], 1)
+	})
+
+	t.Run("streaming subscription seeks once per position", func(t *testing.T) {
+		seekReqs = nil
+		err := subs.SeekStreamerSubscription(t.Context(), "notseekable", &comppubsub.SeekRequest{Topic: "topic2", Offset: "42"})
+		require.Error(t, err)
+
+		for _, offset := range []string{"42", "42", "43"} {
+			require.NoError(t, subs.SeekStreamerSubscription(t.Context(), "seekable", &comppubsub.SeekRequest{Topic: "topic2", Offset: offset}))
+		}
+		require.Len(t, seekReqs, 2)
+		assert.Equal(t, "42", seekReqs[0].Offset)
+		assert.Equal(t, "43", seekReqs[1].Offset)
+	})
+}
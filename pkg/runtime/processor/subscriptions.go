@@ -46,6 +46,10 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 				MaxMessagesCount:   comp.Spec.BulkSubscribe.MaxMessagesCount,
 				MaxAwaitDurationMs: comp.Spec.BulkSubscribe.MaxAwaitDurationMs,
 			},
+			Limits: rtpubsub.Limits{
+				MaxConcurrency:       comp.Spec.MaxConcurrency,
+				MaxMessagesPerSecond: comp.Spec.MaxMessagesPerSecond,
+			},
 		}
 		for _, rule := range comp.Spec.Routes.Rules {
 			erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
@@ -60,6 +64,29 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 				Path: comp.Spec.Routes.Default,
 			})
 		}
+		for _, tier := range comp.Spec.RetryTopics {
+			retryTopic, err := rtpubsub.CreateRetryTopic(comp.Spec.Topic, tier.Delay, tier.Topic)
+			if err != nil {
+				p.errorSubscriptions(ctx, err)
+				return false
+			}
+			sub.RetryTopics = append(sub.RetryTopics, retryTopic)
+		}
+		if err := rtpubsub.ValidateRetryTopics(comp.Spec.Topic, sub.RetryTopics); err != nil {
+			p.errorSubscriptions(ctx, err)
+			return false
+		}
+		if t := comp.Spec.Transform; t != nil {
+			transform, err := rtpubsub.CreateTransform(t.Unwrap, t.Fields, t.Headers)
+			if err != nil {
+				p.errorSubscriptions(ctx, err)
+				return false
+			}
+			sub.Transform = transform
+		}
+		if o := comp.Spec.Ordering; o != nil {
+			sub.Ordering = &rtpubsub.Ordering{Key: o.Key, MaxQueue: o.MaxQueue}
+		}
 
 		p.compStore.AddDeclarativeSubscription(&comp, sub)
 		if err := p.subscriber.ReloadDeclaredAppSubscription(comp.Name, comp.Spec.Pubsubname); err != nil {
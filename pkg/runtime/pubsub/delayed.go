@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+)
+
+const (
+	// MetadataDeliverAt is the publish metadata key of the time, in RFC3339
+	// format, at which a message is delivered to the broker.
+	MetadataDeliverAt = "deliverAt"
+	// MetadataDeliverAfter is the publish metadata key of the duration, e.g.
+	// "10m", after which a message is delivered to the broker.
+	MetadataDeliverAfter = "deliverAfter"
+	// MetadataNativeDelayedDelivery is the component metadata key which
+	// declares that the broker delays messages natively, in which case the
+	// delivery metadata is passed through to the component.
+	MetadataNativeDelayedDelivery = "nativeDelayedDelivery"
+)
+
+// DeliveryTime returns the time at which a message published with the given
+// metadata is to be delivered to the broker. The returned bool is false if
+// the message isn't delayed.
+func DeliveryTime(metadata map[string]string, now time.Time) (time.Time, bool, error) {
+	at, hasAt := metadata[MetadataDeliverAt]
+	after, hasAfter := metadata[MetadataDeliverAfter]
+
+	switch {
+	case hasAt && hasAfter:
+		return time.Time{}, false, fmt.Errorf("only one of '%s' and '%s' can be set", MetadataDeliverAt, MetadataDeliverAfter)
+	case hasAt:
+		t, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid '%s': %w", MetadataDeliverAt, err)
+		}
+		return t, true, nil
+	case hasAfter:
+		d, err := time.ParseDuration(after)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid '%s': %w", MetadataDeliverAfter, err)
+		}
+		if d < 0 {
+			return time.Time{}, false, errors.New("'" + MetadataDeliverAfter + "' must not be negative")
+		}
+		return now.Add(d), true, nil
+	default:
+		return time.Time{}, false, nil
+	}
+}
+
+// WithoutDeliveryTime returns a copy of the metadata without the delivery
+// metadata.
+func WithoutDeliveryTime(metadata map[string]string) map[string]string {
+	md := maps.Clone(metadata)
+	delete(md, MetadataDeliverAt)
+	delete(md, MetadataDeliverAfter)
+	return md
+}
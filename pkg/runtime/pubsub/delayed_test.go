@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("not delayed", func(t *testing.T) {
+		_, ok, err := DeliveryTime(map[string]string{"key": "v"}, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("deliverAt", func(t *testing.T) {
+		at, ok, err := DeliveryTime(map[string]string{MetadataDeliverAt: "2025-01-02T00:00:00Z"}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), at.UTC())
+	})
+
+	t.Run("deliverAfter", func(t *testing.T) {
+		at, ok, err := DeliveryTime(map[string]string{MetadataDeliverAfter: "10m"}, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, now.Add(10*time.Minute), at)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, md := range []map[string]string{
+			{MetadataDeliverAt: "tomorrow"},
+			{MetadataDeliverAfter: "soon"},
+			{MetadataDeliverAfter: "-1m"},
+			{MetadataDeliverAt: "2025-01-02T00:00:00Z", MetadataDeliverAfter: "10m"},
+		} {
+			_, _, err := DeliveryTime(md, now)
+			require.Error(t, err, md)
+		}
+	})
+
+	t.Run("without delivery time", func(t *testing.T) {
+		md := map[string]string{MetadataDeliverAt: "x", MetadataDeliverAfter: "y", "key": "v"}
+		assert.Equal(t, map[string]string{"key": "v"}, WithoutDeliveryTime(md))
+		assert.Len(t, md, 3)
+	})
+}
@@ -40,3 +40,25 @@ type NotAllowedError struct {
 func (e NotAllowedError) Error() string {
 	return fmt.Sprintf(messages.ErrPubsubForbidden, e.Topic, e.ID)
 }
+
+// pubsub.SeekNotSupportedError is returned by the runtime when seeking a
+// subscription of a pubsub which doesn't support it. Only pluggable
+// components can seek, as built-in components don't implement the Seeker
+// contract.
+type SeekNotSupportedError struct {
+	PubsubName string
+}
+
+func (e SeekNotSupportedError) Error() string {
+	return fmt.Sprintf("pubsub '%s' does not support seeking subscriptions: only pluggable components which advertise the SEEK feature can seek", e.PubsubName)
+}
+
+// pubsub.SubscriptionNotFoundError is returned by the runtime when the
+// subscription does not exist.
+type SubscriptionNotFoundError struct {
+	Name string
+}
+
+func (e SubscriptionNotFoundError) Error() string {
+	return fmt.Sprintf("subscription '%s' not found", e.Name)
+}
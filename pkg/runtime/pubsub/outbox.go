@@ -16,6 +16,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -40,6 +41,10 @@ const (
 	outboxDiscardWhenMissingStateKey = "outboxDiscardWhenMissingState"
 	outboxStatePrefix                = "outbox"
 	defaultStateScanDelay            = time.Second * 1
+
+	// outboxTopicExtension is the cloud event extension of internal outbox
+	// messages which are published to a topic other than the configured one.
+	outboxTopicExtension = "outboxtopic"
 )
 
 var outboxLogger = logger.NewLogger("dapr.outbox")
@@ -125,6 +130,33 @@ func (o *outboxImpl) Enabled(stateStore string) bool {
 	return ok
 }
 
+// PublishPubSub returns the pubsub the outbox of the state store publishes to.
+func (o *outboxImpl) PublishPubSub(stateStore string) (string, bool) {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+
+	c, ok := o.outboxStores[stateStore]
+	return c.publishPubSub, ok
+}
+
+// IsOutboxMessageAllowed returns a NotAllowedError if the app isn't allowed to
+// publish to the topic on the pubsub the outbox of the state store publishes
+// to, or a NotFoundError if that pubsub isn't loaded.
+func IsOutboxMessageAllowed(o outbox.Outbox, getPubSub func(string) (*PubsubItem, bool), stateStore, topic, appID string) error {
+	pubsubName, ok := o.PublishPubSub(stateStore)
+	if !ok {
+		return fmt.Errorf("outbox is not configured on state store %s", stateStore)
+	}
+	ps, ok := getPubSub(pubsubName)
+	if !ok {
+		return NotFoundError{PubsubName: pubsubName}
+	}
+	if !IsOperationAllowed(topic, ps, ps.ScopedPublishings) {
+		return NotAllowedError{Topic: topic, ID: appID}
+	}
+	return nil
+}
+
 func transaction() (state.TransactionalStateOperation, error) {
 	uid, err := uuid.NewRandom()
 	if err != nil {
@@ -162,14 +194,23 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 		}
 	}
 
+	var messages []outbox.Message
+	var stateOps []state.TransactionalStateOperation
 	for _, op := range operations {
-		sr, ok := op.(state.SetRequest)
-		if ok {
-			tr, err := transaction()
-			if err != nil {
-				return nil, err
+		if msg, ok := op.(outbox.Message); ok {
+			if msg.Topic == "" {
+				return nil, errors.New("error publishing internal outbox message: message topic is required")
 			}
+			messages = append(messages, msg)
+			continue
+		}
+		stateOps = append(stateOps, op)
+	}
+	operations = stateOps
 
+	for _, op := range stateOps {
+		sr, ok := op.(state.SetRequest)
+		if ok {
 			var payload any
 			var contentType string
 
@@ -191,56 +232,123 @@ func (o *outboxImpl) PublishInternal(ctx context.Context, stateStore string, ope
 				}
 			}
 
-			var ceData []byte
-			bt, ok := payload.([]byte)
-			if ok {
-				ceData = bt
-			} else if contentType != "" && strings.EqualFold(contentType, "application/json") {
-				b, sErr := json.Marshal(payload)
-				if sErr != nil {
-					return nil, sErr
-				}
-
-				ceData = b
-			} else {
-				ceData = []byte(fmt.Sprintf("%v", payload))
+			tr, err := o.publishOutboxMessage(ctx, c, outboxMessage{
+				payload:     payload,
+				contentType: contentType,
+				metadata:    op.GetMetadata(),
+				source:      source,
+				traceID:     traceID,
+				traceState:  traceState,
+			})
+			if err != nil {
+				return nil, err
 			}
 
-			var dataContentType string
-			if contentType != "" {
-				dataContentType = contentType
-			}
+			operations = append(operations, tr)
+		}
+	}
 
-			ce := contribPubsub.NewCloudEventsEnvelope(tr.GetKey(), source, "", "", "", c.outboxPubsub, dataContentType, ceData, "", traceState)
-			ce[contribPubsub.TraceIDField] = traceID
+	// Explicit messages go through the same internal topic as the state
+	// operations, with their destination topic carried in the cloud event.
+	for _, msg := range messages {
+		// Data which isn't raw bytes is serialized as JSON by default.
+		contentType := msg.ContentType
+		if _, ok := msg.Data.([]byte); !ok && contentType == "" {
+			contentType = "application/json"
+		}
+		tr, err := o.publishOutboxMessage(ctx, c, outboxMessage{
+			topic:       msg.Topic,
+			payload:     msg.Data,
+			contentType: contentType,
+			metadata:    msg.Metadata,
+			source:      source,
+			traceID:     traceID,
+			traceState:  traceState,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-			for k, v := range op.GetMetadata() {
-				if k == contribPubsub.DataField || k == contribPubsub.IDField {
-					continue
-				}
+		operations = append(operations, tr)
+	}
 
-				ce[k] = v
-			}
+	return operations, nil
+}
 
-			data, err := json.Marshal(ce)
-			if err != nil {
-				return nil, err
-			}
+type outboxMessage struct {
+	// topic is the topic the message is published to, or empty for the topic
+	// configured on the state store.
+	topic       string
+	payload     any
+	contentType string
+	metadata    map[string]string
+	source      string
+	traceID     string
+	traceState  string
+}
 
-			err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
-				PubsubName: c.outboxPubsub,
-				Data:       data,
-				Topic:      outboxTopic(source, c.publishTopic, o.namespace),
-			})
-			if err != nil {
-				return nil, err
-			}
+// publishOutboxMessage publishes the message to the internal outbox topic, and
+// returns the state operation which records it in the transaction.
+func (o *outboxImpl) publishOutboxMessage(ctx context.Context, c outboxConfig, msg outboxMessage) (state.TransactionalStateOperation, error) {
+	tr, err := transaction()
+	if err != nil {
+		return nil, err
+	}
 
-			operations = append(operations, tr)
+	var ceData []byte
+	bt, ok := msg.payload.([]byte)
+	if ok {
+		ceData = bt
+	} else if msg.contentType != "" && strings.EqualFold(msg.contentType, "application/json") {
+		b, sErr := json.Marshal(msg.payload)
+		if sErr != nil {
+			return nil, sErr
 		}
+
+		ceData = b
+	} else {
+		ceData = []byte(fmt.Sprintf("%v", msg.payload))
 	}
 
-	return operations, nil
+	var dataContentType string
+	if msg.contentType != "" {
+		dataContentType = msg.contentType
+	}
+
+	ce := contribPubsub.NewCloudEventsEnvelope(tr.GetKey(), msg.source, "", "", "", c.outboxPubsub, dataContentType, ceData, "", msg.traceState)
+	ce[contribPubsub.TraceIDField] = msg.traceID
+
+	for k, v := range msg.metadata {
+		switch k {
+		case contribPubsub.DataField, contribPubsub.IDField:
+			continue
+		case outboxTopicExtension, contribPubsub.TopicField, contribPubsub.PubsubField:
+			// The destination of the message is only set by the runtime.
+			continue
+		}
+
+		ce[k] = v
+	}
+
+	if msg.topic != "" {
+		ce[outboxTopicExtension] = msg.topic
+	}
+
+	data, err := json.Marshal(ce)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
+		PubsubName: c.outboxPubsub,
+		Data:       data,
+		Topic:      outboxTopic(msg.source, c.publishTopic, o.namespace),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tr, nil
 }
 
 func outboxTopic(appID, topic, namespace string) string {
@@ -311,7 +419,13 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 				return err
 			}
 
-			cloudEvent[contribPubsub.TopicField] = c.publishTopic
+			publishTopic := c.publishTopic
+			if topic, ok := cloudEvent[outboxTopicExtension].(string); ok && topic != "" {
+				publishTopic = topic
+			}
+			delete(cloudEvent, outboxTopicExtension)
+
+			cloudEvent[contribPubsub.TopicField] = publishTopic
 			cloudEvent[contribPubsub.PubsubField] = c.publishPubSub
 
 			b, err := json.Marshal(cloudEvent)
@@ -324,7 +438,7 @@ func (o *outboxImpl) SubscribeToInternalTopics(ctx context.Context, appID string
 			err = o.publisher.Publish(ctx, &contribPubsub.PublishRequest{
 				PubsubName:  c.publishPubSub,
 				Data:        b,
-				Topic:       c.publishTopic,
+				Topic:       publishTopic,
 				ContentType: &contentType,
 			})
 			if err != nil {
@@ -675,6 +675,137 @@ func TestPublishInternal(t *testing.T) {
 	})
 }
 
+func addTestOutboxStore(o outbox.Outbox, name, pubsub, topic string) {
+	o.AddOrUpdateOutbox(v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.ComponentSpec{
+			Metadata: []common.NameValuePair{
+				{
+					Name:  outboxPublishPubsubKey,
+					Value: common.DynamicValue{JSON: v1.JSON{Raw: []byte(pubsub)}},
+				},
+				{
+					Name:  outboxPublishTopicKey,
+					Value: common.DynamicValue{JSON: v1.JSON{Raw: []byte(topic)}},
+				},
+			},
+		},
+	})
+}
+
+func TestPublishInternalMessages(t *testing.T) {
+	t.Run("messages are published through the internal topic", func(t *testing.T) {
+		var published []map[string]any
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			assert.Equal(t, "a", pr.PubsubName)
+			assert.Equal(t, "testapp1outbox", pr.Topic)
+
+			var cloudEvent map[string]any
+			require.NoError(t, json.Unmarshal(pr.Data, &cloudEvent))
+			published = append(published, cloudEvent)
+			return nil
+		})
+		addTestOutboxStore(o, "test", "a", "1")
+
+		ops, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{Key: "key", Value: "state"},
+			outbox.Message{
+				Topic:       "OrderCreated",
+				Data:        map[string]any{"id": 1},
+				ContentType: "application/json",
+			},
+			outbox.Message{
+				Topic:    "InventoryReserved",
+				Data:     []byte("reserved"),
+				Metadata: map[string]string{"custom": "value"},
+			},
+		}, "testapp", "", "")
+		require.NoError(t, err)
+
+		require.Len(t, ops, 4)
+		assert.Equal(t, "key", ops[0].GetKey())
+		for _, op := range ops[1:] {
+			assert.IsType(t, state.SetRequest{}, op)
+			assert.Contains(t, op.GetKey(), outboxStatePrefix+"-")
+		}
+
+		require.Len(t, published, 3)
+		assert.Equal(t, "state", published[0]["data"])
+		assert.NotContains(t, published[0], outboxTopicExtension)
+		assert.Equal(t, "OrderCreated", published[1][outboxTopicExtension])
+		assert.Equal(t, map[string]any{"id": float64(1)}, published[1]["data"])
+		assert.Equal(t, "application/json", published[1]["datacontenttype"])
+		assert.Equal(t, "InventoryReserved", published[2][outboxTopicExtension])
+		assert.Equal(t, "reserved", published[2]["data"])
+		assert.Equal(t, "value", published[2]["custom"])
+		assert.Equal(t, ops[3].GetKey(), published[2]["id"])
+	})
+
+	t.Run("metadata can't set the destination", func(t *testing.T) {
+		var published []map[string]any
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			var cloudEvent map[string]any
+			require.NoError(t, json.Unmarshal(pr.Data, &cloudEvent))
+			published = append(published, cloudEvent)
+			return nil
+		})
+		addTestOutboxStore(o, "test", "a", "1")
+
+		reserved := map[string]string{
+			outboxTopicExtension:      "Redirected",
+			contribPubsub.TopicField:  "Redirected",
+			contribPubsub.PubsubField: "other",
+		}
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{Key: "key", Value: "state", Metadata: reserved},
+			outbox.Message{Topic: "OrderCreated", Data: "data", Metadata: reserved},
+		}, "testapp", "", "")
+		require.NoError(t, err)
+
+		require.Len(t, published, 2)
+		assert.NotContains(t, published[0], outboxTopicExtension)
+		assert.Equal(t, "OrderCreated", published[1][outboxTopicExtension])
+		for _, ce := range published {
+			assert.NotEqual(t, "Redirected", ce[contribPubsub.TopicField])
+			assert.NotEqual(t, "other", ce[contribPubsub.PubsubField])
+		}
+	})
+
+	t.Run("data is serialized as JSON by default", func(t *testing.T) {
+		var published map[string]any
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			return json.Unmarshal(pr.Data, &published)
+		})
+		addTestOutboxStore(o, "test", "a", "1")
+
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			outbox.Message{Topic: "OrderCreated", Data: map[string]any{"id": 1}},
+		}, "testapp", "", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"id": float64(1)}, published["data"])
+		assert.Equal(t, "application/json", published["datacontenttype"])
+	})
+
+	t.Run("message without topic", func(t *testing.T) {
+		var called bool
+		o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+			called = true
+			return nil
+		})
+		addTestOutboxStore(o, "test", "a", "1")
+
+		_, err := o.PublishInternal(t.Context(), "test", []state.TransactionalStateOperation{
+			state.SetRequest{Key: "key", Value: "state"},
+			outbox.Message{Data: "data"},
+		}, "testapp", "", "")
+		require.Error(t, err)
+		assert.False(t, called)
+	})
+}
+
 func TestSubscribeToInternalTopics(t *testing.T) {
 	t.Run("correct configuration with trace, custom field and nonoverridable fields", func(t *testing.T) {
 		const outboxTopic = "test1outbox"
@@ -1178,6 +1309,47 @@ func (o *outboxStateMock) Get(ctx context.Context, req *state.GetRequest) (*stat
 	return nil, nil
 }
 
+func TestSubscribeToInternalTopicsMessages(t *testing.T) {
+	psMock := &outboxPubsubMock{
+		expectedOutboxTopic: "test1outbox",
+		t:                   t,
+	}
+	stateMock := &outboxStateMock{}
+
+	publishedCh := make(chan *contribPubsub.PublishRequest, 1)
+	o := newTestOutbox(func(ctx context.Context, pr *contribPubsub.PublishRequest) error {
+		publishedCh <- pr
+		return nil
+	}).(*outboxImpl)
+	o.getPubsubFn = func(string) (contribPubsub.PubSub, bool) {
+		return psMock, true
+	}
+	o.getStateFn = func(string) (state.Store, bool) {
+		return stateMock, true
+	}
+	addTestOutboxStore(o, "test", "a", "1")
+	require.NoError(t, o.SubscribeToInternalTopics(t.Context(), "test"))
+
+	stateMock.expectedKey.Store(ptr.Of("outbox-1"))
+	data, err := json.Marshal(map[string]any{
+		contribPubsub.IDField:              "outbox-1",
+		contribPubsub.DataField:            "reserved",
+		contribPubsub.DataContentTypeField: "text/plain",
+		outboxTopicExtension:               "InventoryReserved",
+	})
+	require.NoError(t, err)
+	require.NoError(t, psMock.handler(t.Context(), &contribPubsub.NewMessage{Data: data}))
+
+	pr := <-publishedCh
+	assert.Equal(t, "a", pr.PubsubName)
+	assert.Equal(t, "InventoryReserved", pr.Topic)
+
+	var cloudEvent map[string]any
+	require.NoError(t, json.Unmarshal(pr.Data, &cloudEvent))
+	assert.Equal(t, "InventoryReserved", cloudEvent[contribPubsub.TopicField])
+	assert.NotContains(t, cloudEvent, outboxTopicExtension)
+}
+
 func TestOutboxTopic(t *testing.T) {
 	t.Run("not namespaced", func(t *testing.T) {
 		o := newTestOutbox(nil).(*outboxImpl)
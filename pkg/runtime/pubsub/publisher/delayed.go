@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
+	"github.com/dapr/kit/ptr"
+)
+
+// delayedJobName is the name of the scheduler jobs of delayed messages. Each
+// delayed message is scheduled for its own actor ID, so the name is constant.
+const delayedJobName = "delayed-message"
+
+// scheduleTimeout is the timeout of scheduling a delayed message.
+const scheduleTimeout = time.Second * 30
+
+// DelayedActorType returns the actor type which the scheduler jobs of the
+// delayed messages of an app target. Delayed messages are scheduled as actor
+// reminders rather than as jobs of the app, because the jobs of the app are
+// only triggered while the app is healthy, whereas the sidecar watches this
+// actor type whatever the health of the app, once a pubsub component which
+// doesn't delay messages natively is loaded. No actor of this type is hosted:
+// the sidecar publishes the message when the reminder is triggered.
+func DelayedActorType(namespace, appID string) string {
+	return "dapr.internal." + namespace + "." + appID + ".pubsub.delayed"
+}
+
+// PublishDelayed publishes the delayed message stored in the data of a
+// triggered scheduler job. The message is published as it was when it was
+// delayed, so a CloudEvent keeps the trace context of the original publish.
+func PublishDelayed(ctx context.Context, adapter rtpubsub.Adapter, data *anypb.Any) error {
+	var msg runtimev1pb.PublishEventRequest
+	if err := data.UnmarshalTo(&msg); err != nil {
+		return fmt.Errorf("failed to unmarshal delayed message: %w", err)
+	}
+
+	req := &contribpubsub.PublishRequest{
+		PubsubName: msg.GetPubsubName(),
+		Topic:      msg.GetTopic(),
+		Data:       msg.GetData(),
+		Metadata:   msg.GetMetadata(),
+	}
+	if ct := msg.GetDataContentType(); ct != "" {
+		req.ContentType = ptr.Of(ct)
+	}
+	return adapter.Publish(ctx, req)
+}
+
+// deliveryTime returns the time at which a message published with the given
+// metadata is delayed to. The returned bool is false if the message is
+// published now, because it isn't delayed, its delivery time has passed, or
+// the broker delays messages natively.
+func (p *publisher) deliveryTime(pubsub *rtpubsub.PubsubItem, metadata map[string]string) (time.Time, bool, error) {
+	if pubsub.NativeDelayedDelivery {
+		return time.Time{}, false, nil
+	}
+
+	now := p.clock.Now()
+	at, ok, err := rtpubsub.DeliveryTime(metadata, now)
+	if err != nil || !ok || !at.After(now) {
+		return time.Time{}, false, err
+	}
+	return at, true, nil
+}
+
+// scheduleDelayed persists a delayed message as a scheduler job which
+// publishes the message when it is triggered at the delivery time.
+func (p *publisher) scheduleDelayed(ctx context.Context, at time.Time, msg *runtimev1pb.PublishEventRequest) error {
+	var scheduler schedclient.Interface
+	if p.getSchedulerFn != nil {
+		scheduler = p.getSchedulerFn()
+	}
+	if scheduler == nil {
+		return errors.New("delayed publishing requires the scheduler")
+	}
+
+	msg.Metadata = rtpubsub.WithoutDeliveryTime(msg.GetMetadata())
+	data, err := anypb.New(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal delayed message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, scheduleTimeout)
+	defer cancel()
+
+	_, err = scheduler.ScheduleJob(ctx, &schedulerv1pb.ScheduleJobRequest{
+		Name: delayedJobName,
+		Metadata: &schedulerv1pb.JobMetadata{
+			AppId:     p.appID,
+			Namespace: p.namespace,
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Actor{
+					Actor: &schedulerv1pb.TargetActorReminder{
+						Type: DelayedActorType(p.namespace, p.appID),
+						Id:   uuid.NewString(),
+					},
+				},
+			},
+		},
+		Job: &schedulerv1pb.Job{
+			DueTime: ptr.Of(at.UTC().Format(time.RFC3339Nano)),
+			Data:    data,
+		},
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("failed to schedule delayed message: %w", err)
+	}
+
+	log.Debugf("Delayed message to topic %s on pubsub %s until %s", msg.GetTopic(), msg.GetPubsubName(), at)
+	return nil
+}
+
+// bulkScheduleDelayed schedules the delayed entries of a bulk publish request
+// and returns the entries to publish now, along with the failed entries.
+func (p *publisher) bulkScheduleDelayed(ctx context.Context, pubsub *rtpubsub.PubsubItem, req *contribpubsub.BulkPublishRequest) ([]contribpubsub.BulkMessageEntry, []contribpubsub.BulkPublishResponseFailedEntry) {
+	if pubsub.NativeDelayedDelivery {
+		return req.Entries, nil
+	}
+
+	var failed []contribpubsub.BulkPublishResponseFailedEntry
+	entries := make([]contribpubsub.BulkMessageEntry, 0, len(req.Entries))
+	for _, entry := range req.Entries {
+		metadata := maps.Clone(req.Metadata)
+		if metadata == nil {
+			metadata = make(map[string]string, len(entry.Metadata))
+		}
+		maps.Copy(metadata, entry.Metadata)
+
+		at, ok, err := p.deliveryTime(pubsub, metadata)
+		if err == nil && ok {
+			err = p.scheduleDelayed(ctx, at, &runtimev1pb.PublishEventRequest{
+				PubsubName:      req.PubsubName,
+				Topic:           req.Topic,
+				Data:            entry.Event,
+				DataContentType: entry.ContentType,
+				Metadata:        metadata,
+			})
+		}
+		switch {
+		case err != nil:
+			failed = append(failed, contribpubsub.BulkPublishResponseFailedEntry{
+				EntryId: entry.EntryId,
+				Error:   err,
+			})
+		case !ok:
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, failed
+}
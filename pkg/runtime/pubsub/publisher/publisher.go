@@ -15,42 +15,58 @@ package publisher
 
 import (
 	"context"
+	"errors"
+
+	"k8s.io/utils/clock"
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/kit/logger"
 )
 
 type GetPubSubFn func(name string) (*rtpubsub.PubsubItem, bool)
 
+// GetSchedulerFn returns the scheduler client which delayed messages are
+// scheduled with.
+type GetSchedulerFn func() schedclient.Interface
+
 type Options struct {
-	AppID       string
-	Namespace   string
-	Resiliency  resiliency.Provider
-	GetPubSubFn GetPubSubFn
+	AppID          string
+	Namespace      string
+	Resiliency     resiliency.Provider
+	GetPubSubFn    GetPubSubFn
+	GetSchedulerFn GetSchedulerFn
 }
 
 type publisher struct {
-	appID       string
-	namespace   string
-	resiliency  resiliency.Provider
-	getpubsubFn GetPubSubFn
+	appID          string
+	namespace      string
+	resiliency     resiliency.Provider
+	getpubsubFn    GetPubSubFn
+	getSchedulerFn GetSchedulerFn
+	clock          clock.Clock
 }
 
 var log = logger.NewLogger("dapr.runtime.pubsub.publisher")
 
 func New(opts Options) rtpubsub.Adapter {
 	return &publisher{
-		appID:       opts.AppID,
-		namespace:   opts.Namespace,
-		resiliency:  opts.Resiliency,
-		getpubsubFn: opts.GetPubSubFn,
+		appID:          opts.AppID,
+		namespace:      opts.Namespace,
+		resiliency:     opts.Resiliency,
+		getpubsubFn:    opts.GetPubSubFn,
+		getSchedulerFn: opts.GetSchedulerFn,
+		clock:          clock.RealClock{},
 	}
 }
 
 // Publish is an adapter method for the runtime to pre-validate publish requests
 // And then forward them to the Pub/Sub component.
+// Delayed messages are scheduled with the scheduler instead, unless the broker
+// delays messages natively.
 // This method is used by the HTTP and gRPC APIs.
 func (p *publisher) Publish(ctx context.Context, req *contribpubsub.PublishRequest) error {
 	pubsub, ok := p.getpubsubFn(req.PubsubName)
@@ -62,6 +78,23 @@ func (p *publisher) Publish(ctx context.Context, req *contribpubsub.PublishReque
 		return rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
 	}
 
+	at, delayed, err := p.deliveryTime(pubsub, req.Metadata)
+	if err != nil {
+		return err
+	}
+	if delayed {
+		msg := &runtimev1pb.PublishEventRequest{
+			PubsubName: req.PubsubName,
+			Topic:      req.Topic,
+			Data:       req.Data,
+			Metadata:   req.Metadata,
+		}
+		if req.ContentType != nil {
+			msg.DataContentType = *req.ContentType
+		}
+		return p.scheduleDelayed(ctx, at, msg)
+	}
+
 	if pubsub.NamespaceScoped {
 		req.Topic = p.namespace + req.Topic
 	}
@@ -69,7 +102,7 @@ func (p *publisher) Publish(ctx context.Context, req *contribpubsub.PublishReque
 	policyRunner := resiliency.NewRunner[any](ctx,
 		p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub),
 	)
-	_, err := policyRunner(func(ctx context.Context) (any, error) {
+	_, err = policyRunner(func(ctx context.Context) (any, error) {
 		return nil, pubsub.Component.Publish(ctx, req)
 	})
 	return err
@@ -85,14 +118,47 @@ func (p *publisher) BulkPublish(ctx context.Context, req *contribpubsub.BulkPubl
 		return contribpubsub.BulkPublishResponse{}, rtpubsub.NotAllowedError{Topic: req.Topic, ID: p.appID}
 	}
 
+	entries, failed := p.bulkScheduleDelayed(ctx, pubsub, req)
+	if len(entries) == 0 {
+		return bulkPublishResponse(contribpubsub.BulkPublishResponse{}, nil, failed)
+	}
+	if len(entries) < len(req.Entries) {
+		req = &contribpubsub.BulkPublishRequest{
+			Entries:    entries,
+			PubsubName: req.PubsubName,
+			Topic:      req.Topic,
+			Metadata:   req.Metadata,
+		}
+	}
+
 	policyDef := p.resiliency.ComponentOutboundPolicy(req.PubsubName, resiliency.Pubsub)
 
 	if contribpubsub.FeatureBulkPublish.IsPresent(pubsub.Component.Features()) {
-		return rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, pubsub.Component.(contribpubsub.BulkPublisher))
+		res, err := rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, pubsub.Component.(contribpubsub.BulkPublisher))
+		return bulkPublishResponse(res, err, failed)
 	}
 
 	log.Debugf("pubsub %s does not implement the BulkPublish API; falling back to publishing messages individually", req.PubsubName)
 	defaultBulkPublisher := rtpubsub.NewDefaultBulkPublisher(pubsub.Component)
 
-	return rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, defaultBulkPublisher)
+	res, err := rtpubsub.ApplyBulkPublishResiliency(ctx, req, policyDef, defaultBulkPublisher)
+	return bulkPublishResponse(res, err, failed)
+}
+
+// bulkPublishResponse adds the entries which failed to be delayed to the
+// response of a bulk publish.
+func bulkPublishResponse(res contribpubsub.BulkPublishResponse, err error, failed []contribpubsub.BulkPublishResponseFailedEntry) (contribpubsub.BulkPublishResponse, error) {
+	if len(failed) == 0 {
+		return res, err
+	}
+
+	res.FailedEntries = append(res.FailedEntries, failed...)
+	errs := make([]error, 0, len(failed)+1)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, f := range failed {
+		errs = append(errs, f.Error)
+	}
+	return res, errors.Join(errs...)
 }
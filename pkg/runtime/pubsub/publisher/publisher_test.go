@@ -21,13 +21,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 const (
@@ -503,3 +508,131 @@ func TestPubsubWithResiliency(t *testing.T) {
 		assert.Less(t, end.Sub(start), time.Second*10)
 	})
 }
+
+type mockScheduler struct {
+	schedulerv1pb.SchedulerClient
+	jobs []*schedulerv1pb.ScheduleJobRequest
+}
+
+func (m *mockScheduler) Addresses() []string {
+	return nil
+}
+
+func (m *mockScheduler) ScheduleJob(_ context.Context, req *schedulerv1pb.ScheduleJobRequest, _ ...grpc.CallOption) (*schedulerv1pb.ScheduleJobResponse, error) {
+	m.jobs = append(m.jobs, req)
+	return new(schedulerv1pb.ScheduleJobResponse), nil
+}
+
+func TestPublishDelayed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newPublisher := func(item *rtpubsub.PubsubItem) (*publisher, *mockScheduler) {
+		compStore := compstore.New()
+		compStore.AddPubSub(TestPubsubName, item)
+		scheduler := new(mockScheduler)
+		ps := New(Options{
+			AppID:       "myapp",
+			Namespace:   "ns1",
+			Resiliency:  resiliency.New(logger.NewLogger("test")),
+			GetPubSubFn: compStore.GetPubSub,
+			GetSchedulerFn: func() schedclient.Interface {
+				return scheduler
+			},
+		}).(*publisher)
+		ps.clock = clocktesting.NewFakeClock(now)
+		return ps, scheduler
+	}
+
+	t.Run("delayed message is scheduled and published when triggered", func(t *testing.T) {
+		comp := &mockPublishPubSub{}
+		ps, scheduler := newPublisher(&rtpubsub.PubsubItem{Component: comp, NamespaceScoped: true})
+
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName:  TestPubsubName,
+			Topic:       "topic0",
+			Data:        []byte("test"),
+			ContentType: ptr.Of("text/plain"),
+			Metadata:    map[string]string{rtpubsub.MetadataDeliverAfter: "10m", "key": "v"},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, comp.PublishedRequest.Load())
+
+		require.Len(t, scheduler.jobs, 1)
+		job := scheduler.jobs[0]
+		assert.Equal(t, "myapp", job.GetMetadata().GetAppId())
+		assert.Equal(t, "ns1", job.GetMetadata().GetNamespace())
+		actor := job.GetMetadata().GetTarget().GetActor()
+		require.NotNil(t, actor)
+		assert.Equal(t, DelayedActorType("ns1", "myapp"), actor.GetType())
+		assert.NotEmpty(t, actor.GetId())
+		assert.Equal(t, "2025-01-01T12:10:00Z", job.GetJob().GetDueTime())
+
+		require.NoError(t, PublishDelayed(t.Context(), ps, job.GetJob().GetData()))
+		published := comp.PublishedRequest.Load()
+		require.NotNil(t, published)
+		assert.Equal(t, "ns1topic0", published.Topic)
+		assert.Equal(t, []byte("test"), published.Data)
+		assert.Equal(t, "text/plain", *published.ContentType)
+		assert.Equal(t, map[string]string{"key": "v"}, published.Metadata)
+	})
+
+	t.Run("past delivery time is published now", func(t *testing.T) {
+		comp := &mockPublishPubSub{}
+		ps, scheduler := newPublisher(&rtpubsub.PubsubItem{Component: comp})
+
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Metadata:   map[string]string{rtpubsub.MetadataDeliverAt: "2024-01-01T00:00:00Z"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, scheduler.jobs)
+		assert.NotNil(t, comp.PublishedRequest.Load())
+	})
+
+	t.Run("native delayed delivery passes metadata through", func(t *testing.T) {
+		comp := &mockPublishPubSub{}
+		ps, scheduler := newPublisher(&rtpubsub.PubsubItem{Component: comp, NativeDelayedDelivery: true})
+
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Metadata:   map[string]string{rtpubsub.MetadataDeliverAfter: "10m"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, scheduler.jobs)
+		require.NotNil(t, comp.PublishedRequest.Load())
+		assert.Equal(t, "10m", comp.PublishedRequest.Load().Metadata[rtpubsub.MetadataDeliverAfter])
+	})
+
+	t.Run("invalid delivery time", func(t *testing.T) {
+		ps, _ := newPublisher(&rtpubsub.PubsubItem{Component: &mockPublishPubSub{}})
+
+		err := ps.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Metadata:   map[string]string{rtpubsub.MetadataDeliverAfter: "soon"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("bulk publish schedules delayed entries", func(t *testing.T) {
+		ps, scheduler := newPublisher(&rtpubsub.PubsubItem{Component: &mockPublishPubSub{}})
+
+		res, err := ps.BulkPublish(t.Context(), &contribpubsub.BulkPublishRequest{
+			PubsubName: TestPubsubName,
+			Topic:      "topic0",
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte("now"), ContentType: "text/plain"},
+				{EntryId: "2", Event: []byte("later"), ContentType: "text/plain", Metadata: map[string]string{rtpubsub.MetadataDeliverAfter: "1h"}},
+				{EntryId: "3", Event: []byte("invalid"), ContentType: "text/plain", Metadata: map[string]string{rtpubsub.MetadataDeliverAfter: "soon"}},
+			},
+		})
+		require.Error(t, err)
+		require.Len(t, res.FailedEntries, 1)
+		assert.Equal(t, "3", res.FailedEntries[0].EntryId)
+
+		require.Len(t, scheduler.jobs, 1)
+		assert.Equal(t, "2025-01-01T13:00:00Z", scheduler.jobs[0].GetJob().GetDueTime())
+	})
+}
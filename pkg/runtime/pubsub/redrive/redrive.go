@@ -0,0 +1,353 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redrive moves messages from a dead-letter topic back to the topics
+// they were originally published to.
+package redrive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// DefaultIdleTimeout is the duration without any message after which the
+	// dead-letter topic is considered empty.
+	DefaultIdleTimeout = 5 * time.Second
+	// DefaultTimeout is the maximum duration of a redrive.
+	DefaultTimeout = time.Minute
+)
+
+var log = logger.NewLogger("dapr.runtime.pubsub.redrive")
+
+// errHold is returned by process for messages which aren't redriven. They
+// are held until the redrive ends, and then rejected, so they stay in the
+// dead-letter topic.
+var errHold = errors.New("message not redriven")
+
+// Request is a request to redrive the messages of a dead-letter topic.
+type Request struct {
+	// PubsubName is the name of the pubsub of the dead-letter topic.
+	PubsubName string
+	// DeadLetterTopic is the dead-letter topic to consume messages from.
+	DeadLetterTopic string
+	// Topic is the topic to republish messages to. If empty, messages are
+	// republished to the topic of their CloudEvent.
+	Topic string
+	// MaxMessages is the maximum number of messages to redrive. If 0, all
+	// messages are redriven until the dead-letter topic is empty.
+	MaxMessages int
+	// Filter is a CEL expression over the CloudEvent, as `event`, which
+	// messages must match to be redriven.
+	Filter *expr.Expr
+	// DryRun reports the messages which would be redriven, without moving them.
+	DryRun bool
+	// Metadata is the metadata of the subscription to the dead-letter topic.
+	Metadata map[string]string
+}
+
+// Message is a redriven message.
+type Message struct {
+	ID    string `json:"id,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Topic string `json:"topic"`
+}
+
+// Result is the result of a redrive.
+type Result struct {
+	// Messages are the messages which were, or in dry-run mode would be,
+	// redriven.
+	Messages []Message `json:"messages"`
+	// Skipped is the number of messages which were left in the dead-letter
+	// topic, because they didn't match the filter or have no topic.
+	Skipped int `json:"skipped"`
+	// DryRun is true if no message was moved.
+	DryRun bool `json:"dryRun"`
+}
+
+// Options are the options of a redrive.
+type Options struct {
+	// Pubsub is the pubsub of the dead-letter topic.
+	Pubsub *rtpubsub.PubsubItem
+	// Publisher republishes messages.
+	Publisher rtpubsub.Adapter
+	// AppID is the ID of the app, which must be allowed to subscribe to the
+	// dead-letter topic.
+	AppID string
+	// Namespace is the namespace of the app, which prefixes the topics of
+	// namespace scoped pubsubs.
+	Namespace   string
+	IdleTimeout time.Duration
+	Timeout     time.Duration
+}
+
+type redrive struct {
+	opts Options
+	req  *Request
+	// ctx is the context of the subscription to the dead-letter topic.
+	ctx context.Context
+
+	lock   sync.Mutex
+	result Result
+	seen   map[string]struct{}
+	err    error
+	done   bool
+	doneCh chan struct{}
+	idleCh chan struct{}
+	// pending is the number of messages being republished, which count
+	// towards MaxMessages.
+	pending int
+	// publishing is done when no message is being republished.
+	publishing sync.WaitGroup
+}
+
+// Redrive consumes messages from the dead-letter topic and republishes them
+// to their original topic. Messages are republished with their data and
+// metadata unchanged, so CloudEvents keep their trace context.
+//
+// Redrive stops when MaxMessages are redriven, when the timeout is reached,
+// or when no message was delivered for the idle timeout, which is when the
+// dead-letter topic is considered drained.
+// Messages which aren't redriven, including every message of a dry run, are
+// held until the redrive ends, and then rejected, so the broker keeps them in
+// the dead-letter topic. Brokers count the rejection as a failed delivery:
+// depending on the broker, held messages are redelivered to the dead-letter
+// topic, or dead-lettered or dropped once their maximum number of deliveries
+// is reached. A message is redriven at most once per redrive, so a redriven
+// message which lands in the dead-letter topic again is held.
+// With brokers which deliver one message at a time, a held message stops
+// further deliveries, so the redrive ends at the next idle timeout.
+// The dead-letter topic is subscribed to with the component, and so with the
+// consumer group, of the app: callers must not redrive a dead-letter topic
+// which the app subscribes to, as the subscriptions would compete for its
+// messages.
+func Redrive(ctx context.Context, opts Options, req *Request) (*Result, error) {
+	if !rtpubsub.IsOperationAllowed(req.DeadLetterTopic, opts.Pubsub, opts.Pubsub.ScopedSubscriptions) {
+		return nil, rtpubsub.NotAllowedError{Topic: req.DeadLetterTopic, ID: opts.AppID}
+	}
+
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = DefaultIdleTimeout
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	r := &redrive{
+		opts: opts,
+		req:  req,
+		result: Result{
+			Messages: make([]Message, 0),
+			DryRun:   req.DryRun,
+		},
+		seen:   make(map[string]struct{}),
+		doneCh: make(chan struct{}),
+		idleCh: make(chan struct{}, 1),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	topic := req.DeadLetterTopic
+	if opts.Pubsub.NamespaceScoped {
+		topic = opts.Namespace + topic
+	}
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	r.ctx = subCtx
+	err := opts.Pubsub.Component.Subscribe(subCtx, contribpubsub.SubscribeRequest{
+		Topic:    topic,
+		Metadata: req.Metadata,
+	}, r.handle)
+	if err != nil {
+		subCancel()
+		return nil, fmt.Errorf("failed to subscribe to dead-letter topic %s: %w", req.DeadLetterTopic, err)
+	}
+
+	idle := time.NewTimer(opts.IdleTimeout)
+	defer idle.Stop()
+	for stop := false; !stop; {
+		select {
+		case <-r.doneCh:
+			stop = true
+		case <-r.idleCh:
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(opts.IdleTimeout)
+		case <-idle.C:
+			stop = true
+		case <-ctx.Done():
+			stop = true
+		}
+	}
+
+	r.lock.Lock()
+	r.stop()
+	r.lock.Unlock()
+	r.publishing.Wait()
+
+	r.lock.Lock()
+	result, err := r.result, r.err
+	r.lock.Unlock()
+	subCancel()
+
+	return &result, err
+}
+
+// handle handles a message of the dead-letter topic. Held messages are
+// rejected when the redrive ends.
+func (r *redrive) handle(ctx context.Context, msg *contribpubsub.NewMessage) error {
+	r.lock.Lock()
+	message, err := r.process(msg)
+	r.lock.Unlock()
+	if err == nil {
+		return r.publish(ctx, message, msg)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	}
+}
+
+// process reserves a message to be republished, or returns errHold if the
+// message isn't redriven. The lock must be held.
+func (r *redrive) process(msg *contribpubsub.NewMessage) (Message, error) {
+	if r.done {
+		return Message{}, errHold
+	}
+
+	select {
+	case r.idleCh <- struct{}{}:
+	default:
+	}
+
+	var event map[string]any
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		event = nil
+	}
+
+	// Messages are identified by the ID of their CloudEvent, or by their
+	// content if they aren't CloudEvents.
+	id, _ := event[contribpubsub.IDField].(string)
+	key := id
+	if key == "" {
+		sum := sha256.Sum256(msg.Data)
+		key = hex.EncodeToString(sum[:])
+	}
+	if _, ok := r.seen[key]; ok {
+		return Message{}, errHold
+	}
+	r.seen[key] = struct{}{}
+
+	topic := r.req.Topic
+	if topic == "" {
+		topic, _ = event[contribpubsub.TopicField].(string)
+	}
+	if topic == "" {
+		log.Debugf("Skipping message %s of dead-letter topic %s without topic", id, r.req.DeadLetterTopic)
+		r.result.Skipped++
+		return Message{}, errHold
+	}
+
+	if r.req.Filter != nil && r.req.Filter.String() != "" {
+		match, err := r.req.Filter.Eval(map[string]any{"event": event})
+		if err != nil {
+			log.Debugf("Skipping message %s of dead-letter topic %s which failed to match filter: %s", id, r.req.DeadLetterTopic, err)
+			r.result.Skipped++
+			return Message{}, errHold
+		}
+		if ok, _ := match.(bool); !ok {
+			r.result.Skipped++
+			return Message{}, errHold
+		}
+	}
+
+	typ, _ := event[contribpubsub.TypeField].(string)
+	message := Message{ID: id, Type: typ, Topic: topic}
+
+	if r.req.DryRun {
+		r.add(message)
+		return Message{}, errHold
+	}
+
+	r.pending++
+	r.publishing.Add(1)
+	r.checkMaxMessages()
+	return message, nil
+}
+
+// publish republishes a message reserved by process. Messages are
+// republished without the lock, so concurrent deliveries don't wait for each
+// other's publishing.
+func (r *redrive) publish(ctx context.Context, message Message, msg *contribpubsub.NewMessage) error {
+	defer r.publishing.Done()
+
+	err := r.opts.Publisher.Publish(ctx, &contribpubsub.PublishRequest{
+		PubsubName:  r.req.PubsubName,
+		Topic:       message.Topic,
+		Data:        msg.Data,
+		Metadata:    msg.Metadata,
+		ContentType: msg.ContentType,
+	})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.pending--
+	if err != nil {
+		if r.err == nil {
+			r.err = fmt.Errorf("failed to republish message %s to topic %s: %w", message.ID, message.Topic, err)
+		}
+		r.stop()
+		return err
+	}
+	r.result.Messages = append(r.result.Messages, message)
+	return nil
+}
+
+// add adds a message which would be redriven to the result of a dry run.
+// The lock must be held.
+func (r *redrive) add(message Message) {
+	r.result.Messages = append(r.result.Messages, message)
+	r.checkMaxMessages()
+}
+
+// checkMaxMessages stops the redrive when the maximum number of messages is
+// redriven or being republished. The lock must be held.
+func (r *redrive) checkMaxMessages() {
+	if r.req.MaxMessages > 0 && len(r.result.Messages)+r.pending >= r.req.MaxMessages {
+		r.stop()
+	}
+}
+
+// stop stops the redrive. The lock must be held.
+func (r *redrive) stop() {
+	if !r.done {
+		r.done = true
+		close(r.doneCh)
+	}
+}
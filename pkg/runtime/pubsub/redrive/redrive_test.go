@@ -0,0 +1,262 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redrive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+)
+
+// queuePubSub delivers the messages of a queue concurrently, and requeues the
+// messages which aren't acknowledged, like a broker redelivering messages.
+type queuePubSub struct {
+	contribpubsub.PubSub
+
+	lock     sync.Mutex
+	topic    string
+	metadata map[string]string
+	queue    []*contribpubsub.NewMessage
+}
+
+func (q *queuePubSub) Subscribe(ctx context.Context, req contribpubsub.SubscribeRequest, handler contribpubsub.Handler) error {
+	q.lock.Lock()
+	q.topic, q.metadata = req.Topic, req.Metadata
+	q.lock.Unlock()
+
+	q.lock.Lock()
+	msgs := q.queue
+	q.queue = nil
+	q.lock.Unlock()
+
+	for _, msg := range msgs {
+		go func() {
+			if err := handler(ctx, msg); err != nil {
+				q.lock.Lock()
+				q.queue = append(q.queue, msg)
+				q.lock.Unlock()
+			}
+		}()
+	}
+	return nil
+}
+
+func (q *queuePubSub) remaining() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.queue)
+}
+
+func cloudEvent(t *testing.T, id, typ, topic string) *contribpubsub.NewMessage {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{
+		contribpubsub.IDField:      id,
+		contribpubsub.TypeField:    typ,
+		contribpubsub.TopicField:   topic,
+		contribpubsub.TraceIDField: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	require.NoError(t, err)
+	return &contribpubsub.NewMessage{
+		Topic:    "dlq",
+		Data:     data,
+		Metadata: map[string]string{"key": id},
+	}
+}
+
+func TestRedrive(t *testing.T) {
+	newQueue := func(t *testing.T) *queuePubSub {
+		return &queuePubSub{queue: []*contribpubsub.NewMessage{
+			cloudEvent(t, "1", "order.created", "orders"),
+			cloudEvent(t, "2", "order.deleted", "orders"),
+			cloudEvent(t, "3", "order.created", "orders"),
+			{Topic: "dlq", Data: []byte("raw")},
+		}}
+	}
+
+	recorder := func() (*fake.Fake, func() []*contribpubsub.PublishRequest) {
+		var lock sync.Mutex
+		var published []*contribpubsub.PublishRequest
+		return fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+				lock.Lock()
+				defer lock.Unlock()
+				published = append(published, req)
+				return nil
+			}), func() []*contribpubsub.PublishRequest {
+				lock.Lock()
+				defer lock.Unlock()
+				return published
+			}
+	}
+
+	opts := func(q *queuePubSub, publisher rtpubsub.Adapter) Options {
+		return Options{
+			Pubsub:      &rtpubsub.PubsubItem{Component: q, NamespaceScoped: true},
+			Publisher:   publisher,
+			Namespace:   "ns1",
+			IdleTimeout: 100 * time.Millisecond,
+			Timeout:     5 * time.Second,
+		}
+	}
+
+	t.Run("redrives all messages with a topic", func(t *testing.T) {
+		q := newQueue(t)
+		publisher, published := recorder()
+
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+			Metadata:        map[string]string{"consumerID": "redrive"},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.DryRun)
+		assert.Len(t, res.Messages, 3)
+		assert.Equal(t, 1, res.Skipped)
+		assert.Equal(t, "ns1dlq", q.topic)
+		assert.Equal(t, map[string]string{"consumerID": "redrive"}, q.metadata)
+		assert.Eventually(t, func() bool { return q.remaining() == 1 }, time.Second, 10*time.Millisecond)
+
+		reqs := published()
+		require.Len(t, reqs, 3)
+		for _, req := range reqs {
+			assert.Equal(t, "mypubsub", req.PubsubName)
+			assert.Equal(t, "orders", req.Topic)
+			assert.Contains(t, string(req.Data), "traceid")
+			assert.NotEmpty(t, req.Metadata["key"])
+		}
+	})
+
+	t.Run("redrives filtered messages to the given topic", func(t *testing.T) {
+		q := newQueue(t)
+		publisher, published := recorder()
+
+		var filter expr.Expr
+		require.NoError(t, filter.DecodeString(`event.type == "order.created"`))
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+			Topic:           "orders-retry",
+			Filter:          &filter,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Messages, 2)
+		assert.ElementsMatch(t, []Message{
+			{ID: "1", Type: "order.created", Topic: "orders-retry"},
+			{ID: "3", Type: "order.created", Topic: "orders-retry"},
+		}, res.Messages)
+		assert.Equal(t, 2, res.Skipped)
+		assert.Len(t, published(), 2)
+		assert.Eventually(t, func() bool { return q.remaining() == 2 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("redrives at most max messages", func(t *testing.T) {
+		q := newQueue(t)
+		publisher, published := recorder()
+
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+			MaxMessages:     1,
+		})
+		require.NoError(t, err)
+		assert.Len(t, res.Messages, 1)
+		assert.Len(t, published(), 1)
+		assert.Eventually(t, func() bool { return q.remaining() == 3 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("dry run moves nothing", func(t *testing.T) {
+		q := newQueue(t)
+		publisher, published := recorder()
+
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+			DryRun:          true,
+		})
+		require.NoError(t, err)
+		assert.True(t, res.DryRun)
+		assert.Len(t, res.Messages, 3)
+		assert.Empty(t, published())
+		assert.Eventually(t, func() bool { return q.remaining() == 4 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("subscription not allowed", func(t *testing.T) {
+		q := newQueue(t)
+		o := opts(q, fake.New())
+		o.AppID = "myapp"
+		o.Pubsub.ScopedSubscriptions = []string{"other"}
+
+		_, err := Redrive(t.Context(), o, &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+		})
+		require.ErrorAs(t, err, &rtpubsub.NotAllowedError{})
+		assert.Empty(t, q.topic)
+		assert.Equal(t, 4, q.remaining())
+	})
+
+	t.Run("republishes concurrent deliveries concurrently", func(t *testing.T) {
+		q := newQueue(t)
+		// Each publish waits for the three messages with a topic to be
+		// publishing, which never happens if publishing is serialized.
+		var wg sync.WaitGroup
+		wg.Add(3)
+		publisher := fake.New().WithPublishFn(func(ctx context.Context, _ *contribpubsub.PublishRequest) error {
+			wg.Done()
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+		})
+		require.NoError(t, err)
+		assert.Len(t, res.Messages, 3)
+	})
+
+	t.Run("publish error stops the redrive", func(t *testing.T) {
+		q := newQueue(t)
+		publisher := fake.New().WithPublishFn(func(context.Context, *contribpubsub.PublishRequest) error {
+			return errors.New("broker down")
+		})
+
+		res, err := Redrive(t.Context(), opts(q, publisher), &Request{
+			PubsubName:      "mypubsub",
+			DeadLetterTopic: "dlq",
+		})
+		require.ErrorContains(t, err, "broker down")
+		assert.Empty(t, res.Messages)
+		assert.Eventually(t, func() bool { return q.remaining() == 4 }, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+)
+
+// RetryAttemptExtension is the CloudEvent extension, and message metadata
+// key, which holds the number of retry tiers a message has been through.
+// It is informational only: the attempt of a message is derived from the
+// retry topic it is delivered from, because brokers may not keep metadata.
+const RetryAttemptExtension = "retryattempt"
+
+// RetryTopic is a delay tier which failed messages are republished to before
+// being redelivered to the subscriber.
+type RetryTopic struct {
+	Topic string        `json:"topic"`
+	Delay time.Duration `json:"delay"`
+}
+
+// CreateRetryTopic parses a retry tier of the given topic. The tier topic
+// defaults to "<topic>-retry-<delay>".
+func CreateRetryTopic(topic, delay, retryTopic string) (RetryTopic, error) {
+	d, err := time.ParseDuration(delay)
+	if err != nil {
+		return RetryTopic{}, fmt.Errorf("invalid retry topic delay '%s' for topic %s: %w", delay, topic, err)
+	}
+	if d <= 0 {
+		return RetryTopic{}, errors.New("retry topic delay for topic " + topic + " must be positive")
+	}
+
+	if retryTopic == "" {
+		retryTopic = topic + "-retry-" + delay
+	}
+
+	return RetryTopic{
+		Topic: retryTopic,
+		Delay: d,
+	}, nil
+}
+
+// ValidateRetryTopics checks that the retry tiers of the given topic have
+// distinct topics, which aren't the topic itself, so that the tier of a
+// message can be told from the topic it is delivered from.
+func ValidateRetryTopics(topic string, tiers []RetryTopic) error {
+	seen := make(map[string]struct{}, len(tiers)+1)
+	seen[topic] = struct{}{}
+	for _, tier := range tiers {
+		if _, ok := seen[tier.Topic]; ok {
+			return fmt.Errorf("retry topic '%s' of topic %s must be distinct from the topic and the other retry topics", tier.Topic, topic)
+		}
+		seen[tier.Topic] = struct{}{}
+	}
+	return nil
+}
+
+// WithRetryAttempt returns a copy of the message metadata carrying the
+// attempt count, and delaying the delivery by the given duration.
+func WithRetryAttempt(metadata map[string]string, attempt int, delay time.Duration) map[string]string {
+	md := maps.Clone(metadata)
+	if md == nil {
+		md = make(map[string]string, 2)
+	}
+	delete(md, MetadataDeliverAt)
+	md[MetadataDeliverAfter] = delay.String()
+	md[RetryAttemptExtension] = strconv.Itoa(attempt)
+	return md
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRetryTopic(t *testing.T) {
+	t.Run("default topic", func(t *testing.T) {
+		rt, err := CreateRetryTopic("orders", "10s", "")
+		require.NoError(t, err)
+		assert.Equal(t, RetryTopic{Topic: "orders-retry-10s", Delay: 10 * time.Second}, rt)
+	})
+
+	t.Run("explicit topic", func(t *testing.T) {
+		rt, err := CreateRetryTopic("orders", "1m", "orders-slow")
+		require.NoError(t, err)
+		assert.Equal(t, RetryTopic{Topic: "orders-slow", Delay: time.Minute}, rt)
+	})
+
+	t.Run("invalid delay", func(t *testing.T) {
+		_, err := CreateRetryTopic("orders", "soon", "")
+		require.Error(t, err)
+		_, err = CreateRetryTopic("orders", "0s", "")
+		require.Error(t, err)
+	})
+}
+
+func TestValidateRetryTopics(t *testing.T) {
+	require.NoError(t, ValidateRetryTopics("orders", []RetryTopic{{Topic: "orders-retry-10s"}, {Topic: "orders-retry-1m"}}))
+	require.Error(t, ValidateRetryTopics("orders", []RetryTopic{{Topic: "orders"}}))
+	require.Error(t, ValidateRetryTopics("orders", []RetryTopic{{Topic: "orders-slow"}, {Topic: "orders-slow"}}))
+}
+
+func TestWithRetryAttempt(t *testing.T) {
+	md := map[string]string{"a": "b", MetadataDeliverAt: "2025-01-01T00:00:00Z"}
+	got := WithRetryAttempt(md, 2, time.Minute)
+	assert.Equal(t, map[string]string{
+		"a":                   "b",
+		MetadataDeliverAfter:  "1m0s",
+		RetryAttemptExtension: "2",
+	}, got)
+	assert.Len(t, md, 2)
+}
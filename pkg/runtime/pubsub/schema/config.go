@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	kitstrings "github.com/dapr/kit/strings"
+)
+
+const (
+	// MetadataStore is the metadata key of the state or configuration store
+	// which holds the schemas of the topics' payloads. On a pub/sub component
+	// it enables validation on publish, and on a subscription validation on
+	// subscribe.
+	MetadataStore = "schemaStore"
+	// MetadataFormat is the metadata key of the format of the schemas, "json"
+	// (JSON Schema, the default), "avro" or "protobuf" (a .proto file whose
+	// first message describes the payload).
+	MetadataFormat = "schemaFormat"
+	// MetadataKeyPrefix is the metadata key of the prefix of the keys under
+	// which the schemas are stored. The key of a topic's schema is the prefix
+	// followed by the topic name.
+	MetadataKeyPrefix = "schemaKeyPrefix"
+	// MetadataValidate is the subscription metadata key which enables
+	// validation on subscribe using the schema configuration of the pub/sub
+	// component.
+	MetadataValidate = "validateSchema"
+)
+
+// Format is the format of a payload schema.
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatAvro     Format = "avro"
+	FormatProtobuf Format = "protobuf"
+)
+
+// Config is the configuration of the schema validation of payloads.
+type Config struct {
+	Store     string
+	Format    Format
+	KeyPrefix string
+}
+
+// Key returns the key under which the schema of the topic is stored.
+func (c *Config) Key(topic string) string {
+	return c.KeyPrefix + topic
+}
+
+// ConfigFromMetadata returns the schema configuration of the metadata, or nil
+// if no schema store is configured.
+func ConfigFromMetadata(metadata map[string]string) (*Config, error) {
+	store := metadata[MetadataStore]
+	if store == "" {
+		return nil, nil
+	}
+
+	cfg := &Config{
+		Store:     store,
+		Format:    FormatJSON,
+		KeyPrefix: metadata[MetadataKeyPrefix],
+	}
+	if f, ok := metadata[MetadataFormat]; ok {
+		switch Format(f) {
+		case FormatJSON, FormatAvro, FormatProtobuf:
+			cfg.Format = Format(f)
+		default:
+			return nil, fmt.Errorf("invalid %s '%s': must be one of %s, %s, %s", MetadataFormat, f, FormatJSON, FormatAvro, FormatProtobuf)
+		}
+	}
+
+	return cfg, nil
+}
+
+// SubscriptionConfig returns the schema configuration used to validate the
+// messages of a subscription, or nil if the subscription doesn't validate
+// messages. A subscription may declare its own schema store, or reuse the
+// configuration of the pub/sub component, which is nil if it has none.
+func SubscriptionConfig(metadata map[string]string, component *Config) (*Config, error) {
+	cfg, err := ConfigFromMetadata(metadata)
+	if err != nil || cfg != nil {
+		return cfg, err
+	}
+
+	if !kitstrings.IsTruthy(metadata[MetadataValidate]) {
+		return nil, nil
+	}
+	if component == nil {
+		return nil, fmt.Errorf("%s is set but the pub/sub component has no %s", MetadataValidate, MetadataStore)
+	}
+	return component, nil
+}
+
+// ValidationError is returned when a payload doesn't match the schema of its
+// topic.
+type ValidationError struct {
+	Topic string
+	Err   error
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("payload of topic '%s' does not match its schema: %s", e.Topic, e.Err)
+}
+
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
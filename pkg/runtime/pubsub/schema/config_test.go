@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromMetadata(t *testing.T) {
+	cfg, err := ConfigFromMetadata(map[string]string{})
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = ConfigFromMetadata(map[string]string{MetadataStore: "schemas"})
+	require.NoError(t, err)
+	assert.Equal(t, &Config{Store: "schemas", Format: FormatJSON}, cfg)
+	assert.Equal(t, "orders", cfg.Key("orders"))
+
+	cfg, err = ConfigFromMetadata(map[string]string{
+		MetadataStore:     "schemas",
+		MetadataFormat:    "avro",
+		MetadataKeyPrefix: "schema-",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, FormatAvro, cfg.Format)
+	assert.Equal(t, "schema-orders", cfg.Key("orders"))
+
+	cfg, err = ConfigFromMetadata(map[string]string{MetadataStore: "schemas", MetadataFormat: "protobuf"})
+	require.NoError(t, err)
+	assert.Equal(t, FormatProtobuf, cfg.Format)
+
+	_, err = ConfigFromMetadata(map[string]string{MetadataStore: "schemas", MetadataFormat: "xml"})
+	require.Error(t, err)
+}
+
+func TestSubscriptionConfig(t *testing.T) {
+	componentCfg := &Config{Store: "component", Format: FormatJSON}
+
+	cfg, err := SubscriptionConfig(map[string]string{}, componentCfg)
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = SubscriptionConfig(map[string]string{MetadataValidate: "true"}, componentCfg)
+	require.NoError(t, err)
+	assert.Same(t, componentCfg, cfg)
+
+	cfg, err = SubscriptionConfig(map[string]string{MetadataStore: "subscription"}, componentCfg)
+	require.NoError(t, err)
+	assert.Equal(t, "subscription", cfg.Store)
+
+	_, err = SubscriptionConfig(map[string]string{MetadataValidate: "true"}, nil)
+	require.Error(t, err)
+}
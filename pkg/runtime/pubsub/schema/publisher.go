@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	contribmetadata "github.com/dapr/components-contrib/metadata"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+)
+
+// adapter publishes messages to the pub/sub components.
+type adapter interface {
+	Publish(context.Context, *contribpubsub.PublishRequest) error
+	BulkPublish(context.Context, *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error)
+}
+
+// GetConfigFn returns the schema configuration of the pub/sub component with
+// the given name, or nil if its payloads aren't validated.
+type GetConfigFn func(pubsubName string) *Config
+
+type PublisherOptions struct {
+	Adapter     adapter
+	Registry    *Registry
+	GetConfigFn GetConfigFn
+}
+
+// Publisher validates the messages published by the app against the schemas
+// of their topics before publishing them with the adapter. The runtime
+// publishes its own messages, such as retries, dead letters and delayed
+// messages, with the adapter directly, so they are not validated again.
+type Publisher struct {
+	adapter     adapter
+	registry    *Registry
+	getConfigFn GetConfigFn
+}
+
+func NewPublisher(opts PublisherOptions) *Publisher {
+	return &Publisher{
+		adapter:     opts.Adapter,
+		registry:    opts.Registry,
+		getConfigFn: opts.GetConfigFn,
+	}
+}
+
+// Publish validates the message against the schema of the topic and
+// publishes it. CloudEvents are published with a reference to the schema.
+func (p *Publisher) Publish(ctx context.Context, req *contribpubsub.PublishRequest) error {
+	if cfg := p.getConfigFn(req.PubsubName); cfg != nil {
+		data, err := p.validate(ctx, cfg, req.Topic, req.Data, req.Metadata)
+		if err != nil {
+			return err
+		}
+		req.Data = data
+	}
+
+	return p.adapter.Publish(ctx, req)
+}
+
+// BulkPublish validates the entries against the schema of the topic and
+// publishes the valid ones. Invalid entries are returned as failed entries.
+func (p *Publisher) BulkPublish(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+	cfg := p.getConfigFn(req.PubsubName)
+	if cfg == nil {
+		return p.adapter.BulkPublish(ctx, req)
+	}
+
+	var invalid []contribpubsub.BulkPublishResponseFailedEntry
+	entries := make([]contribpubsub.BulkMessageEntry, 0, len(req.Entries))
+	for _, entry := range req.Entries {
+		metadata := maps.Clone(req.Metadata)
+		if metadata == nil {
+			metadata = make(map[string]string, len(entry.Metadata))
+		}
+		maps.Copy(metadata, entry.Metadata)
+
+		data, err := p.validate(ctx, cfg, req.Topic, entry.Event, metadata)
+		if err != nil {
+			invalid = append(invalid, contribpubsub.BulkPublishResponseFailedEntry{
+				EntryId: entry.EntryId,
+				Error:   err,
+			})
+			continue
+		}
+		entry.Event = data
+		entries = append(entries, entry)
+	}
+
+	var res contribpubsub.BulkPublishResponse
+	var err error
+	if len(entries) > 0 {
+		res, err = p.adapter.BulkPublish(ctx, &contribpubsub.BulkPublishRequest{
+			Entries:    entries,
+			PubsubName: req.PubsubName,
+			Topic:      req.Topic,
+			Metadata:   req.Metadata,
+		})
+	}
+	if len(invalid) == 0 {
+		return res, err
+	}
+
+	res.FailedEntries = append(res.FailedEntries, invalid...)
+	errs := make([]error, 0, len(invalid)+1)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, f := range invalid {
+		errs = append(errs, f.Error)
+	}
+	return res, errors.Join(errs...)
+}
+
+// validate validates the published message against the schema of the topic,
+// returning the message to publish.
+func (p *Publisher) validate(ctx context.Context, cfg *Config, topic string, data []byte, metadata map[string]string) ([]byte, error) {
+	rawPayload, err := contribmetadata.IsRawPayload(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.registry.ValidateMessage(ctx, cfg, topic, data, rawPayload)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	publisherfake "github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+)
+
+func TestPublisher(t *testing.T) {
+	r, _ := newTestRegistry(t, map[string]string{"orders": `{"type": "object", "required": ["orderId"]}`})
+
+	var published *contribpubsub.PublishRequest
+	var bulkPublished *contribpubsub.BulkPublishRequest
+	adapter := publisherfake.New().
+		WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			published = req
+			return nil
+		}).
+		WithBulkPublishFn(func(_ context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+			bulkPublished = req
+			return contribpubsub.BulkPublishResponse{}, nil
+		})
+
+	p := NewPublisher(PublisherOptions{
+		Adapter:  adapter,
+		Registry: r,
+		GetConfigFn: func(pubsubName string) *Config {
+			if pubsubName != "validated" {
+				return nil
+			}
+			return &Config{Store: "schemas", Format: FormatJSON}
+		},
+	})
+
+	t.Run("valid payload is published with its schema", func(t *testing.T) {
+		err := p.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "validated",
+			Topic:      "orders",
+			Data:       []byte(`{"specversion":"1.0","id":"1","data":{"orderId":1}}`),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.Contains(t, string(published.Data), `"dataschema":"dapr://schemas/orders"`)
+	})
+
+	t.Run("invalid payload is rejected", func(t *testing.T) {
+		published = nil
+		err := p.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "validated",
+			Topic:      "orders",
+			Data:       []byte(`{"specversion":"1.0","id":"1","data":{"id":1}}`),
+		})
+		require.ErrorAs(t, err, &ValidationError{})
+		assert.Nil(t, published)
+	})
+
+	t.Run("payload of a pubsub without schema is not validated", func(t *testing.T) {
+		published = nil
+		err := p.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "other",
+			Topic:      "orders",
+			Data:       []byte(`{"id":1}`),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.JSONEq(t, `{"id":1}`, string(published.Data))
+	})
+
+	t.Run("bulk publish fails invalid entries", func(t *testing.T) {
+		res, err := p.BulkPublish(t.Context(), &contribpubsub.BulkPublishRequest{
+			PubsubName: "validated",
+			Topic:      "orders",
+			Metadata:   map[string]string{"rawPayload": "true"},
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte(`{"orderId":1}`), ContentType: "application/json"},
+				{EntryId: "2", Event: []byte(`{"id":2}`), ContentType: "application/json"},
+			},
+		})
+		require.ErrorAs(t, err, &ValidationError{})
+		require.Len(t, res.FailedEntries, 1)
+		assert.Equal(t, "2", res.FailedEntries[0].EntryId)
+		require.NotNil(t, bulkPublished)
+		require.Len(t, bulkPublished.Entries, 1)
+		assert.Equal(t, "1", bulkPublished.Entries[0].EntryId)
+	})
+
+	t.Run("bulk publish of only invalid entries publishes nothing", func(t *testing.T) {
+		bulkPublished = nil
+		res, err := p.BulkPublish(t.Context(), &contribpubsub.BulkPublishRequest{
+			PubsubName: "validated",
+			Topic:      "orders",
+			Metadata:   map[string]string{"rawPayload": "true"},
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte(`{"id":1}`), ContentType: "application/json"},
+			},
+		})
+		require.ErrorAs(t, err, &ValidationError{})
+		require.Len(t, res.FailedEntries, 1)
+		assert.Nil(t, bulkPublished)
+	})
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/linkedin/goavro/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protorange"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/configuration"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+)
+
+// DataSchemaField is the CloudEvent attribute which references the schema of
+// the event data.
+const DataSchemaField = "dataschema"
+
+// cacheTTL is how long schemas are cached for before being fetched again.
+const cacheTTL = time.Minute
+
+// Stores gets the state and configuration stores which hold the schemas.
+type Stores interface {
+	GetStateStore(name string) (state.Store, bool)
+	GetConfiguration(name string) (configuration.Store, bool)
+}
+
+// Registry fetches, compiles and caches the schemas of topics' payloads from
+// state or configuration stores.
+type Registry struct {
+	stores Stores
+	clock  clock.Clock
+
+	lock  sync.Mutex
+	cache map[string]*entry
+}
+
+type entry struct {
+	// validate is nil if the topic has no schema.
+	validate func(payload []byte) error
+	uri      string
+	expires  time.Time
+}
+
+func NewRegistry(stores Stores) *Registry {
+	return &Registry{
+		stores: stores,
+		clock:  clock.RealClock{},
+		cache:  make(map[string]*entry),
+	}
+}
+
+// Validate validates the payload against the schema of the topic. It returns
+// the URI of the schema, or an empty string if the topic has no schema.
+// Payloads which don't match the schema return a ValidationError.
+func (r *Registry) Validate(ctx context.Context, cfg *Config, topic string, payload []byte) (string, error) {
+	e, err := r.load(ctx, cfg, topic)
+	if err != nil {
+		return "", err
+	}
+	if e.validate == nil {
+		return "", nil
+	}
+	if err := e.validate(payload); err != nil {
+		return "", ValidationError{Topic: topic, Err: err}
+	}
+	return e.uri, nil
+}
+
+// ValidateCloudEvent validates the data of the CloudEvent against the schema
+// of the topic, and sets the dataschema attribute of the event if it isn't
+// set already.
+func (r *Registry) ValidateCloudEvent(ctx context.Context, cfg *Config, topic string, cloudEvent map[string]any) error {
+	payload, err := Payload(cloudEvent)
+	if err != nil {
+		return ValidationError{Topic: topic, Err: err}
+	}
+	uri, err := r.Validate(ctx, cfg, topic, payload)
+	if err != nil {
+		return err
+	}
+	if _, ok := cloudEvent[DataSchemaField]; !ok && uri != "" {
+		cloudEvent[DataSchemaField] = uri
+	}
+	return nil
+}
+
+// ValidateMessage validates a message as published to the broker: either a
+// CloudEvent, whose data is validated, or a raw payload. It returns the
+// message to publish, which references the schema if it's a CloudEvent.
+func (r *Registry) ValidateMessage(ctx context.Context, cfg *Config, topic string, data []byte, rawPayload bool) ([]byte, error) {
+	var cloudEvent map[string]any
+	if rawPayload || json.Unmarshal(data, &cloudEvent) != nil || cloudEvent[contribpubsub.SpecVersionField] == nil {
+		_, err := r.Validate(ctx, cfg, topic, data)
+		return data, err
+	}
+
+	_, hasDataSchema := cloudEvent[DataSchemaField]
+	if err := r.ValidateCloudEvent(ctx, cfg, topic, cloudEvent); err != nil {
+		return nil, err
+	}
+	if _, ok := cloudEvent[DataSchemaField]; hasDataSchema || !ok {
+		return data, nil
+	}
+	return json.Marshal(cloudEvent)
+}
+
+// Payload returns the data of the CloudEvent to validate.
+func Payload(cloudEvent map[string]any) ([]byte, error) {
+	if b64, ok := cloudEvent[contribpubsub.DataBase64Field].(string); ok {
+		return base64.StdEncoding.DecodeString(b64)
+	}
+	return json.Marshal(cloudEvent[contribpubsub.DataField])
+}
+
+func (r *Registry) load(ctx context.Context, cfg *Config, topic string) (*entry, error) {
+	key := cfg.Key(topic)
+	cacheKey := cfg.Store + "||" + string(cfg.Format) + "||" + key
+
+	r.lock.Lock()
+	e, ok := r.cache[cacheKey]
+	r.lock.Unlock()
+	if ok && r.clock.Now().Before(e.expires) {
+		return e, nil
+	}
+
+	doc, found, err := r.fetch(ctx, cfg.Store, key)
+	if err != nil {
+		return nil, fmt.Errorf("error getting schema of topic %s from store %s: %w", topic, cfg.Store, err)
+	}
+
+	e = &entry{expires: r.clock.Now().Add(cacheTTL)}
+	if found {
+		e.uri = "dapr://" + url.PathEscape(cfg.Store) + "/" + url.PathEscape(key)
+		switch cfg.Format {
+		case FormatAvro:
+			e.validate, err = compileAvro(doc)
+		case FormatProtobuf:
+			e.validate, err = compileProtobuf(doc)
+		default:
+			e.validate, err = compileJSON(e.uri, doc)
+			if id := jsonSchemaID(doc); id != "" {
+				e.uri = id
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema of topic %s in store %s: %w", topic, cfg.Store, err)
+		}
+	}
+
+	r.lock.Lock()
+	r.cache[cacheKey] = e
+	r.lock.Unlock()
+
+	return e, nil
+}
+
+// fetch gets the schema document from the state or configuration store.
+func (r *Registry) fetch(ctx context.Context, storeName, key string) ([]byte, bool, error) {
+	if store, ok := r.stores.GetStateStore(storeName); ok {
+		res, err := store.Get(ctx, &state.GetRequest{Key: key})
+		if err != nil {
+			return nil, false, err
+		}
+		if res == nil || len(res.Data) == 0 {
+			return nil, false, nil
+		}
+		return unquote(res.Data), true, nil
+	}
+
+	if store, ok := r.stores.GetConfiguration(storeName); ok {
+		res, err := store.Get(ctx, &configuration.GetRequest{Keys: []string{key}})
+		if err != nil {
+			return nil, false, err
+		}
+		if res == nil || res.Items[key] == nil || res.Items[key].Value == "" {
+			return nil, false, nil
+		}
+		return []byte(res.Items[key].Value), true, nil
+	}
+
+	return nil, false, errors.New("store not found")
+}
+
+// unquote returns the document of a schema which was saved as a JSON string.
+func unquote(doc []byte) []byte {
+	var s string
+	if bytes.HasPrefix(doc, []byte{'"'}) && json.Unmarshal(doc, &s) == nil {
+		return []byte(s)
+	}
+	return doc
+}
+
+func jsonSchemaID(doc []byte) string {
+	var s struct {
+		ID string `json:"$id"`
+	}
+	_ = json.Unmarshal(doc, &s)
+	return s.ID
+}
+
+func compileJSON(uri string, doc []byte) (func([]byte) error, error) {
+	c := jsonschema.NewCompiler()
+	// Schemas can only reference themselves: loading external documents would
+	// let a schema read local files or make network requests.
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external schema reference %s is not allowed", s)
+	}
+	if err := c.AddResource(uri, bytes.NewReader(doc)); err != nil {
+		return nil, err
+	}
+	s, err := c.Compile(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(payload []byte) error {
+		dec := json.NewDecoder(bytes.NewReader(payload))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("payload is not valid JSON: %w", err)
+		}
+		return s.Validate(v)
+	}, nil
+}
+
+func compileAvro(doc []byte) (func([]byte) error, error) {
+	codec, err := goavro.NewCodecForStandardJSONFull(string(doc))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(payload []byte) error {
+		var rest []byte
+		var err error
+		if json.Valid(payload) {
+			_, rest, err = codec.NativeFromTextual(payload)
+		} else {
+			_, rest, err = codec.NativeFromBinary(payload)
+		}
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(rest)) > 0 {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}, nil
+}
+
+// compileProtobuf compiles a .proto file. Payloads are validated against the
+// first message declared in the file, in either the binary or the JSON
+// encoding. The file can only import the well-known types.
+func compileProtobuf(doc []byte) (func([]byte) error, error) {
+	const filename = "schema.proto"
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{filename: string(doc)}),
+	}
+	fds, err := parser.ParseFiles(filename)
+	if err != nil {
+		return nil, err
+	}
+	msgs := fds[0].GetMessageTypes()
+	if len(msgs) == 0 {
+		return nil, errors.New("schema declares no message")
+	}
+	md := msgs[0].UnwrapMessage()
+
+	return func(payload []byte) error {
+		msg := dynamicpb.NewMessage(md)
+		if json.Valid(payload) {
+			// protojson rejects unknown fields.
+			return protojson.Unmarshal(payload, msg)
+		}
+		if err := proto.Unmarshal(payload, msg); err != nil {
+			return err
+		}
+		return checkUnknownFields(msg)
+	}, nil
+}
+
+// checkUnknownFields returns an error if the message, or any message it
+// contains, has fields which aren't declared in the schema.
+func checkUnknownFields(msg protoreflect.Message) error {
+	return protorange.Range(msg, func(v protopath.Values) error {
+		m, ok := v.Index(-1).Value.Interface().(protoreflect.Message)
+		if ok && len(m.GetUnknown()) > 0 {
+			return fmt.Errorf("unknown fields in %s", m.Descriptor().FullName())
+		}
+		return nil
+	})
+}
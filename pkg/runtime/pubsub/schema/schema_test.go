@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/kit/logger"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"properties": {"orderId": {"type": "integer"}},
+	"required": ["orderId"]
+}`
+
+const orderAvroSchema = `{
+	"type": "record",
+	"name": "Order",
+	"fields": [{"name": "orderId", "type": "int"}]
+}`
+
+const orderProtoSchema = `syntax = "proto3";
+package orders;
+import "google/protobuf/timestamp.proto";
+message Order {
+	int32 order_id = 1;
+	google.protobuf.Timestamp created = 2;
+}
+message Unused {}`
+
+func newTestRegistry(t *testing.T, schemas map[string]string) (*Registry, state.Store) {
+	t.Helper()
+
+	store := inmemory.NewInMemoryStateStore(logger.NewLogger("test"))
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+	for k, v := range schemas {
+		require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: k, Value: []byte(v)}))
+	}
+
+	return NewRegistry(stateStores{"schemas": store}), store
+}
+
+// stateStores holds the state stores of the schemas by name.
+type stateStores map[string]state.Store
+
+func (s stateStores) GetStateStore(name string) (state.Store, bool) {
+	store, ok := s[name]
+	return store, ok
+}
+
+func (s stateStores) GetConfiguration(string) (configuration.Store, bool) {
+	return nil, false
+}
+
+func TestValidate(t *testing.T) {
+	r, _ := newTestRegistry(t, map[string]string{
+		"orders":        orderSchema,
+		"avro-orders":   orderAvroSchema,
+		"proto-orders":  orderProtoSchema,
+		"file-ref":      `{"$ref": "file:///etc/passwd"}`,
+		"http-ref":      `{"$ref": "https://example.com/order.json"}`,
+		"proto-invalid": `message {`,
+	})
+	cfg := &Config{Store: "schemas", Format: FormatJSON}
+
+	t.Run("valid payload", func(t *testing.T) {
+		uri, err := r.Validate(t.Context(), cfg, "orders", []byte(`{"orderId": 1}`))
+		require.NoError(t, err)
+		assert.Equal(t, "dapr://schemas/orders", uri)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := r.Validate(t.Context(), cfg, "orders", []byte(`{"orderId": "one"}`))
+		require.ErrorAs(t, err, &ValidationError{})
+
+		_, err = r.Validate(t.Context(), cfg, "orders", []byte(`not json`))
+		require.ErrorAs(t, err, &ValidationError{})
+	})
+
+	t.Run("topic without schema", func(t *testing.T) {
+		uri, err := r.Validate(t.Context(), cfg, "payments", []byte(`anything`))
+		require.NoError(t, err)
+		assert.Empty(t, uri)
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		_, err := r.Validate(t.Context(), &Config{Store: "notfound"}, "orders", []byte(`{}`))
+		require.Error(t, err)
+		require.NotErrorAs(t, err, &ValidationError{})
+	})
+
+	t.Run("avro", func(t *testing.T) {
+		avroCfg := &Config{Store: "schemas", Format: FormatAvro, KeyPrefix: "avro-"}
+		_, err := r.Validate(t.Context(), avroCfg, "orders", []byte(`{"orderId": 1}`))
+		require.NoError(t, err)
+		_, err = r.Validate(t.Context(), avroCfg, "orders", []byte(`{"orderId": "one"}`))
+		require.ErrorAs(t, err, &ValidationError{})
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		protoCfg := &Config{Store: "schemas", Format: FormatProtobuf, KeyPrefix: "proto-"}
+		_, err := r.Validate(t.Context(), protoCfg, "orders", []byte(`{"orderId": 1, "created": "2025-01-01T00:00:00Z"}`))
+		require.NoError(t, err)
+		// order_id = 5 in the binary encoding.
+		_, err = r.Validate(t.Context(), protoCfg, "orders", []byte{0x08, 0x05})
+		require.NoError(t, err)
+
+		_, err = r.Validate(t.Context(), protoCfg, "orders", []byte(`{"orderId": "one"}`))
+		require.ErrorAs(t, err, &ValidationError{})
+		_, err = r.Validate(t.Context(), protoCfg, "orders", []byte(`{"id": 1}`))
+		require.ErrorAs(t, err, &ValidationError{})
+		// Field 9, which isn't declared, in the binary encoding.
+		_, err = r.Validate(t.Context(), protoCfg, "orders", []byte{0x48, 0x01})
+		require.ErrorAs(t, err, &ValidationError{})
+
+		_, err = r.Validate(t.Context(), protoCfg, "invalid", []byte(`{}`))
+		require.Error(t, err)
+		require.NotErrorAs(t, err, &ValidationError{})
+	})
+
+	t.Run("external references", func(t *testing.T) {
+		for _, topic := range []string{"file-ref", "http-ref"} {
+			_, err := r.Validate(t.Context(), cfg, topic, []byte(`{}`))
+			require.ErrorContains(t, err, "external schema reference")
+		}
+	})
+}
+
+func TestValidateMessage(t *testing.T) {
+	r, _ := newTestRegistry(t, map[string]string{
+		"orders": `{"$id": "https://example.com/order.json", "type": "object", "required": ["orderId"]}`,
+	})
+	cfg := &Config{Store: "schemas", Format: FormatJSON}
+
+	t.Run("cloud event", func(t *testing.T) {
+		data, err := r.ValidateMessage(t.Context(), cfg, "orders", []byte(`{"specversion":"1.0","id":"1","data":{"orderId":1}}`), false)
+		require.NoError(t, err)
+
+		var ce map[string]any
+		require.NoError(t, json.Unmarshal(data, &ce))
+		assert.Equal(t, "https://example.com/order.json", ce[DataSchemaField])
+
+		_, err = r.ValidateMessage(t.Context(), cfg, "orders", []byte(`{"specversion":"1.0","id":"1","data":{"id":1}}`), false)
+		require.ErrorAs(t, err, &ValidationError{})
+	})
+
+	t.Run("cloud event with existing dataschema", func(t *testing.T) {
+		in := []byte(`{"specversion":"1.0","id":"1","dataschema":"custom","data":{"orderId":1}}`)
+		data, err := r.ValidateMessage(t.Context(), cfg, "orders", in, false)
+		require.NoError(t, err)
+		assert.Equal(t, in, data)
+	})
+
+	t.Run("raw payload", func(t *testing.T) {
+		in := []byte(`{"orderId":1}`)
+		data, err := r.ValidateMessage(t.Context(), cfg, "orders", in, true)
+		require.NoError(t, err)
+		assert.Equal(t, in, data)
+
+		_, err = r.ValidateMessage(t.Context(), cfg, "orders", []byte(`{}`), true)
+		require.ErrorAs(t, err, &ValidationError{})
+	})
+}
+
+func TestCache(t *testing.T) {
+	r, store := newTestRegistry(t, map[string]string{"orders": orderSchema})
+	clock := clocktesting.NewFakeClock(time.Now())
+	r.clock = clock
+	cfg := &Config{Store: "schemas", Format: FormatJSON}
+
+	_, err := r.Validate(t.Context(), cfg, "orders", []byte(`{"orderId": 1}`))
+	require.NoError(t, err)
+
+	// Schemas saved as JSON strings are supported too.
+	b, err := json.Marshal(`{"type": "object", "required": ["customerId"]}`)
+	require.NoError(t, err)
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{Key: "orders", Value: b}))
+
+	_, err = r.Validate(t.Context(), cfg, "orders", []byte(`{"orderId": 1}`))
+	require.NoError(t, err)
+
+	clock.Step(cacheTTL)
+	_, err = r.Validate(t.Context(), cfg, "orders", []byte(`{"orderId": 1}`))
+	require.ErrorAs(t, err, &ValidationError{})
+}
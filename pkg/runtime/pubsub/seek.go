@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+
+	contribPubsub "github.com/dapr/components-contrib/pubsub"
+	comppubsub "github.com/dapr/dapr/pkg/components/pubsub"
+)
+
+// CanSeek returns true if the component advertises the seek feature and
+// implements Seeker.
+func CanSeek(component contribPubsub.PubSub) bool {
+	_, ok := component.(comppubsub.Seeker)
+	return ok && comppubsub.FeatureSeek.IsPresent(component.Features())
+}
+
+// Seek resets the position of the subscription of the component, or returns a
+// SeekNotSupportedError if the component doesn't support seeking.
+func Seek(ctx context.Context, pubsubName string, component contribPubsub.PubSub, req *comppubsub.SeekRequest) error {
+	if !CanSeek(component) {
+		return SeekNotSupportedError{PubsubName: pubsubName}
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	return component.(comppubsub.Seeker).Seek(ctx, req)
+}
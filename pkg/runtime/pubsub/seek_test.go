@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribPubsub "github.com/dapr/components-contrib/pubsub"
+	comppubsub "github.com/dapr/dapr/pkg/components/pubsub"
+)
+
+type seekerPubsub struct {
+	contribPubsub.PubSub
+
+	features []contribPubsub.Feature
+	req      *comppubsub.SeekRequest
+}
+
+func (s *seekerPubsub) Features() []contribPubsub.Feature {
+	return s.features
+}
+
+func (s *seekerPubsub) Seek(_ context.Context, req *comppubsub.SeekRequest) error {
+	s.req = req
+	return nil
+}
+
+func TestSeek(t *testing.T) {
+	t.Run("component does not advertise the feature", func(t *testing.T) {
+		ps := &seekerPubsub{}
+		err := Seek(t.Context(), "mypubsub", ps, &comppubsub.SeekRequest{Offset: "1"})
+		require.ErrorAs(t, err, &SeekNotSupportedError{})
+		assert.Nil(t, ps.req)
+	})
+
+	t.Run("component does not implement Seeker", func(t *testing.T) {
+		ps := &struct{ contribPubsub.PubSub }{&seekerPubsub{features: []contribPubsub.Feature{comppubsub.FeatureSeek}}}
+		assert.False(t, CanSeek(ps))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		ps := &seekerPubsub{features: []contribPubsub.Feature{comppubsub.FeatureSeek}}
+		require.Error(t, Seek(t.Context(), "mypubsub", ps, &comppubsub.SeekRequest{}))
+		now := time.Now()
+		require.Error(t, Seek(t.Context(), "mypubsub", ps, &comppubsub.SeekRequest{Timestamp: &now, Offset: "1"}))
+		assert.Nil(t, ps.req)
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		ps := &seekerPubsub{features: []contribPubsub.Feature{comppubsub.FeatureSeek}}
+		req := &comppubsub.SeekRequest{Topic: "topic", Offset: "1"}
+		require.NoError(t, Seek(t.Context(), "mypubsub", ps, req))
+		assert.Same(t, req, ps.req)
+	})
+}
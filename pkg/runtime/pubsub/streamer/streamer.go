@@ -182,6 +182,10 @@ func (s *streamer) Publish(ctx context.Context, msg *rtpubsub.SubscribedMessage)
 	if err != nil {
 		return nil, err
 	}
+	// Streamed events can't carry per message gRPC headers, so the metadata,
+	// including the headers injected by the transformation, is sent with the
+	// event.
+	envelope.Metadata = msg.Metadata
 
 	ch, cleanup := connection.registerPublishResponse(envelope.GetId())
 	if ch == nil {
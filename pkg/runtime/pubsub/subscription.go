@@ -10,6 +10,24 @@ type Subscription struct {
 	Rules           []*Rule           `json:"rules,omitempty"`
 	Scopes          []string          `json:"scopes"`
 	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	RetryTopics     []RetryTopic      `json:"retryTopics,omitempty"`
+	Limits          Limits            `json:"limits,omitempty"`
+	Transform       *Transform        `json:"transform,omitempty"`
+	Ordering        *Ordering         `json:"ordering,omitempty"`
+}
+
+// Ordering configures the delivery of messages with the same ordering key
+// in the order they were received.
+type Ordering struct {
+	Key      string `json:"key"`
+	MaxQueue int32  `json:"maxQueue,omitempty"`
+}
+
+// Limits bounds how fast messages of a subscription are delivered to the app.
+// A zero value means no limit.
+type Limits struct {
+	MaxConcurrency       int32 `json:"maxConcurrency,omitempty"`
+	MaxMessagesPerSecond int32 `json:"maxMessagesPerSecond,omitempty"`
 }
 
 type BulkSubscribe struct {
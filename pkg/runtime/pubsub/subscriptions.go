@@ -69,6 +69,17 @@ type (
 		Route           string            `json:"route"`  // Single route from v1alpha1
 		Routes          RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
 		BulkSubscribe   BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+
+		MaxConcurrency       int32          `json:"maxConcurrency,omitempty"`
+		MaxMessagesPerSecond int32          `json:"maxMessagesPerSecond,omitempty"`
+		Transform            *TransformJSON `json:"transform,omitempty"`
+		Ordering             *Ordering      `json:"ordering,omitempty"`
+	}
+
+	TransformJSON struct {
+		Unwrap  string            `json:"unwrap,omitempty"`
+		Fields  map[string]string `json:"fields,omitempty"`
+		Headers map[string]string `json:"headers,omitempty"`
 	}
 
 	RoutesJSON struct {
@@ -176,6 +187,13 @@ func GetSubscriptionsHTTP(ctx context.Context, channel channel.AppChannel, log l
 				MaxMessagesCount:   si.BulkSubscribe.MaxMessagesCount,
 				MaxAwaitDurationMs: si.BulkSubscribe.MaxAwaitDurationMs,
 			}
+			var transform *Transform
+			if si.Transform != nil {
+				transform, err = CreateTransform(si.Transform.Unwrap, si.Transform.Fields, si.Transform.Headers)
+				if err != nil {
+					return nil, err
+				}
+			}
 			subscriptions[i] = Subscription{
 				PubsubName:      si.PubsubName,
 				Topic:           si.Topic,
@@ -183,6 +201,12 @@ func GetSubscriptionsHTTP(ctx context.Context, channel channel.AppChannel, log l
 				DeadLetterTopic: si.DeadLetterTopic,
 				Rules:           rules[:n],
 				BulkSubscribe:   bulkSubscribe,
+				Limits: Limits{
+					MaxConcurrency:       si.MaxConcurrency,
+					MaxMessagesPerSecond: si.MaxMessagesPerSecond,
+				},
+				Transform: transform,
+				Ordering:  si.Ordering,
 			}
 		}
 
@@ -253,6 +277,10 @@ func GetSubscriptionsGRPC(ctx context.Context, channel runtimev1pb.AppCallbackCl
 					MaxAwaitDurationMs: s.GetBulkSubscribe().GetMaxAwaitDurationMs(),
 				}
 			}
+			transform, err := CreateTransform(s.GetTransform().GetUnwrap(), s.GetTransform().GetFields(), s.GetTransform().GetHeaders())
+			if err != nil {
+				return nil, err
+			}
 			subscriptions[i] = Subscription{
 				PubsubName:      s.GetPubsubName(),
 				Topic:           s.GetTopic(),
@@ -260,6 +288,7 @@ func GetSubscriptionsGRPC(ctx context.Context, channel runtimev1pb.AppCallbackCl
 				DeadLetterTopic: s.GetDeadLetterTopic(),
 				Rules:           rules,
 				BulkSubscribe:   bulkSubscribe,
+				Transform:       transform,
 			}
 		}
 	}
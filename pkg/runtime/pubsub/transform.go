@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	contribPubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/expr"
+)
+
+// Transform reshapes the events of a subscription before they are delivered
+// to the app. Expressions are evaluated with the variables "event", the cloud
+// event, and "data", its payload. Field and header expressions see the
+// payload after unwrapping.
+type Transform struct {
+	Unwrap  *expr.Expr         `json:"unwrap,omitempty"`
+	Fields  []TransformMapping `json:"fields,omitempty"`
+	Headers []TransformMapping `json:"headers,omitempty"`
+}
+
+// TransformMapping maps the result of an expression to a field or header.
+type TransformMapping struct {
+	Name string     `json:"name"`
+	Expr *expr.Expr `json:"expr"`
+}
+
+// CreateTransform compiles the transformation of a subscription from its
+// unwrap expression and its field and header expressions, keyed by name.
+// It returns nil if there is no transformation.
+func CreateTransform(unwrap string, fields, headers map[string]string) (*Transform, error) {
+	var (
+		t   Transform
+		err error
+	)
+	if unwrap = strings.TrimSpace(unwrap); unwrap != "" {
+		t.Unwrap, err = decodeTransformExpr("unwrap", unwrap)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t.Fields, err = decodeTransformMappings("field", fields)
+	if err != nil {
+		return nil, err
+	}
+	t.Headers, err = decodeTransformMappings("header", headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Unwrap == nil && len(t.Fields) == 0 && len(t.Headers) == 0 {
+		return nil, nil
+	}
+	return &t, nil
+}
+
+func decodeTransformMappings(kind string, mappings map[string]string) ([]TransformMapping, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	res := make([]TransformMapping, 0, len(mappings))
+	// Sort the names so fields are always set in the same order.
+	for _, name := range slices.Sorted(maps.Keys(mappings)) {
+		if name == "" {
+			return nil, fmt.Errorf("invalid transform %s: missing name", kind)
+		}
+		e, err := decodeTransformExpr(kind+" "+name, strings.TrimSpace(mappings[name]))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, TransformMapping{Name: name, Expr: e})
+	}
+	return res, nil
+}
+
+func decodeTransformExpr(name, value string) (*expr.Expr, error) {
+	if value == "" {
+		return nil, fmt.Errorf("invalid transform %s: empty expression", name)
+	}
+	e := &expr.Expr{}
+	if err := e.DecodeString(value); err != nil {
+		return nil, fmt.Errorf("invalid transform %s: %w", name, err)
+	}
+	return e, nil
+}
+
+// Apply transforms the cloud event in place, and returns the metadata of the
+// message with the injected headers. The metadata passed in is not modified.
+func (t *Transform) Apply(cloudEvent map[string]any, metadata map[string]string) (map[string]string, error) {
+	data, err := transformPayload(cloudEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]any{
+		"event": cloudEvent,
+		"data":  data,
+	}
+	reshaped := false
+
+	if t.Unwrap != nil {
+		data, err = t.Unwrap.EvalJSON(vars)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate unwrap: %w", err)
+		}
+		vars["data"] = data
+		reshaped = true
+	}
+
+	if len(t.Fields) > 0 {
+		mapped := make(map[string]any, len(t.Fields))
+		for _, f := range t.Fields {
+			v, fErr := f.Expr.EvalJSON(vars)
+			if fErr != nil {
+				return nil, fmt.Errorf("failed to evaluate field %s: %w", f.Name, fErr)
+			}
+			if fErr = setTransformField(mapped, f.Name, v); fErr != nil {
+				return nil, fErr
+			}
+		}
+		data = mapped
+		reshaped = true
+	}
+
+	var md map[string]string
+	if len(t.Headers) > 0 {
+		md = make(map[string]string, len(metadata)+len(t.Headers))
+		maps.Copy(md, metadata)
+		for _, h := range t.Headers {
+			v, hErr := h.Expr.EvalJSON(vars)
+			if hErr != nil {
+				return nil, fmt.Errorf("failed to evaluate header %s: %w", h.Name, hErr)
+			}
+			md[h.Name] = transformHeaderValue(v)
+		}
+	} else {
+		md = metadata
+	}
+
+	if reshaped {
+		delete(cloudEvent, contribPubsub.DataBase64Field)
+		cloudEvent[contribPubsub.DataField] = data
+		if _, ok := data.(string); !ok {
+			cloudEvent[contribPubsub.DataContentTypeField] = "application/json"
+		}
+	}
+
+	return md, nil
+}
+
+// transformPayload returns the payload of the cloud event. Base64 payloads,
+// such as raw payloads, are decoded and parsed as JSON when possible.
+func transformPayload(cloudEvent map[string]any) (any, error) {
+	if data, ok := cloudEvent[contribPubsub.DataField]; ok {
+		return data, nil
+	}
+
+	encoded, ok := cloudEvent[contribPubsub.DataBase64Field].(string)
+	if !ok {
+		return nil, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", contribPubsub.DataBase64Field, err)
+	}
+	var data any
+	if json.Unmarshal(b, &data) != nil {
+		return string(b), nil
+	}
+	return data, nil
+}
+
+func setTransformField(obj map[string]any, name string, value any) error {
+	parts := strings.Split(name, ".")
+	for _, p := range parts[:len(parts)-1] {
+		next, ok := obj[p]
+		if !ok {
+			m := make(map[string]any)
+			obj[p] = m
+			obj = m
+			continue
+		}
+		m, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("failed to set field %s: %s is not an object", name, p)
+		}
+		obj = m
+	}
+	obj[parts[len(parts)-1]] = value
+	return nil
+}
+
+func transformHeaderValue(v any) string {
+	switch vv := v.(type) {
+	case string:
+		return vv
+	case nil:
+		return ""
+	case map[string]any, []any:
+		b, _ := json.Marshal(vv)
+		return string(b)
+	default:
+		return fmt.Sprint(vv)
+	}
+}
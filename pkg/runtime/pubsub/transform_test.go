@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransform(t *testing.T) {
+	tr, err := CreateTransform("", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, tr)
+
+	tr, err = CreateTransform("data.detail",
+		map[string]string{"b": "data.b", "a": "data.a"},
+		map[string]string{"x-src": "event.source"},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, tr)
+	assert.Equal(t, "data.detail", tr.Unwrap.String())
+	require.Len(t, tr.Fields, 2)
+	assert.Equal(t, "a", tr.Fields[0].Name)
+	assert.Equal(t, "b", tr.Fields[1].Name)
+	require.Len(t, tr.Headers, 1)
+	assert.Equal(t, "x-src", tr.Headers[0].Name)
+
+	_, err = CreateTransform("data.", nil, nil)
+	require.Error(t, err)
+	_, err = CreateTransform("", map[string]string{"a": ""}, nil)
+	require.Error(t, err)
+	_, err = CreateTransform("", nil, map[string]string{"": "event.id"})
+	require.Error(t, err)
+}
+
+func TestTransformApply(t *testing.T) {
+	newEvent := func() map[string]any {
+		return map[string]any{
+			"id":              "1",
+			"source":          "aws.events",
+			"datacontenttype": "application/json",
+			"data": map[string]any{
+				"detail-type": "OrderCreated",
+				"detail": map[string]any{
+					"orderId":  "o1",
+					"customer": map[string]any{"id": "c1"},
+					"total":    12.5,
+				},
+			},
+		}
+	}
+
+	t.Run("unwrap", func(t *testing.T) {
+		tr, err := CreateTransform("data.detail", nil, nil)
+		require.NoError(t, err)
+
+		ce := newEvent()
+		md := map[string]string{"a": "b"}
+		got, err := tr.Apply(ce, md)
+		require.NoError(t, err)
+		assert.Equal(t, md, got)
+		assert.Equal(t, map[string]any{
+			"orderId":  "o1",
+			"customer": map[string]any{"id": "c1"},
+			"total":    12.5,
+		}, ce["data"])
+	})
+
+	t.Run("field mapping and headers", func(t *testing.T) {
+		tr, err := CreateTransform("data.detail",
+			map[string]string{
+				"id":       "data.orderId",
+				"buyer.id": "data.customer.id",
+				"kind":     `event.data["detail-type"]`,
+			},
+			map[string]string{
+				"x-kind":  `event.data["detail-type"]`,
+				"x-total": "data.total",
+			},
+		)
+		require.NoError(t, err)
+
+		ce := newEvent()
+		md := map[string]string{"a": "b"}
+		got, err := tr.Apply(ce, md)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "b"}, md)
+		assert.Equal(t, map[string]string{"a": "b", "x-kind": "OrderCreated", "x-total": "12.5"}, got)
+		assert.Equal(t, map[string]any{
+			"id":    "o1",
+			"buyer": map[string]any{"id": "c1"},
+			"kind":  "OrderCreated",
+		}, ce["data"])
+	})
+
+	t.Run("raw payload", func(t *testing.T) {
+		tr, err := CreateTransform("", map[string]string{"id": "data.orderId"}, nil)
+		require.NoError(t, err)
+
+		ce := map[string]any{
+			"id":              "1",
+			"datacontenttype": "application/octet-stream",
+			"data_base64":     base64.StdEncoding.EncodeToString([]byte(`{"orderId":"o1"}`)),
+		}
+		_, err = tr.Apply(ce, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"id": "o1"}, ce["data"])
+		assert.Equal(t, "application/json", ce["datacontenttype"])
+		assert.NotContains(t, ce, "data_base64")
+	})
+
+	t.Run("evaluation error", func(t *testing.T) {
+		tr, err := CreateTransform("", map[string]string{"id": "data.missing"}, nil)
+		require.NoError(t, err)
+
+		_, err = tr.Apply(newEvent(), nil)
+		require.Error(t, err)
+	})
+}
@@ -80,9 +80,11 @@ import (
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	"github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/pubsub/streamer"
 	"github.com/dapr/dapr/pkg/runtime/registry"
 	"github.com/dapr/dapr/pkg/runtime/scheduler"
+	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/dapr/utils"
@@ -118,6 +120,7 @@ type DaprRuntime struct {
 	httpMiddleware        *middlewarehttp.HTTP
 	compStore             *compstore.ComponentStore
 	pubsubAdapter         pubsub.Adapter
+	appPubsubAdapter      pubsub.Adapter
 	pubsubAdapterStreamer pubsub.AdapterStreamer
 	outbox                outbox.Outbox
 	meta                  *meta.Meta
@@ -209,11 +212,29 @@ func newDaprRuntime(ctx context.Context,
 		AppMiddleware:       httpMiddlewareApp,
 	})
 
+	// The scheduler, which delayed messages are scheduled with, depends on
+	// the publisher through actors and workflows, so it is created later.
+	var jobsManager *scheduler.Scheduler
 	pubsubAdapter := publisher.New(publisher.Options{
 		AppID:       runtimeConfig.id,
 		Namespace:   namespace,
 		Resiliency:  resiliencyProvider,
 		GetPubSubFn: compStore.GetPubSub,
+		GetSchedulerFn: func() schedclient.Interface {
+			return jobsManager.Client()
+		},
+	})
+	// The messages published by the app are validated against the schemas of
+	// their topics, unlike the messages the runtime publishes itself.
+	appPubsubAdapter := schema.NewPublisher(schema.PublisherOptions{
+		Adapter:  pubsubAdapter,
+		Registry: schema.NewRegistry(compStore),
+		GetConfigFn: func(name string) *schema.Config {
+			if ps, ok := compStore.GetPubSub(name); ok {
+				return ps.Schema
+			}
+			return nil
+		},
 	})
 	pubsubAdapterStreamer := streamer.New(ctx, streamer.Options{
 		TracingSpec: globalConfig.Spec.TracingSpec,
@@ -241,6 +262,8 @@ func newDaprRuntime(ctx context.Context,
 		StateTTLEnabled:    globalConfig.IsFeatureEnabled(config.ActorStateTTL),
 		MaxRequestBodySize: runtimeConfig.maxRequestBodySize,
 		Mode:               runtimeConfig.mode,
+		LifecycleEvents:    globalConfig.GetActorSpec().LifecycleEvents,
+		PubSubAdapter:      pubsubAdapter,
 	})
 
 	processor := processor.New(processor.Options{
@@ -265,6 +288,9 @@ func newDaprRuntime(ctx context.Context,
 		Adapter:         pubsubAdapter,
 		AdapterStreamer: pubsubAdapterStreamer,
 		Reporter:        runtimeConfig.registry.Reporter(),
+		EnableDelayedDeliveryFn: func() {
+			jobsManager.EnableDelayedDelivery()
+		},
 	})
 
 	var reloader *hotreload.Reloader
@@ -306,6 +332,21 @@ func newDaprRuntime(ctx context.Context,
 		Resiliency:         resiliencyProvider,
 		SchedulerReminders: globalConfig.IsFeatureEnabled(config.SchedulerReminders),
 		EventSink:          runtimeConfig.workflowEventSink,
+		CompStore:          compStore,
+		Publisher:          pubsubAdapter,
+	})
+
+	jobsManager = scheduler.New(scheduler.Options{
+		Namespace:          namespace,
+		AppID:              runtimeConfig.id,
+		Channels:           channels,
+		Actors:             actors,
+		Addresses:          runtimeConfig.schedulerAddress,
+		Security:           sec,
+		WFEngine:           wfe,
+		Publisher:          pubsubAdapter,
+		Healthz:            runtimeConfig.healthz,
+		SchedulerReminders: globalConfig.IsFeatureEnabled(config.SchedulerReminders),
 	})
 
 	rt := &DaprRuntime{
@@ -318,6 +359,7 @@ func newDaprRuntime(ctx context.Context,
 		appHealthReady:        nil,
 		compStore:             compStore,
 		pubsubAdapter:         pubsubAdapter,
+		appPubsubAdapter:      appPubsubAdapter,
 		pubsubAdapterStreamer: pubsubAdapterStreamer,
 		outbox:                outbox,
 		meta:                  meta,
@@ -329,23 +371,13 @@ func newDaprRuntime(ctx context.Context,
 		reloader:              reloader,
 		namespace:             namespace,
 		podName:               podName,
-		jobsManager: scheduler.New(scheduler.Options{
-			Namespace:          namespace,
-			AppID:              runtimeConfig.id,
-			Channels:           channels,
-			Actors:             actors,
-			Addresses:          runtimeConfig.schedulerAddress,
-			Security:           sec,
-			WFEngine:           wfe,
-			Healthz:            runtimeConfig.healthz,
-			SchedulerReminders: globalConfig.IsFeatureEnabled(config.SchedulerReminders),
-		}),
-		initComplete:   make(chan struct{}),
-		isAppHealthy:   make(chan struct{}),
-		clock:          new(clock.RealClock),
-		httpMiddleware: httpMiddleware,
-		actors:         actors,
-		wfengine:       wfe,
+		jobsManager:           jobsManager,
+		initComplete:          make(chan struct{}),
+		isAppHealthy:          make(chan struct{}),
+		clock:                 new(clock.RealClock),
+		httpMiddleware:        httpMiddleware,
+		actors:                actors,
+		wfengine:              wfe,
 	}
 	close(rt.isAppHealthy)
 
@@ -639,6 +671,7 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Scheduler:                   a.jobsManager.Client(),
 		Actors:                      a.actors,
 		WorkflowEngine:              a.wfengine,
+		PubSubAdapter:               a.pubsubAdapter,
 	})
 
 	// Create and start internal and external gRPC servers
@@ -646,7 +679,7 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Universal:             a.daprUniversal,
 		Logger:                logger.NewLogger("dapr.grpc.api"),
 		Channels:              a.channels,
-		PubSubAdapter:         a.pubsubAdapter,
+		PubSubAdapter:         a.appPubsubAdapter,
 		PubSubAdapterStreamer: a.pubsubAdapterStreamer,
 		Outbox:                a.outbox,
 		DirectMessaging:       a.directMessaging,
@@ -807,6 +840,8 @@ func (a *DaprRuntime) appHealthChanged(ctx context.Context, status *apphealth.St
 			DefaultIdleTimeout:         a.appConfig.ActorIdleTimeout,
 			Reentrancy:                 a.appConfig.Reentrancy,
 			AppChannel:                 a.channels.AppChannel(),
+			ActorTypeVersion:           a.appConfig.ActorTypeVersion,
+			ActorTypeVersionRouting:    a.appConfig.ActorTypeVersionRouting,
 		}); err != nil {
 			log.Warnf("Failed to register hosted actors: %s", err)
 		}
@@ -875,9 +910,10 @@ func (a *DaprRuntime) startHTTPServer() error {
 		Universal:             a.daprUniversal,
 		Channels:              a.channels,
 		DirectMessaging:       a.directMessaging,
-		PubSubAdapter:         a.pubsubAdapter,
+		PubSubAdapter:         a.appPubsubAdapter,
 		Outbox:                a.outbox,
 		SendToOutputBindingFn: a.processor.Binding().SendToOutputBinding,
+		SeekSubscriptionFn:    a.processor.Subscriber().SeekDeclaredAppSubscription,
 		TracingSpec:           a.globalConfig.GetTracingSpec(),
 		MetricSpec:            &getMetricSpec,
 		MaxRequestBodySize:    int64(a.runtimeConfig.maxRequestBodySize),
@@ -1330,12 +1366,19 @@ func (a *DaprRuntime) appendBuiltinSecretStore(ctx context.Context) {
 
 func (a *DaprRuntime) getComponentsCapabilitesMap() map[string][]string {
 	capabilities := make(map[string][]string)
+	actorStateStoreName, _ := a.processor.State().ActorStateStoreName()
 	for key, store := range a.compStore.ListStateStores() {
 		features := store.Features()
 		stateStoreCapabilities := featureTypeToString(features)
 		if state.FeatureETag.IsPresent(features) && state.FeatureTransactional.IsPresent(features) {
 			stateStoreCapabilities = append(stateStoreCapabilities, "ACTOR")
 		}
+		// Actor state TTL is emulated by daprd for the actor state store if the
+		// store has no native TTL support.
+		if key == actorStateStoreName && !state.FeatureTTL.IsPresent(features) &&
+			a.globalConfig.IsFeatureEnabled(config.ActorStateTTL) {
+			stateStoreCapabilities = append(stateStoreCapabilities, "ACTOR_TTL_EMULATED")
+		}
 		capabilities[key] = stateStoreCapabilities
 	}
 	for key, pubSubItem := range a.compStore.ListPubSubs() {
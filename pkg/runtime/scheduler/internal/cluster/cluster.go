@@ -18,10 +18,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/dapr/dapr/pkg/actors"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
@@ -34,36 +37,44 @@ type Options struct {
 	AppID      string
 	AppTarget  bool
 	ActorTypes []string
-
-	Clients  []schedulerv1pb.SchedulerClient
-	Actors   actors.Interface
-	Channels *channels.Channels
-	WFEngine wfengine.Interface
+	// DelayedDelivery is true if delayed messages of the app are scheduled,
+	// in which case their actor type is watched.
+	DelayedDelivery bool
+
+	Clients   []schedulerv1pb.SchedulerClient
+	Actors    actors.Interface
+	Channels  *channels.Channels
+	WFEngine  wfengine.Interface
+	Publisher rtpubsub.Adapter
 }
 
 // Cluster manages connections to multiple schedulers.
 type Cluster struct {
-	namespace  string
-	appID      string
-	appTarget  bool
-	actorTypes []string
-
-	clients  []schedulerv1pb.SchedulerClient
-	actors   actors.Interface
-	channels *channels.Channels
-	wfengine wfengine.Interface
+	namespace       string
+	appID           string
+	appTarget       bool
+	actorTypes      []string
+	delayedDelivery bool
+
+	clients   []schedulerv1pb.SchedulerClient
+	actors    actors.Interface
+	channels  *channels.Channels
+	wfengine  wfengine.Interface
+	publisher rtpubsub.Adapter
 }
 
 func New(opts Options) *Cluster {
 	return &Cluster{
-		namespace:  opts.Namespace,
-		appID:      opts.AppID,
-		appTarget:  opts.AppTarget,
-		actorTypes: opts.ActorTypes,
-		clients:    opts.Clients,
-		actors:     opts.Actors,
-		channels:   opts.Channels,
-		wfengine:   opts.WFEngine,
+		namespace:       opts.Namespace,
+		appID:           opts.AppID,
+		appTarget:       opts.AppTarget,
+		actorTypes:      opts.ActorTypes,
+		delayedDelivery: opts.DelayedDelivery,
+		clients:         opts.Clients,
+		actors:          opts.Actors,
+		channels:        opts.Channels,
+		wfengine:        opts.WFEngine,
+		publisher:       opts.Publisher,
 	}
 }
 
@@ -101,9 +112,18 @@ func (c *Cluster) watchJobs(ctx context.Context) error {
 		acceptJobTypes = append(acceptJobTypes, schedulerv1pb.JobTargetType_JOB_TARGET_TYPE_JOB)
 	}
 
-	if len(c.actorTypes) > 0 {
+	actorTypes := c.actorTypes
+	// Delayed messages are published whether or not the app is running, so
+	// their actor type is watched independently of the app target.
+	var delayedActorType string
+	if c.delayedDelivery && c.publisher != nil {
+		delayedActorType = publisher.DelayedActorType(c.namespace, c.appID)
+		actorTypes = append(slices.Clone(actorTypes), delayedActorType)
+	}
+
+	if len(actorTypes) > 0 {
 		acceptJobTypes = append(acceptJobTypes, schedulerv1pb.JobTargetType_JOB_TARGET_TYPE_ACTOR_REMINDER)
-		req.GetInitial().ActorTypes = c.actorTypes
+		req.GetInitial().ActorTypes = actorTypes
 	}
 
 	req.GetInitial().AcceptJobTypes = acceptJobTypes
@@ -121,11 +141,14 @@ func (c *Cluster) watchJobs(ctx context.Context) error {
 	router, _ := c.actors.Router(ctx)
 	for i := range c.clients {
 		connectors[i] = &connector{
-			req:      req,
-			client:   c.clients[i],
-			channels: c.channels,
-			actors:   router,
-			wfengine: c.wfengine,
+			req:       req,
+			client:    c.clients[i],
+			channels:  c.channels,
+			actors:    router,
+			wfengine:  c.wfengine,
+			publisher: c.publisher,
+
+			delayedActorType: delayedActorType,
 		}
 		runners[i] = connectors[i].run
 	}
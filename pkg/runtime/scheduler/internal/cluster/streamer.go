@@ -30,6 +30,8 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
 	"github.com/dapr/kit/concurrency"
 )
@@ -38,9 +40,13 @@ type streamer struct {
 	stream   schedulerv1pb.Scheduler_WatchJobsClient
 	resultCh chan *schedulerv1pb.WatchJobsRequest
 
-	actors   router.Interface
-	channels *channels.Channels
-	wfengine wfengine.Interface
+	actors    router.Interface
+	channels  *channels.Channels
+	wfengine  wfengine.Interface
+	publisher rtpubsub.Adapter
+
+	// delayedActorType is the actor type of the jobs of delayed messages.
+	delayedActorType string
 
 	wg       sync.WaitGroup
 	inflight atomic.Int64
@@ -128,6 +134,14 @@ func (s *streamer) handleJob(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 		return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
 
 	case *schedulerv1pb.JobTargetMetadata_Actor:
+		if s.delayedActorType != "" && t.GetActor().GetType() == s.delayedActorType {
+			if err := s.publishDelayed(ctx, job); err != nil {
+				log.Errorf("failed to publish delayed message %s: %s", t.GetActor().GetId(), err)
+				return schedulerv1pb.WatchJobsRequestResultStatus_FAILED
+			}
+			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
+		}
+
 		err := s.invokeActorReminder(ctx, job)
 		if err == nil {
 			return schedulerv1pb.WatchJobsRequestResultStatus_SUCCESS
@@ -196,6 +210,21 @@ func (s *streamer) invokeApp(ctx context.Context, job *schedulerv1pb.WatchJobsRe
 	}
 }
 
+// publishDelayed publishes the delayed message of the given job.
+func (s *streamer) publishDelayed(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
+	if s.publisher == nil {
+		return errors.New("received delayed message, but publisher not initialized")
+	}
+
+	err := publisher.PublishDelayed(ctx, s.publisher, job.GetData())
+	if errors.As(err, &rtpubsub.NotFoundError{}) || errors.As(err, &rtpubsub.NotAllowedError{}) {
+		log.Errorf("non-retriable error while publishing delayed message %s, dropping message: %s", job.GetMetadata().GetTarget().GetActor().GetId(), err)
+		// return nil to signal SUCCESS
+		return nil
+	}
+	return err
+}
+
 // invokeActorReminder calls the actor ID with the given reminder data.
 func (s *streamer) invokeActorReminder(ctx context.Context, job *schedulerv1pb.WatchJobsResponse) error {
 	if s.actors == nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/cluster"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/loops"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
@@ -37,6 +38,7 @@ type Options struct {
 	Actors             actors.Interface
 	Channels           *channels.Channels
 	WFEngine           wfengine.Interface
+	Publisher          rtpubsub.Adapter
 	SchedulerReminders bool
 }
 
@@ -46,10 +48,12 @@ type connector struct {
 	actors             actors.Interface
 	channels           *channels.Channels
 	wfEngine           wfengine.Interface
+	publisher          rtpubsub.Adapter
 	schedulerReminders bool
 
 	currentAppRunning bool
 	currentActorTypes []string
+	delayedDelivery   bool
 	clients           []schedulerv1pb.SchedulerClient
 
 	closeCluster context.CancelFunc
@@ -62,6 +66,7 @@ func New(opts Options) loop.Interface[loops.Event] {
 		actors:             opts.Actors,
 		channels:           opts.Channels,
 		wfEngine:           opts.WFEngine,
+		publisher:          opts.Publisher,
 		schedulerReminders: opts.SchedulerReminders,
 	}, 1024)
 }
@@ -106,6 +111,10 @@ func (c *connector) handleReconnect(ctx context.Context, e *loops.Reconnect) {
 		c.currentActorTypes = *e.ActorTypes
 	}
 
+	if e.DelayedDelivery != nil {
+		c.delayedDelivery = *e.DelayedDelivery
+	}
+
 	c.maybeClientConnect(ctx)
 }
 
@@ -118,7 +127,7 @@ func (c *connector) closeClusterConnections() {
 }
 
 func (c *connector) maybeClientConnect(ctx context.Context) {
-	if len(c.clients) == 0 || (!c.currentAppRunning && len(c.currentActorTypes) == 0) {
+	if len(c.clients) == 0 || (!c.currentAppRunning && len(c.currentActorTypes) == 0 && !c.delayedDelivery) {
 		return
 	}
 
@@ -128,10 +137,12 @@ func (c *connector) maybeClientConnect(ctx context.Context) {
 		Actors:    c.actors,
 		Channels:  c.channels,
 		WFEngine:  c.wfEngine,
+		Publisher: c.publisher,
 
-		AppTarget:  c.currentAppRunning,
-		ActorTypes: c.currentActorTypes,
-		Clients:    c.clients,
+		AppTarget:       c.currentAppRunning,
+		ActorTypes:      c.currentActorTypes,
+		DelayedDelivery: c.delayedDelivery,
+		Clients:         c.clients,
 	})
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -30,8 +30,9 @@ type Connect struct {
 type Disconnect struct{}
 
 type Reconnect struct {
-	AppTarget  *bool
-	ActorTypes *[]string
+	AppTarget       *bool
+	ActorTypes      *[]string
+	DelayedDelivery *bool
 }
 
 type Close struct{}
@@ -15,10 +15,12 @@ package scheduler
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients"
 	"github.com/dapr/dapr/pkg/runtime/scheduler/internal/clients/wrapper"
@@ -39,6 +41,7 @@ type Options struct {
 	Actors             actors.Interface
 	Channels           *channels.Channels
 	WFEngine           wfengine.Interface
+	Publisher          rtpubsub.Adapter
 	Addresses          []string
 	Security           security.Handler
 	Healthz            healthz.Healthz
@@ -51,6 +54,8 @@ type Scheduler struct {
 	hosts      loop.Interface[loops.Event]
 	watchhosts *watchhosts.WatchHosts
 	client     client.Interface
+
+	delayedDelivery atomic.Bool
 }
 
 func New(opts Options) *Scheduler {
@@ -60,6 +65,7 @@ func New(opts Options) *Scheduler {
 		Actors:             opts.Actors,
 		Channels:           opts.Channels,
 		WFEngine:           opts.WFEngine,
+		Publisher:          opts.Publisher,
 		SchedulerReminders: opts.SchedulerReminders,
 	})
 
@@ -122,6 +128,17 @@ func (s *Scheduler) ReloadActorTypes(actorTypes []string) {
 	})
 }
 
+// EnableDelayedDelivery connects to the schedulers to publish delayed
+// messages, which happens once a pubsub component which doesn't delay
+// messages natively is loaded.
+func (s *Scheduler) EnableDelayedDelivery() {
+	if s.delayedDelivery.CompareAndSwap(false, true) {
+		s.connector.Enqueue(&loops.Reconnect{
+			DelayedDelivery: ptr.Of(true),
+		})
+	}
+}
+
 func (s *Scheduler) Client() client.Interface {
 	return s.client
 }
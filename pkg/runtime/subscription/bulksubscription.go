@@ -26,6 +26,7 @@ import (
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/dapr/pkg/resiliency"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/todo"
 )
 
@@ -98,6 +99,7 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 			return nil, nil
 		}
 		hasAnyError := false
+		var orderingEvents []map[string]any
 		for i, message := range msg.Entries {
 			if entryIdErr := todo.ValidateEntryId(message.EntryId, i); entryIdErr != nil { //nolint:stylecheck
 				bulkResponses[i].Error = entryIdErr
@@ -106,11 +108,27 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 			}
 			entryIdIndexMap[message.EntryId] = i
 			if rawPayload {
+				if s.schema != nil {
+					if vErr := s.validateSchema(ctx, &contribpubsub.NewMessage{Data: message.Event}, nil, true); vErr != nil {
+						if !errors.As(vErr, &schema.ValidationError{}) {
+							return s.failBulkSchemaValidation(ctx, msg, &bulkResponses, &bulkSubDiag, vErr)
+						}
+						log.Errorf("error validating one of the messages in bulk event in pubsub %s and topic %s: %s", psName, topic, vErr)
+						bulkResponses[i].Error = vErr
+						bulkResponses[i].EntryId = message.EntryId
+						hasAnyError = true
+						err = vErr
+						continue
+					}
+				}
 				rPath, routeErr := s.getRouteIfProcessable(ctx, &bulkSubCallData, route, &(msg.Entries[i]), i, string(message.Event))
 				if routeErr != nil {
 					hasAnyError = true
 					continue
 				}
+				if s.orderer != nil {
+					orderingEvents = append(orderingEvents, contribpubsub.FromRawPayload(message.Event, topic, psName))
+				}
 				dataB64 := base64.StdEncoding.EncodeToString(message.Event)
 				if message.ContentType == "" {
 					message.ContentType = "application/octet-stream"
@@ -141,16 +159,75 @@ func (s *Subscription) bulkSubscribeTopic(ctx context.Context, policyDef *resili
 					bulkResponses[i].Error = nil
 					continue
 				}
+				if s.schema != nil {
+					if vErr := s.validateSchema(ctx, nil, cloudEvent, false); vErr != nil {
+						if !errors.As(vErr, &schema.ValidationError{}) {
+							return s.failBulkSchemaValidation(ctx, msg, &bulkResponses, &bulkSubDiag, vErr)
+						}
+						log.Errorf("error validating one of the messages in bulk cloud event in pubsub %s and topic %s: %s", psName, topic, vErr)
+						bulkResponses[i].Error = vErr
+						bulkResponses[i].EntryId = message.EntryId
+						hasAnyError = true
+						err = vErr
+						continue
+					}
+				}
 				rPath, routeErr := s.getRouteIfProcessable(ctx, &bulkSubCallData, route, &(msg.Entries[i]), i, cloudEvent)
 				if routeErr != nil {
 					hasAnyError = true
 					continue
 				}
+				// The entry is transformed into a copy, so that the original
+				// entry is sent to the dead letter topic if delivery fails.
+				deliverEvent, entry := cloudEvent, &(msg.Entries[i])
+				if route.Transform != nil {
+					var md map[string]string
+					var tErr error
+					deliverEvent, md, _, tErr = s.transformMessage(cloudEvent, message.Metadata)
+					if tErr != nil {
+						log.Errorf("error transforming one of the messages in bulk cloud event in pubsub %s and topic %s: %s", psName, topic, tErr)
+						if route.DeadLetterTopic != "" {
+							if dlqErr := s.sendToDeadLetter(ctx, psName, &contribpubsub.NewMessage{
+								Data:        message.Event,
+								Topic:       topic,
+								Metadata:    message.Metadata,
+								ContentType: &msg.Entries[i].ContentType,
+							}, route.DeadLetterTopic); dlqErr != nil {
+								bulkResponses[i].Error = dlqErr
+								bulkResponses[i].EntryId = message.EntryId
+								hasAnyError = true
+								err = dlqErr
+								continue
+							}
+						}
+						// The transformation fails the same way on every
+						// redelivery, so the entry is dropped rather than retried.
+						bulkSubDiag.StatusWiseDiag[string(contribpubsub.Drop)]++
+						bulkResponses[i].EntryId = message.EntryId
+						bulkResponses[i].Error = nil
+						continue
+					}
+					transformed := msg.Entries[i]
+					transformed.Metadata = md
+					entry = &transformed
+				}
+				if s.orderer != nil {
+					orderingEvents = append(orderingEvents, cloudEvent)
+				}
 				if message.ContentType == "" {
 					message.ContentType = contenttype.CloudEventContentType
 				}
-				todo.PopulateBulkSubcribedMessage(&(msg.Entries[i]), cloudEvent, &routePathBulkMessageMap, rPath, i, msg, true, psName, message.ContentType, namespacedConsumer, s.namespace)
+				todo.PopulateBulkSubcribedMessage(entry, deliverEvent, &routePathBulkMessageMap, rPath, i, msg, true, psName, message.ContentType, namespacedConsumer, s.namespace)
+			}
+		}
+		if s.orderer != nil {
+			release, oErr := s.acquireOrdering(ctx, orderingEvents...)
+			if oErr != nil {
+				todo.PopulateAllBulkResponsesWithError(msg, &bulkResponses, oErr)
+				todo.ReportBulkSubDiagnostics(ctx, topic, &bulkSubDiag)
+				return bulkResponses, oErr
 			}
+			defer release()
 		}
 		var overallInvokeErr error
 		for path, psm := range routePathBulkMessageMap {
@@ -204,6 +281,19 @@ func (s *Subscription) sendBulkToDLQIfConfigured(ctx context.Context, bulkSubCal
 	return errors.New("failed to send to DLQ as DLQ was not configured")
 }
 
+// failBulkSchemaValidation fails the whole bulk message when the schema of the
+// topic can't be fetched, so it's retried instead of being sent to the dead
+// letter topic.
+func (s *Subscription) failBulkSchemaValidation(ctx context.Context, msg *contribpubsub.BulkMessage, bulkResponses *[]contribpubsub.BulkSubscribeResponseEntry,
+	bulkSubDiag *todo.BulkSubIngressDiagnostics, err error,
+) ([]contribpubsub.BulkSubscribeResponseEntry, error) {
+	log.Errorf("error validating bulk event in pubsub %s and topic %s: %s", s.pubsubName, s.topic, err)
+	bulkSubDiag.StatusWiseDiag[string(contribpubsub.Retry)] += int64(len(msg.Entries))
+	todo.PopulateAllBulkResponsesWithError(msg, bulkResponses, err)
+	todo.ReportBulkSubDiagnostics(ctx, s.topic, bulkSubDiag)
+	return *bulkResponses, err
+}
+
 // getRouteIfProcessable returns the route path if the message is processable.
 func (s *Subscription) getRouteIfProcessable(ctx context.Context, bulkSubCallData *todo.BulkSubscribeCallData, route rtpubsub.Subscription, message *contribpubsub.BulkMessageEntry,
 	i int, matchElem interface{},
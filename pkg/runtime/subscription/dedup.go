@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/expr"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+const (
+	// metadataDeduplicationStore is the subscription metadata key of the state
+	// store in which processed messages are recorded. Setting it enables
+	// deduplication for the subscription.
+	metadataDeduplicationStore = "deduplicationStore"
+	// metadataDeduplicationTTL is the subscription metadata key of the window,
+	// e.g. "10m", in which duplicates of a processed message are skipped.
+	metadataDeduplicationTTL = "deduplicationTTL"
+	// metadataDeduplicationKey is the subscription metadata key of the CEL
+	// expression, evaluated against the event, which gives the deduplication
+	// key. Defaults to the CloudEvent ID.
+	metadataDeduplicationKey = "deduplicationKey"
+
+	defaultDeduplicationTTL = time.Hour
+	// deduplicationLease is the maximum time for which a message is reserved
+	// while it is being delivered. The reservation expires if the sidecar
+	// stops before the delivery ends, so that the message is redelivered.
+	deduplicationLease = time.Minute
+)
+
+var (
+	dedupProcessing = []byte(`"processing"`)
+	dedupProcessed  = []byte(`"processed"`)
+)
+
+// errDeliveryInProgress is returned by reserve when a duplicate of the message
+// is being delivered. The message is redelivered rather than skipped, as the
+// delivery in progress may fail.
+var errDeliveryInProgress = errors.New("a duplicate of the message is being delivered")
+
+// deduplicator records the keys of processed messages in a state store, so
+// that redelivered messages can be skipped. Messages are reserved with a
+// first-write before they are delivered, so that concurrent deliveries of
+// the same message, for example by several replicas of the app, are not both
+// processed.
+type deduplicator struct {
+	store    state.Store
+	ttl      string
+	leaseTTL string
+	key      *expr.Expr
+	prefix   string
+}
+
+// newDeduplicator returns the deduplicator configured by the subscription
+// metadata, or nil if deduplication isn't enabled.
+func newDeduplicator(compStore *compstore.ComponentStore, appID, pubsubName, topic string, md map[string]string) (*deduplicator, error) {
+	storeName := md[metadataDeduplicationStore]
+	if storeName == "" {
+		return nil, nil
+	}
+
+	if compStore == nil {
+		return nil, errors.New("deduplication is not supported for this subscription")
+	}
+	store, ok := compStore.GetStateStore(storeName)
+	if !ok {
+		return nil, fmt.Errorf("deduplication state store %s not found", storeName)
+	}
+	if !state.FeatureTTL.IsPresent(store.Features()) {
+		return nil, fmt.Errorf("deduplication state store %s does not support TTLs", storeName)
+	}
+	if !state.FeatureETag.IsPresent(store.Features()) {
+		return nil, fmt.Errorf("deduplication state store %s does not support ETags", storeName)
+	}
+
+	ttl := defaultDeduplicationTTL
+	if v, ok := md[metadataDeduplicationTTL]; ok {
+		var err error
+		ttl, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", metadataDeduplicationTTL, err)
+		}
+		if ttl < time.Second {
+			return nil, fmt.Errorf("%s must be at least 1s", metadataDeduplicationTTL)
+		}
+	}
+
+	d := &deduplicator{
+		store:    store,
+		ttl:      strconv.FormatInt(int64(ttl.Seconds()), 10),
+		leaseTTL: strconv.FormatInt(int64(min(ttl, deduplicationLease).Seconds()), 10),
+		prefix:   appID + "||dedup||" + pubsubName + "||" + topic + "||",
+	}
+
+	if v := md[metadataDeduplicationKey]; v != "" {
+		d.key = new(expr.Expr)
+		if err := d.key.DecodeString(v); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", metadataDeduplicationKey, err)
+		}
+	}
+
+	return d, nil
+}
+
+// messageKey returns the state key of the message, or an empty string if the
+// message has no deduplication key.
+func (d *deduplicator) messageKey(cloudEvent map[string]any) (string, error) {
+	var key any
+	if d.key != nil {
+		var err error
+		key, err = d.key.Eval(map[string]any{"event": cloudEvent})
+		if err != nil {
+			return "", fmt.Errorf("error evaluating deduplication key: %w", err)
+		}
+	} else {
+		key = cloudEvent[contribpubsub.IDField]
+	}
+
+	if key == nil {
+		return "", nil
+	}
+	k := fmt.Sprint(key)
+	if k == "" {
+		return "", nil
+	}
+	return d.prefix + k, nil
+}
+
+// reserve records the message with the given key as being delivered, unless
+// it is already recorded. It returns true if the message has already been
+// processed within the deduplication window, in which case it must be
+// skipped, and errDeliveryInProgress if a duplicate of the message is being
+// delivered.
+func (d *deduplicator) reserve(ctx context.Context, key string) (bool, error) {
+	err := d.store.Set(ctx, &state.SetRequest{
+		Key:   key,
+		Value: dedupProcessing,
+		Metadata: map[string]string{
+			"ttlInSeconds": d.leaseTTL,
+		},
+		Options: state.SetStateOption{
+			Concurrency: state.FirstWrite,
+		},
+	})
+	if err == nil {
+		return false, nil
+	}
+
+	var etagErr *state.ETagError
+	if !errors.As(err, &etagErr) {
+		return false, err
+	}
+
+	res, err := d.store.Get(ctx, &state.GetRequest{Key: key})
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case res == nil || len(res.Data) == 0:
+		// The reservation expired since the write, so the message is retried.
+		return false, errDeliveryInProgress
+	case bytes.Equal(res.Data, dedupProcessing):
+		return false, errDeliveryInProgress
+	default:
+		return true, nil
+	}
+}
+
+// release deletes the reservation of the message with the given key, whose
+// delivery failed, so that its redelivery is processed.
+func (d *deduplicator) release(ctx context.Context, key string) error {
+	return d.store.Delete(ctx, &state.DeleteRequest{Key: key})
+}
+
+// markProcessed records the reserved message with the given key as processed
+// for the deduplication window.
+func (d *deduplicator) markProcessed(ctx context.Context, key string) error {
+	return d.store.Set(ctx, &state.SetRequest{
+		Key:   key,
+		Value: dedupProcessed,
+		Metadata: map[string]string{
+			"ttlInSeconds": d.ttl,
+		},
+	})
+}
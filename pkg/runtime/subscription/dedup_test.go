@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	inmemorystate "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	postmanfake "github.com/dapr/dapr/pkg/runtime/subscription/postman/fake"
+)
+
+func newDedupCompStore(t *testing.T) *compstore.ComponentStore {
+	t.Helper()
+	store := inmemorystate.NewInMemoryStateStore(log)
+	require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+	t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+	cs := compstore.New()
+	cs.AddStateStore("dedupstore", store)
+	return cs
+}
+
+func TestNewDeduplicator(t *testing.T) {
+	cs := newDedupCompStore(t)
+
+	t.Run("disabled", func(t *testing.T) {
+		d, err := newDeduplicator(cs, "app", "pubsub", "topic", map[string]string{})
+		require.NoError(t, err)
+		assert.Nil(t, d)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		d, err := newDeduplicator(cs, "app", "pubsub", "topic", map[string]string{
+			metadataDeduplicationStore: "dedupstore",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, d)
+		assert.Equal(t, "3600", d.ttl)
+		assert.Nil(t, d.key)
+
+		key, err := d.messageKey(map[string]any{"id": "abc"})
+		require.NoError(t, err)
+		assert.Equal(t, "app||dedup||pubsub||topic||abc", key)
+
+		key, err = d.messageKey(map[string]any{})
+		require.NoError(t, err)
+		assert.Empty(t, key)
+	})
+
+	t.Run("custom key", func(t *testing.T) {
+		d, err := newDeduplicator(cs, "app", "pubsub", "topic", map[string]string{
+			metadataDeduplicationStore: "dedupstore",
+			metadataDeduplicationTTL:   "10m",
+			metadataDeduplicationKey:   "event.data.orderId",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "600", d.ttl)
+
+		key, err := d.messageKey(map[string]any{"id": "abc", "data": map[string]any{"orderId": "1"}})
+		require.NoError(t, err)
+		assert.Equal(t, "app||dedup||pubsub||topic||1", key)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, md := range map[string]map[string]string{
+			"unknown store": {metadataDeduplicationStore: "notfound"},
+			"invalid ttl":   {metadataDeduplicationStore: "dedupstore", metadataDeduplicationTTL: "forever"},
+			"short ttl":     {metadataDeduplicationStore: "dedupstore", metadataDeduplicationTTL: "10ms"},
+			"invalid key":   {metadataDeduplicationStore: "dedupstore", metadataDeduplicationKey: "event.("},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := newDeduplicator(cs, "app", "pubsub", "topic", md)
+				require.Error(t, err)
+			})
+		}
+
+		_, err := newDeduplicator(nil, "app", "pubsub", "topic", map[string]string{metadataDeduplicationStore: "dedupstore"})
+		require.Error(t, err)
+	})
+}
+
+func TestDeduplicatorReserve(t *testing.T) {
+	d, err := newDeduplicator(newDedupCompStore(t), "app", "pubsub", "topic", map[string]string{
+		metadataDeduplicationStore: "dedupstore",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "60", d.leaseTTL)
+
+	dup, err := d.reserve(t.Context(), "key")
+	require.NoError(t, err)
+	assert.False(t, dup)
+
+	_, err = d.reserve(t.Context(), "key")
+	require.ErrorIs(t, err, errDeliveryInProgress)
+
+	require.NoError(t, d.release(t.Context(), "key"))
+	dup, err = d.reserve(t.Context(), "key")
+	require.NoError(t, err)
+	assert.False(t, dup)
+
+	require.NoError(t, d.markProcessed(t.Context(), "key"))
+	dup, err = d.reserve(t.Context(), "key")
+	require.NoError(t, err)
+	assert.True(t, dup)
+}
+
+func TestDeduplication(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	var delivered []string
+	fail := true
+	postman := postmanfake.New().WithDeliverFn(func(ctx context.Context, msg *runtimePubsub.SubscribedMessage) error {
+		delivered = append(delivered, msg.CloudEvent["id"].(string))
+		if fail {
+			fail = false
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postman,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		TraceSpec:  &config.TracingSpec{},
+		CompStore:  newDedupCompStore(t),
+		Route: runtimePubsub.Subscription{
+			Metadata: map[string]string{metadataDeduplicationStore: "dedupstore"},
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+
+	publish := func(id string) {
+		_ = comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+			PubsubName: "testpubsub",
+			Topic:      "topic0",
+			Data:       []byte(`{"specversion":"1.0","id":"` + id + `","source":"test","type":"test"}`),
+		})
+	}
+
+	// A failed delivery isn't recorded, so the redelivery is processed.
+	publish("1")
+	publish("1")
+	publish("1")
+	publish("2")
+	publish("2")
+
+	assert.Equal(t, []string{"1", "1", "2"}, delivered)
+}
+
+func TestDeduplicationBulk(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	_, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postmanfake.New(),
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		CompStore:  newDedupCompStore(t),
+		Route: runtimePubsub.Subscription{
+			Metadata:      map[string]string{metadataDeduplicationStore: "dedupstore"},
+			BulkSubscribe: &runtimePubsub.BulkSubscribe{Enabled: true},
+		},
+	})
+	require.ErrorContains(t, err, "deduplication is not supported for bulk subscriptions")
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/dapr/dapr/pkg/expr"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	// metadataOrderingKey is the subscription metadata key of the CEL
+	// expression, evaluated against the event, which gives the ordering key.
+	// Messages with the same ordering key are delivered serially, in the order
+	// they were received. It is used when the subscription doesn't set the
+	// ordering key, e.g. for gRPC subscriptions.
+	metadataOrderingKey = "orderingKey"
+	// metadataOrderingMaxQueue is the subscription metadata key of the maximum
+	// number of messages waiting to be delivered per ordering key. Receiving
+	// further messages for a full key blocks until there is space. It is used
+	// when the subscription doesn't set the maximum queue.
+	metadataOrderingMaxQueue = "orderingMaxQueue"
+
+	defaultOrderingMaxQueue = 100
+)
+
+// orderer serializes the delivery of messages with the same ordering key,
+// while messages with different keys are delivered concurrently.
+type orderer struct {
+	key      *expr.Expr
+	maxQueue int
+
+	lock   sync.Mutex
+	queues map[string][]*orderTicket
+	space  chan struct{}
+}
+
+type orderTicket struct {
+	ready chan struct{}
+}
+
+// newOrderer returns the orderer configured by the subscription ordering, or
+// by the subscription metadata for the settings the ordering doesn't set. It
+// returns nil if ordered delivery isn't enabled.
+func newOrderer(ordering *rtpubsub.Ordering, md map[string]string) (*orderer, error) {
+	v := md[metadataOrderingKey]
+	if ordering != nil && ordering.Key != "" {
+		v = ordering.Key
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	o := &orderer{
+		key:      new(expr.Expr),
+		maxQueue: defaultOrderingMaxQueue,
+		queues:   make(map[string][]*orderTicket),
+		space:    make(chan struct{}),
+	}
+	if err := o.key.DecodeString(v); err != nil {
+		return nil, fmt.Errorf("invalid ordering key: %w", err)
+	}
+
+	if ordering != nil && ordering.MaxQueue != 0 {
+		if ordering.MaxQueue < 1 {
+			return nil, errors.New("invalid ordering maxQueue: must be a positive integer")
+		}
+		o.maxQueue = int(ordering.MaxQueue)
+	} else if v, ok := md[metadataOrderingMaxQueue]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid %s: must be a positive integer", metadataOrderingMaxQueue)
+		}
+		o.maxQueue = n
+	}
+
+	return o, nil
+}
+
+// messageKey returns the ordering key of the event, or an empty string if the
+// event has no ordering key.
+func (o *orderer) messageKey(cloudEvent map[string]any) (string, error) {
+	key, err := o.key.Eval(map[string]any{"event": cloudEvent})
+	if err != nil {
+		return "", fmt.Errorf("error evaluating ordering key: %w", err)
+	}
+	if key == nil {
+		return "", nil
+	}
+	return fmt.Sprint(key), nil
+}
+
+// acquire blocks until it is the turn of the message(s) with the given keys to
+// be delivered. Tickets for all keys are taken at once so that concurrent
+// acquisitions of overlapping keys can't deadlock. The returned func must be
+// called once delivery has completed.
+func (o *orderer) acquire(ctx context.Context, keys ...string) (func(), error) {
+	keys = slices.DeleteFunc(slices.Clone(keys), func(k string) bool { return k == "" })
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+	if len(keys) == 0 {
+		return func() {}, nil
+	}
+
+	tickets := make([]*orderTicket, len(keys))
+	for {
+		o.lock.Lock()
+		full := slices.ContainsFunc(keys, func(k string) bool { return len(o.queues[k]) >= o.maxQueue })
+		if !full {
+			for i, k := range keys {
+				tickets[i] = &orderTicket{ready: make(chan struct{})}
+				if len(o.queues[k]) == 0 {
+					close(tickets[i].ready)
+				}
+				o.queues[k] = append(o.queues[k], tickets[i])
+			}
+			o.lock.Unlock()
+			break
+		}
+		space := o.space
+		o.lock.Unlock()
+
+		select {
+		case <-space:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+		for i, k := range keys {
+			o.remove(k, tickets[i])
+		}
+		close(o.space)
+		o.space = make(chan struct{})
+	}
+
+	for _, t := range tickets {
+		select {
+		case <-t.ready:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// remove removes the ticket from the queue of the key, signalling the next
+// ticket if the removed ticket was at the head. Must be called with the lock
+// held.
+func (o *orderer) remove(key string, t *orderTicket) {
+	q := o.queues[key]
+	i := slices.Index(q, t)
+	if i < 0 {
+		return
+	}
+	q = slices.Delete(q, i, i+1)
+	if len(q) == 0 {
+		delete(o.queues, key)
+		return
+	}
+	o.queues[key] = q
+	if i == 0 {
+		close(q[0].ready)
+	}
+}
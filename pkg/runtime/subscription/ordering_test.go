@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func TestNewOrderer(t *testing.T) {
+	o, err := newOrderer(nil, map[string]string{})
+	require.NoError(t, err)
+	assert.Nil(t, o)
+
+	o, err = newOrderer(nil, map[string]string{metadataOrderingKey: "event.data.customer"})
+	require.NoError(t, err)
+	require.NotNil(t, o)
+	assert.Equal(t, defaultOrderingMaxQueue, o.maxQueue)
+
+	key, err := o.messageKey(map[string]any{"data": map[string]any{"customer": "a"}})
+	require.NoError(t, err)
+	assert.Equal(t, "a", key)
+
+	o, err = newOrderer(nil, map[string]string{metadataOrderingKey: "event.id", metadataOrderingMaxQueue: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, 5, o.maxQueue)
+
+	_, err = newOrderer(nil, map[string]string{metadataOrderingKey: "event.("})
+	require.Error(t, err)
+	_, err = newOrderer(nil, map[string]string{metadataOrderingKey: "event.id", metadataOrderingMaxQueue: "0"})
+	require.Error(t, err)
+
+	t.Run("ordering takes precedence over metadata", func(t *testing.T) {
+		md := map[string]string{metadataOrderingKey: "event.(", metadataOrderingMaxQueue: "5"}
+		o, err := newOrderer(&rtpubsub.Ordering{Key: "event.id", MaxQueue: 3}, md)
+		require.NoError(t, err)
+		assert.Equal(t, 3, o.maxQueue)
+
+		// Settings the ordering doesn't set fall back to the metadata.
+		o, err = newOrderer(&rtpubsub.Ordering{Key: "event.id"}, md)
+		require.NoError(t, err)
+		assert.Equal(t, 5, o.maxQueue)
+
+		_, err = newOrderer(&rtpubsub.Ordering{Key: "event.id", MaxQueue: -1}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestOrdererAcquire(t *testing.T) {
+	newTestOrderer := func(t *testing.T, maxQueue int) *orderer {
+		t.Helper()
+		o, err := newOrderer(nil, map[string]string{metadataOrderingKey: "event.id"})
+		require.NoError(t, err)
+		o.maxQueue = maxQueue
+		return o
+	}
+
+	queueLen := func(o *orderer, key string) int {
+		o.lock.Lock()
+		defer o.lock.Unlock()
+		return len(o.queues[key])
+	}
+
+	t.Run("same key is delivered serially in order", func(t *testing.T) {
+		o := newTestOrderer(t, 10)
+
+		release, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+
+		var lock sync.Mutex
+		var order []int
+		var wg sync.WaitGroup
+		for i := range 3 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r, err := o.acquire(t.Context(), "a")
+				assert.NoError(t, err)
+				lock.Lock()
+				order = append(order, i)
+				lock.Unlock()
+				r()
+			}()
+			assert.Eventually(t, func() bool { return queueLen(o, "a") == i+2 }, time.Second, time.Millisecond)
+		}
+
+		lock.Lock()
+		assert.Empty(t, order)
+		lock.Unlock()
+
+		release()
+		wg.Wait()
+		assert.Equal(t, []int{0, 1, 2}, order)
+		assert.Equal(t, 0, queueLen(o, "a"))
+	})
+
+	t.Run("different keys are delivered concurrently", func(t *testing.T) {
+		o := newTestOrderer(t, 10)
+
+		releaseA, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+		releaseB, err := o.acquire(t.Context(), "b")
+		require.NoError(t, err)
+		releaseEmpty, err := o.acquire(t.Context(), "")
+		require.NoError(t, err)
+		releaseA()
+		releaseB()
+		releaseEmpty()
+	})
+
+	t.Run("overlapping keys don't deadlock", func(t *testing.T) {
+		o := newTestOrderer(t, 10)
+
+		release, err := o.acquire(t.Context(), "a", "b")
+		require.NoError(t, err)
+
+		done := make(chan struct{})
+		go func() {
+			r, err := o.acquire(t.Context(), "b", "a", "b")
+			assert.NoError(t, err)
+			r()
+			close(done)
+		}()
+
+		assert.Eventually(t, func() bool { return queueLen(o, "b") == 2 }, time.Second, time.Millisecond)
+		release()
+		select {
+		case <-done:
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for acquire")
+		}
+	})
+
+	t.Run("full queue blocks until cancelled", func(t *testing.T) {
+		o := newTestOrderer(t, 1)
+
+		release, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+		t.Cleanup(release)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*100)
+		defer cancel()
+		_, err = o.acquire(ctx, "a")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, queueLen(o, "a"))
+	})
+
+	t.Run("cancelled waiter leaves the queue", func(t *testing.T) {
+		o := newTestOrderer(t, 10)
+
+		release, err := o.acquire(t.Context(), "a")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*100)
+		defer cancel()
+		_, err = o.acquire(ctx, "a")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, queueLen(o, "a"))
+
+		release()
+		assert.Equal(t, 0, queueLen(o, "a"))
+	})
+}
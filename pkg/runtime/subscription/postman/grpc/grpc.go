@@ -152,6 +152,11 @@ func (g *grpc) DeliverBulk(ctx context.Context, req *postman.DeliverBulkRequest)
 			todo.AddBulkResponseEntry(bulkResponses, entry.EntryId, err)
 			continue
 		}
+		if pubSubMsg.RawData != nil {
+			// The metadata of the delivered message, which includes the
+			// headers injected by the transform of the subscription.
+			item.Metadata = pubSubMsg.RawData.Metadata
+		}
 		items[i] = item
 	}
 
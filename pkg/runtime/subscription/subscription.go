@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -29,8 +30,10 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
 	"github.com/dapr/kit/logger"
 )
@@ -49,6 +52,7 @@ type Options struct {
 	AdapterStreamer rtpubsub.AdapterStreamer
 	ConnectionID    rtpubsub.ConnectionID
 	Postman         postman.Interface
+	CompStore       *compstore.ComponentStore
 }
 
 type Subscription struct {
@@ -72,6 +76,10 @@ type Subscription struct {
 	inflight atomic.Int64
 
 	postman postman.Interface
+	dedup   *deduplicator
+	orderer *orderer
+	schema  *schema.Config
+	schemas *schema.Registry
 }
 
 var log = logger.NewLogger("dapr.runtime.processor.subscription")
@@ -103,12 +111,53 @@ func New(opts Options) (*Subscription, error) {
 
 	name := s.pubsubName
 	route := s.route
+
+	dedup, err := newDeduplicator(opts.CompStore, s.appID, name, s.topic, route.Metadata)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to configure deduplication for topic %s: %w", s.topic, err)
+	}
+	s.dedup = dedup
+
+	s.orderer, err = newOrderer(route.Ordering, route.Metadata)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to configure ordered delivery for topic %s: %w", s.topic, err)
+	}
+
+	s.schema, err = schema.SubscriptionConfig(route.Metadata, s.pubsub.Schema)
+	if err == nil && s.schema != nil && opts.CompStore == nil {
+		err = errors.New("schema validation is not supported for this subscription")
+	}
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to configure schema validation for topic %s: %w", s.topic, err)
+	}
+	if s.schema != nil {
+		s.schemas = schema.NewRegistry(opts.CompStore)
+	}
 	policyDef := s.resiliency.ComponentInboundPolicy(name, resiliency.Pubsub)
 	routeMetadata := route.Metadata
 
 	namespaced := s.pubsub.NamespaceScoped
 
 	if route.BulkSubscribe != nil && route.BulkSubscribe.Enabled {
+		if len(route.RetryTopics) > 0 {
+			cancel()
+			return nil, fmt.Errorf("retry topics are not supported for bulk subscriptions; topic %s", s.topic)
+		}
+		if s.dedup != nil {
+			cancel()
+			return nil, fmt.Errorf("deduplication is not supported for bulk subscriptions; topic %s", s.topic)
+		}
+		if route.Transform != nil {
+			// Raw payloads are delivered in bulk as they were published, so
+			// there is no cloud event to transform.
+			if rawPayload, _ := metadata.IsRawPayload(route.Metadata); rawPayload {
+				cancel()
+				return nil, fmt.Errorf("transforms are not supported for bulk subscriptions with raw payloads; topic %s", s.topic)
+			}
+		}
 		err := s.bulkSubscribeTopic(ctx, policyDef)
 		if err != nil {
 			cancel()
@@ -122,10 +171,14 @@ func New(opts Options) (*Subscription, error) {
 		subscribeTopic = s.namespace + s.topic
 	}
 
-	err := s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
-		Topic:    subscribeTopic,
-		Metadata: routeMetadata,
-	}, func(ctx context.Context, msg *contribpubsub.NewMessage) error {
+	for _, tier := range route.RetryTopics {
+		if !rtpubsub.IsOperationAllowed(tier.Topic, opts.PubSub, opts.PubSub.ScopedSubscriptions) {
+			cancel()
+			return nil, fmt.Errorf("subscription to retry topic '%s' on pubsub '%s' is not allowed", tier.Topic, opts.PubSubName)
+		}
+	}
+
+	handler := func(ctx context.Context, msg *contribpubsub.NewMessage) error {
 		s.wg.Add(1)
 		s.inflight.Add(1)
 		defer func() {
@@ -147,6 +200,14 @@ func New(opts Options) (*Subscription, error) {
 		if s.pubsub.NamespaceScoped {
 			msgTopic = strings.Replace(msgTopic, s.namespace, "", 1)
 		}
+		// The retry attempt of a message is the tier of the topic it is
+		// delivered from, so it doesn't depend on the broker keeping metadata.
+		attempt := s.retryAttempt(msgTopic)
+		if attempt > 0 {
+			// Messages redelivered from a retry tier are delivered to the app as
+			// coming from the original topic.
+			msgTopic = s.topic
+		}
 
 		rawPayload, err := metadata.IsRawPayload(route.Metadata)
 		if err != nil {
@@ -236,6 +297,24 @@ func New(opts Options) (*Subscription, error) {
 			return nil
 		}
 
+		if s.schema != nil {
+			if vErr := s.validateSchema(ctx, msg, cloudEvent, rawPayload); vErr != nil {
+				log.Errorf("error validating pub/sub event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, vErr)
+				// Only events which don't match the schema are sent to the dead
+				// letter topic: failing to get the schema is retried.
+				if route.DeadLetterTopic != "" && errors.As(vErr, &schema.ValidationError{}) {
+					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr == nil {
+						// dlq has been configured and message is successfully sent to dlq.
+						diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+						return nil
+					}
+				}
+				// If no DLQ is configured, return error for backwards compatibility (component-level retry).
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return vErr
+			}
+		}
+
 		routePath, shouldProcess, err := findMatchingRoute(route.Rules, cloudEvent)
 		if err != nil {
 			log.Errorf("error finding matching route for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
@@ -260,11 +339,77 @@ func New(opts Options) (*Subscription, error) {
 			return nil
 		}
 
+		if s.orderer != nil {
+			release, oErr := s.acquireOrdering(ctx, cloudEvent)
+			if oErr != nil {
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+				return oErr
+			}
+			defer release()
+		}
+
+		var dedupKey string
+		if s.dedup != nil {
+			dedupKey, err = s.dedup.messageKey(cloudEvent)
+			if err != nil {
+				log.Warnf("error getting deduplication key for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+			} else if dedupKey != "" {
+				dup, dErr := s.dedup.reserve(ctx, dedupKey)
+				switch {
+				case errors.Is(dErr, errDeliveryInProgress):
+					log.Debugf("duplicate event %v in pubsub %s and topic %s is being delivered; retrying later", cloudEvent[contribpubsub.IDField], name, msgTopic)
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+					return dErr
+				case dErr != nil:
+					log.Warnf("error checking for duplicate event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, dErr)
+					dedupKey = ""
+				case dup:
+					log.Debugf("skipping duplicate event %v in pubsub %s and topic %s", cloudEvent[contribpubsub.IDField], name, msgTopic)
+					diag.DefaultComponentMonitoring.PubsubIngressDuplicateEvent(ctx, name, msgTopic)
+					return nil
+				}
+			}
+		}
+
+		// The reservation of the message is turned into a record of its
+		// processing if it was delivered, and is released otherwise, so that
+		// its redelivery is processed.
+		var delivered bool
+		if dedupKey != "" {
+			defer func() {
+				if delivered {
+					if dErr := s.dedup.markProcessed(ctx, dedupKey); dErr != nil {
+						log.Warnf("error recording processed event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, dErr)
+					}
+				} else if dErr := s.dedup.release(ctx, dedupKey); dErr != nil {
+					log.Warnf("error releasing event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, dErr)
+				}
+			}()
+		}
+
+		deliverEvent, msgMetadata := cloudEvent, msg.Metadata
+		if route.Transform != nil {
+			deliverEvent, msgMetadata, data, err = s.transformMessage(cloudEvent, msgMetadata)
+			if err != nil {
+				log.Errorf("error transforming event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], name, msgTopic, err)
+				if route.DeadLetterTopic != "" {
+					if dlqErr := s.sendToDeadLetter(ctx, name, msg, route.DeadLetterTopic); dlqErr != nil {
+						diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+						return dlqErr
+					}
+				}
+				// The transformation fails the same way on every redelivery, so
+				// the event is dropped rather than retried.
+				diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Drop)), "", msgTopic, 0)
+				return nil
+			}
+		}
+
 		sm := &rtpubsub.SubscribedMessage{
-			CloudEvent:   cloudEvent,
+			CloudEvent:   deliverEvent,
 			Data:         data,
 			Topic:        msgTopic,
-			Metadata:     msg.Metadata,
+			Metadata:     msgMetadata,
 			Path:         routePath,
 			PubSub:       name,
 			SubscriberID: s.connectionID,
@@ -293,6 +438,13 @@ func New(opts Options) (*Subscription, error) {
 		})
 		// when runtime shutting down, don't send to DLQ
 		if err != nil && err != context.Canceled {
+			// Sending msg to the next retry tier, if any are left.
+			if len(route.RetryTopics) > 0 {
+				if retried, rErr := s.sendToRetryTopic(ctx, name, msg, cloudEvent, rawPayload, attempt); retried && rErr == nil {
+					diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
+					return nil
+				}
+			}
 			// Sending msg to dead letter queue.
 			// If no DLQ is configured, return error for backwards compatibility (component-level retry).
 			if route.DeadLetterTopic != "" {
@@ -305,13 +457,34 @@ func New(opts Options) (*Subscription, error) {
 			diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, name, strings.ToLower(string(contribpubsub.Retry)), "", msgTopic, 0)
 			return err
 		}
+		delivered = err == nil
 		return err
-	})
+	}
+
+	err = s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+		Topic:    subscribeTopic,
+		Metadata: routeMetadata,
+	}, handler)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to subscribe to topic %s: %w", s.topic, err)
 	}
 
+	for _, tier := range route.RetryTopics {
+		retryTopic := tier.Topic
+		if namespaced {
+			retryTopic = s.namespace + tier.Topic
+		}
+		err = s.pubsub.Component.Subscribe(ctx, contribpubsub.SubscribeRequest{
+			Topic:    retryTopic,
+			Metadata: routeMetadata,
+		}, handler)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to subscribe to retry topic %s of topic %s: %w", tier.Topic, s.topic, err)
+		}
+	}
+
 	return s, nil
 }
 
@@ -349,6 +522,99 @@ func (s *Subscription) sendToDeadLetter(ctx context.Context, name string, msg *c
 	return nil
 }
 
+// acquireOrdering waits for the turn of the events to be delivered, according
+// to their ordering keys. Events whose ordering key can't be evaluated are
+// delivered unordered.
+func (s *Subscription) acquireOrdering(ctx context.Context, cloudEvents ...map[string]any) (func(), error) {
+	keys := make([]string, 0, len(cloudEvents))
+	for _, cloudEvent := range cloudEvents {
+		key, err := s.orderer.messageKey(cloudEvent)
+		if err != nil {
+			log.Warnf("error getting ordering key for event %v in pubsub %s and topic %s: %s", cloudEvent[contribpubsub.IDField], s.pubsubName, s.topic, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return s.orderer.acquire(ctx, keys...)
+}
+
+// validateSchema validates the payload of the message against the schema of
+// the topic.
+func (s *Subscription) validateSchema(ctx context.Context, msg *contribpubsub.NewMessage, cloudEvent map[string]any, rawPayload bool) error {
+	if rawPayload {
+		_, err := s.schemas.Validate(ctx, s.schema, s.topic, msg.Data)
+		return err
+	}
+	return s.schemas.ValidateCloudEvent(ctx, s.schema, s.topic, cloudEvent)
+}
+
+// transformMessage applies the transformation of the subscription to a copy
+// of the cloud event, and returns the cloud event, metadata and serialized
+// cloud event to deliver to the app. The original cloud event is kept intact
+// so retries and dead letters carry the event as it was received.
+func (s *Subscription) transformMessage(cloudEvent map[string]any, metadata map[string]string) (map[string]any, map[string]string, []byte, error) {
+	event := maps.Clone(cloudEvent)
+	md, err := s.route.Transform.Apply(event, metadata)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error serializing transformed cloud event: %w", err)
+	}
+	return event, md, data, nil
+}
+
+// retryAttempt returns the number of retry tiers a message delivered from the
+// given topic has been through: 0 for the topic of the subscription, or the
+// position of the retry tier whose topic it is.
+func (s *Subscription) retryAttempt(topic string) int {
+	for i, tier := range s.route.RetryTopics {
+		if tier.Topic == topic {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// sendToRetryTopic republishes the message to the next retry tier after the
+// given attempt, delayed by the tier delay. It returns false once all retry
+// tiers have been exhausted.
+func (s *Subscription) sendToRetryTopic(ctx context.Context, name string, msg *contribpubsub.NewMessage, cloudEvent map[string]any, rawPayload bool, attempt int) (bool, error) {
+	if attempt >= len(s.route.RetryTopics) {
+		return false, nil
+	}
+	tier := s.route.RetryTopics[attempt]
+
+	data := msg.Data
+	if !rawPayload {
+		if _, ok := cloudEvent[contribpubsub.SpecVersionField]; ok {
+			event := maps.Clone(cloudEvent)
+			event[rtpubsub.RetryAttemptExtension] = attempt + 1
+			var err error
+			data, err = json.Marshal(event)
+			if err != nil {
+				return true, err
+			}
+		}
+	}
+
+	req := &contribpubsub.PublishRequest{
+		Data:        data,
+		PubsubName:  name,
+		Topic:       tier.Topic,
+		Metadata:    rtpubsub.WithRetryAttempt(msg.Metadata, attempt+1, tier.Delay),
+		ContentType: msg.ContentType,
+	}
+
+	if err := s.adapter.Publish(ctx, req); err != nil {
+		log.Errorf("error sending message to retry topic, origin topic: %s retry topic %s err: %v", msg.Topic, tier.Topic, err)
+		return true, err
+	}
+
+	return true, nil
+}
+
 // findMatchingRoute selects the path based on routing rules. If there are
 // no matching rules, the route-level path is used.
 func findMatchingRoute(rules []*rtpubsub.Rule, cloudEvent interface{}) (path string, shouldProcess bool, err error) {
@@ -14,21 +14,30 @@ limitations under the License.
 package subscription
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/schema"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+	postmanfake "github.com/dapr/dapr/pkg/runtime/subscription/postman/fake"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/http"
+	"github.com/dapr/dapr/pkg/runtime/subscription/todo"
+	daprt "github.com/dapr/dapr/pkg/testing"
 )
 
 func TestTracingOnNewPublishedMessage(t *testing.T) {
@@ -99,3 +108,435 @@ func TestTracingOnNewPublishedMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestRetryTopics(t *testing.T) {
+	for _, rawPayload := range []bool{false, true} {
+		t.Run("rawPayload="+strconv.FormatBool(rawPayload), func(t *testing.T) {
+			comp := &mockSubscribePubSub{}
+			require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+			var delays []string
+			adapter := &daprt.MockPubSubAdapter{
+				PublishFn: func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+					// Deliver delayed messages straight away, and drop the metadata
+					// like brokers which don't keep it.
+					if d, ok := req.Metadata[runtimePubsub.MetadataDeliverAfter]; ok {
+						delays = append(delays, d)
+					}
+					req.Metadata = nil
+					return comp.Publish(ctx, req)
+				},
+			}
+
+			var topics []string
+			postman := postmanfake.New().WithDeliverFn(func(ctx context.Context, msg *runtimePubsub.SubscribedMessage) error {
+				topics = append(topics, msg.Topic)
+				return errors.New("failed")
+			})
+
+			ps, err := New(Options{
+				Resiliency: resiliency.New(log),
+				Postman:    postman,
+				PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+				Adapter:    adapter,
+				AppID:      TestRuntimeConfigID,
+				PubSubName: "testpubsub",
+				Topic:      "topic0",
+				Route: runtimePubsub.Subscription{
+					Metadata: map[string]string{"rawPayload": strconv.FormatBool(rawPayload)},
+					Rules: []*runtimePubsub.Rule{
+						{Path: "orders"},
+					},
+					DeadLetterTopic: "topic0-dlq",
+					RetryTopics: []runtimePubsub.RetryTopic{
+						{Topic: "topic0-retry-10s", Delay: 10 * time.Second},
+						{Topic: "topic0-retry-1m", Delay: time.Minute},
+					},
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(ps.Stop)
+
+			require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+				PubsubName: "testpubsub",
+				Topic:      "topic0",
+				Data:       []byte(`{"specversion":"1.0","id":"1","source":"test","type":"test","data":{"orderId":"1"}}`),
+			}))
+
+			assert.Equal(t, []string{"topic0", "topic0", "topic0"}, topics)
+			assert.Equal(t, []string{"10s", "1m0s"}, delays)
+			assert.Equal(t, 1, comp.pubCount["topic0-retry-10s"])
+			assert.Equal(t, 1, comp.pubCount["topic0-retry-1m"])
+			assert.Equal(t, 1, comp.pubCount["topic0-dlq"])
+		})
+	}
+}
+
+func TestRetryTopicsBulk(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	_, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postmanfake.New(),
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			BulkSubscribe: &runtimePubsub.BulkSubscribe{Enabled: true},
+			RetryTopics: []runtimePubsub.RetryTopic{
+				{Topic: "topic0-retry-10s", Delay: 10 * time.Second},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "retry topics are not supported for bulk subscriptions")
+}
+
+func TestSchemaValidation(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	cs := newDedupCompStore(t)
+	store, _ := cs.GetStateStore("dedupstore")
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{
+		Key:   "topic0",
+		Value: []byte(`{"type": "object", "required": ["orderId"]}`),
+	}))
+
+	var deadLettered []string
+	adapter := &daprt.MockPubSubAdapter{
+		PublishFn: func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+			deadLettered = append(deadLettered, req.Topic)
+			return nil
+		},
+	}
+
+	var delivered []map[string]any
+	postman := postmanfake.New().WithDeliverFn(func(ctx context.Context, msg *runtimePubsub.SubscribedMessage) error {
+		delivered = append(delivered, msg.CloudEvent)
+		return nil
+	})
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postman,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		Adapter:    adapter,
+		CompStore:  cs,
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Metadata: map[string]string{schema.MetadataStore: "dedupstore"},
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+			DeadLetterTopic: "topic0-dlq",
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+
+	require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+		PubsubName: "testpubsub",
+		Topic:      "topic0",
+		Data:       []byte(`{"specversion":"1.0","id":"1","source":"test","type":"test","data":{"orderId":1}}`),
+	}))
+	require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+		PubsubName: "testpubsub",
+		Topic:      "topic0",
+		Data:       []byte(`{"specversion":"1.0","id":"2","source":"test","type":"test","data":{"id":2}}`),
+	}))
+
+	require.Len(t, delivered, 1)
+	assert.Equal(t, "1", delivered[0]["id"])
+	assert.Equal(t, "dapr://dedupstore/topic0", delivered[0]["dataschema"])
+	assert.Equal(t, []string{"topic0-dlq"}, deadLettered)
+
+	// Invalid events are retried when they can't be sent to the dead letter
+	// topic.
+	adapter.PublishFn = func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+		return errors.New("dlq unavailable")
+	}
+	err = comp.handlers["topic0"](t.Context(), &contribpubsub.NewMessage{
+		Topic: "topic0",
+		Data:  []byte(`{"specversion":"1.0","id":"3","source":"test","type":"test","data":{"id":3}}`),
+	})
+	require.ErrorAs(t, err, &schema.ValidationError{})
+	assert.Len(t, delivered, 1)
+
+	_, err = New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postman,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		CompStore:  cs,
+		PubSubName: "testpubsub",
+		Topic:      "topic1",
+		Route: runtimePubsub.Subscription{
+			Metadata: map[string]string{schema.MetadataValidate: "true"},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestSchemaValidationBulk(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	cs := newDedupCompStore(t)
+	store, _ := cs.GetStateStore("dedupstore")
+	require.NoError(t, store.Set(t.Context(), &state.SetRequest{
+		Key:   "topic0",
+		Value: []byte(`{"type": "object", "required": ["orderId"]}`),
+	}))
+
+	var deadLettered []string
+	adapter := &daprt.MockPubSubAdapter{
+		BulkPublishFn: func(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+			for _, entry := range req.Entries {
+				deadLettered = append(deadLettered, entry.EntryId)
+			}
+			return contribpubsub.BulkPublishResponse{}, nil
+		},
+	}
+
+	var delivered int
+	fake := postmanfake.New().WithDeliverBulkFn(func(ctx context.Context, req *postman.DeliverBulkRequest) error {
+		delivered += len(req.BulkSubMsg.PubSubMessages)
+		return nil
+	})
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    fake,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		Adapter:    adapter,
+		CompStore:  cs,
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Metadata: map[string]string{schema.MetadataStore: "dedupstore"},
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+			DeadLetterTopic: "topic0-dlq",
+			BulkSubscribe: &runtimePubsub.BulkSubscribe{
+				Enabled: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+
+	msg := func() *contribpubsub.BulkMessage {
+		return &contribpubsub.BulkMessage{
+			Topic: "topic0",
+			Entries: []contribpubsub.BulkMessageEntry{
+				{EntryId: "1", Event: []byte(`{"specversion":"1.0","id":"1","source":"test","type":"test","data":{"orderId":1}}`)},
+				{EntryId: "2", Event: []byte(`{"specversion":"1.0","id":"2","source":"test","type":"test","data":{"id":2}}`)},
+			},
+		}
+	}
+
+	_, err = comp.bulkHandlers["topic0"](t.Context(), msg())
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []string{"2"}, deadLettered)
+
+	// Invalid entries are retried when they can't be sent to the dead letter
+	// topic.
+	adapter.BulkPublishFn = func(ctx context.Context, req *contribpubsub.BulkPublishRequest) (contribpubsub.BulkPublishResponse, error) {
+		return contribpubsub.BulkPublishResponse{}, errors.New("dlq unavailable")
+	}
+	res, err := comp.bulkHandlers["topic0"](t.Context(), msg())
+	require.ErrorAs(t, err, &schema.ValidationError{})
+	require.Len(t, res, 2)
+	require.NoError(t, res[0].Error)
+	require.ErrorAs(t, res[1].Error, &schema.ValidationError{})
+}
+
+func TestTransformation(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	var (
+		deadLettered [][]byte
+		dlqErr       error
+	)
+	adapter := &daprt.MockPubSubAdapter{
+		PublishFn: func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+			if dlqErr != nil {
+				return dlqErr
+			}
+			deadLettered = append(deadLettered, req.Data)
+			return nil
+		},
+	}
+
+	transform, err := runtimePubsub.CreateTransform("data.detail",
+		map[string]string{"orderId": "data.id"},
+		map[string]string{"x-source": "event.source"},
+	)
+	require.NoError(t, err)
+
+	var delivered []*runtimePubsub.SubscribedMessage
+	postman := postmanfake.New().WithDeliverFn(func(ctx context.Context, msg *runtimePubsub.SubscribedMessage) error {
+		delivered = append(delivered, msg)
+		return nil
+	})
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postman,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		Adapter:    adapter,
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Transform: transform,
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+			DeadLetterTopic: "topic0-dlq",
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+
+	require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+		PubsubName: "testpubsub",
+		Topic:      "topic0",
+		Data:       []byte(`{"specversion":"1.0","id":"1","source":"partner","type":"test","data":{"detail":{"id":"o1"}}}`),
+	}))
+	failing := []byte(`{"specversion":"1.0","id":"2","source":"partner","type":"test","data":{"other":true}}`)
+	require.NoError(t, comp.Publish(t.Context(), &contribpubsub.PublishRequest{
+		PubsubName: "testpubsub",
+		Topic:      "topic0",
+		Data:       failing,
+	}))
+
+	require.Len(t, delivered, 1)
+	assert.Equal(t, map[string]any{"orderId": "o1"}, delivered[0].CloudEvent["data"])
+	assert.Equal(t, "partner", delivered[0].Metadata["x-source"])
+	var sent map[string]any
+	require.NoError(t, json.Unmarshal(delivered[0].Data, &sent))
+	assert.Equal(t, map[string]any{"orderId": "o1"}, sent["data"])
+	assert.Equal(t, [][]byte{failing}, deadLettered)
+
+	// Events which fail to transform are retried only when they can't be sent
+	// to the dead letter topic.
+	dlqErr = errors.New("dlq unavailable")
+	err = comp.handlers["topic0"](t.Context(), &contribpubsub.NewMessage{Topic: "topic0", Data: failing})
+	require.ErrorIs(t, err, dlqErr)
+
+	// Without a dead letter topic, they are dropped.
+	ps, err = New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    postman,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		Adapter:    adapter,
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic1",
+		Route: runtimePubsub.Subscription{
+			Transform: transform,
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+	err = comp.handlers["topic1"](t.Context(), &contribpubsub.NewMessage{Topic: "topic1", Data: failing})
+	require.NoError(t, err)
+	assert.Len(t, delivered, 1)
+}
+
+func TestTransformationBulk(t *testing.T) {
+	comp := &mockSubscribePubSub{}
+	require.NoError(t, comp.Init(t.Context(), contribpubsub.Metadata{}))
+
+	var deadLettered [][]byte
+	adapter := &daprt.MockPubSubAdapter{
+		PublishFn: func(ctx context.Context, req *contribpubsub.PublishRequest) error {
+			deadLettered = append(deadLettered, req.Data)
+			return nil
+		},
+	}
+
+	transform, err := runtimePubsub.CreateTransform("data.detail",
+		map[string]string{"orderId": "data.id"},
+		map[string]string{"x-source": "event.source"},
+	)
+	require.NoError(t, err)
+
+	var delivered []todo.Message
+	fake := postmanfake.New().WithDeliverBulkFn(func(ctx context.Context, req *postman.DeliverBulkRequest) error {
+		delivered = append(delivered, req.BulkSubMsg.PubSubMessages...)
+		return nil
+	})
+
+	ps, err := New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    fake,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		Adapter:    adapter,
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic0",
+		Route: runtimePubsub.Subscription{
+			Transform: transform,
+			Rules: []*runtimePubsub.Rule{
+				{Path: "orders"},
+			},
+			DeadLetterTopic: "topic0-dlq",
+			BulkSubscribe: &runtimePubsub.BulkSubscribe{
+				Enabled: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(ps.Stop)
+
+	original := []byte(`{"specversion":"1.0","id":"1","source":"partner","type":"test","data":{"detail":{"id":"o1"}}}`)
+	failing := []byte(`{"specversion":"1.0","id":"2","source":"partner","type":"test","data":{"other":true}}`)
+	res, err := comp.bulkHandlers["topic0"](t.Context(), &contribpubsub.BulkMessage{
+		Topic: "topic0",
+		Entries: []contribpubsub.BulkMessageEntry{
+			{EntryId: "1", Event: original, Metadata: map[string]string{"k": "v"}},
+			{EntryId: "2", Event: failing},
+		},
+	})
+	require.NoError(t, err)
+	for _, r := range res {
+		require.NoError(t, r.Error)
+	}
+
+	require.Len(t, delivered, 1)
+	assert.Equal(t, map[string]any{"orderId": "o1"}, delivered[0].CloudEvent["data"])
+	assert.Equal(t, map[string]any{"orderId": "o1"}, delivered[0].RawData.Event.(map[string]any)["data"])
+	assert.Equal(t, map[string]string{"k": "v", "x-source": "partner"}, delivered[0].RawData.Metadata)
+	// The original entry is kept for the dead letter topic.
+	assert.Equal(t, original, delivered[0].Entry.Event)
+	assert.Equal(t, map[string]string{"k": "v"}, delivered[0].Entry.Metadata)
+	assert.Equal(t, [][]byte{failing}, deadLettered)
+
+	// Raw payloads have no cloud event to transform.
+	_, err = New(Options{
+		Resiliency: resiliency.New(log),
+		Postman:    fake,
+		PubSub:     &runtimePubsub.PubsubItem{Component: comp},
+		AppID:      TestRuntimeConfigID,
+		PubSubName: "testpubsub",
+		Topic:      "topic1",
+		Route: runtimePubsub.Subscription{
+			Metadata:      map[string]string{"rawPayload": "true"},
+			Transform:     transform,
+			BulkSubscribe: &runtimePubsub.BulkSubscribe{Enabled: true},
+		},
+	})
+	require.ErrorContains(t, err, "transforms are not supported for bulk subscriptions with raw payloads")
+}
@@ -19,12 +19,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/google/uuid"
@@ -32,13 +34,21 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	actorerrors "github.com/dapr/dapr/pkg/actors/errors"
 	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/activity"
+	limiteractor "github.com/dapr/dapr/pkg/actors/targets/workflow/limiter"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
+	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/history"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/lifecycle"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
 	"github.com/dapr/dapr/utils"
@@ -58,6 +68,7 @@ const (
 	defaultNamespace     = "default"
 	WorkflowNameLabelKey = "workflow"
 	ActivityNameLabelKey = "activity"
+	LimiterNameLabelKey  = "limiter"
 	ActorTypePrefix      = "dapr.internal."
 )
 
@@ -68,12 +79,18 @@ type Options struct {
 	Resiliency         resiliency.Provider
 	SchedulerReminders bool
 	EventSink          orchestrator.EventSink
+	SearchIndex        *search.Index
+	HistoryLimits      *history.Limits
+	Limits             []limiter.Limit
+	Payloads           *payload.Store
+	Lifecycle          *lifecycle.Lifecycle
 }
 
 type Actors struct {
 	appID             string
 	workflowActorType string
 	activityActorType string
+	limiterActorType  string
 
 	pendingTasksBackend     PendingTasksBackend
 	defaultReminderInterval *time.Duration
@@ -81,6 +98,15 @@ type Actors struct {
 	actors                  actors.Interface
 	schedulerReminders      bool
 	eventSink               orchestrator.EventSink
+	searchIndex             *search.Index
+	historyLimits           *history.Limits
+	payloads                *payload.Store
+	lifecycle               *lifecycle.Lifecycle
+	limits                  []limiter.Limit
+	versions                *workerVersions
+
+	workflowFactoryLock sync.Mutex
+	workflowFactory     targets.Factory
 
 	orchestrationWorkItemChan chan *backend.OrchestrationWorkItem
 	activityWorkItemChan      chan *backend.ActivityWorkItem
@@ -91,6 +117,7 @@ func New(opts Options) *Actors {
 		appID:                     opts.AppID,
 		workflowActorType:         ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + WorkflowNameLabelKey,
 		activityActorType:         ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ActivityNameLabelKey,
+		limiterActorType:          ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + LimiterNameLabelKey,
 		actors:                    opts.Actors,
 		resiliency:                opts.Resiliency,
 		schedulerReminders:        opts.SchedulerReminders,
@@ -98,6 +125,12 @@ func New(opts Options) *Actors {
 		orchestrationWorkItemChan: make(chan *backend.OrchestrationWorkItem, 1),
 		activityWorkItemChan:      make(chan *backend.ActivityWorkItem, 1),
 		eventSink:                 opts.EventSink,
+		searchIndex:               opts.SearchIndex,
+		historyLimits:             opts.HistoryLimits,
+		limits:                    opts.Limits,
+		payloads:                  opts.Payloads,
+		lifecycle:                 opts.Lifecycle,
+		versions:                  newWorkerVersions(),
 	}
 }
 
@@ -110,8 +143,19 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		return err
 	}
 
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+	wfLimiter := limiter.New(limiter.Options{
+		Limits:    abe.limits,
+		Router:    router,
+		ActorType: abe.limiterActorType,
+	})
+
 	oopts := orchestrator.Options{
 		AppID:             abe.appID,
+		Versions:          abe.versions,
 		WorkflowActorType: abe.workflowActorType,
 		ActivityActorType: abe.activityActorType,
 		ReminderInterval:  abe.defaultReminderInterval,
@@ -128,6 +172,11 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		},
 		SchedulerReminders: abe.schedulerReminders,
 		EventSink:          abe.eventSink,
+		SearchIndex:        abe.searchIndex,
+		HistoryLimits:      abe.historyLimits,
+		Payloads:           abe.payloads,
+		Lifecycle:          abe.lifecycle,
+		Limiter:            wfLimiter,
 	}
 
 	aopts := activity.Options{
@@ -150,6 +199,8 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		},
 		Actors:             abe.actors,
 		SchedulerReminders: abe.schedulerReminders,
+		Limiter:            wfLimiter,
+		Payloads:           abe.payloads,
 	}
 
 	workflowFactory, activityFactory, err := workflow.Factories(ctx, oopts, aopts)
@@ -157,18 +208,33 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		return err
 	}
 
+	abe.workflowFactoryLock.Lock()
+	defer abe.workflowFactoryLock.Unlock()
+	abe.workflowFactory = workflowFactory
+
+	factories := []table.ActorTypeFactory{
+		abe.workflowActorTypeFactory(),
+		{
+			Factory: activityFactory,
+			Type:    abe.activityActorType,
+		},
+	}
+
+	// Limits enforced across all the instances of the app are coordinated by
+	// limiter actors.
+	if limiter.HasClusterLimits(abe.limits) {
+		factories = append(factories, table.ActorTypeFactory{
+			Factory: limiteractor.Factory(limiteractor.Options{
+				ActorType: abe.limiterActorType,
+				Limits:    abe.limits,
+			}),
+			Type: abe.limiterActorType,
+		})
+	}
+
 	atable.RegisterActorTypes(
 		table.RegisterActorTypeOptions{
-			Factories: []table.ActorTypeFactory{
-				{
-					Factory: workflowFactory,
-					Type:    abe.workflowActorType,
-				},
-				{
-					Factory: activityFactory,
-					Type:    abe.activityActorType,
-				},
-			},
+			Factories: factories,
 		},
 	)
 
@@ -181,7 +247,74 @@ func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 		return err
 	}
 
-	return table.UnRegisterActorTypes(abe.workflowActorType, abe.activityActorType)
+	abe.workflowFactoryLock.Lock()
+	abe.workflowFactory = nil
+	abe.workflowFactoryLock.Unlock()
+
+	actorTypes := []string{abe.workflowActorType, abe.activityActorType}
+	if limiter.HasClusterLimits(abe.limits) {
+		actorTypes = append(actorTypes, abe.limiterActorType)
+	}
+	return table.UnRegisterActorTypes(actorTypes...)
+}
+
+// WorkerConnected records the workflow versions advertised by a worker
+// connecting to the work item stream of the given context.
+func (abe *Actors) WorkerConnected(ctx context.Context) error {
+	if !abe.versions.connect(WorkerVersionsFromContext(ctx)) {
+		return nil
+	}
+	return abe.reregisterWorkflowActor(ctx)
+}
+
+// WorkerDisconnected removes the workflow versions advertised by a worker
+// disconnecting from the work item stream of the given context.
+func (abe *Actors) WorkerDisconnected(ctx context.Context) error {
+	if !abe.versions.disconnect(WorkerVersionsFromContext(ctx)) {
+		return nil
+	}
+	return abe.reregisterWorkflowActor(context.Background())
+}
+
+// reregisterWorkflowActor updates the version with which the workflow actor
+// type is registered after the current worker version has changed.
+func (abe *Actors) reregisterWorkflowActor(ctx context.Context) error {
+	abe.workflowFactoryLock.Lock()
+	defer abe.workflowFactoryLock.Unlock()
+
+	// The actor type is registered with the current version once the first
+	// worker connects.
+	if abe.workflowFactory == nil {
+		return nil
+	}
+
+	atable, err := abe.actors.Table(ctx)
+	if err != nil {
+		return err
+	}
+
+	atable.RegisterActorTypes(table.RegisterActorTypeOptions{
+		Factories: []table.ActorTypeFactory{abe.workflowActorTypeFactory()},
+	})
+
+	return nil
+}
+
+// workflowActorTypeFactory returns the registration of the workflow actor
+// type. Workflows are only replayed by workers which are compatible with the
+// version they were started with, so the type is registered with the current
+// worker version, pinning in-flight workflows to the hosts of the oldest
+// version until they are removed.
+func (abe *Actors) workflowActorTypeFactory() table.ActorTypeFactory {
+	factory := table.ActorTypeFactory{
+		Factory: abe.workflowFactory,
+		Type:    abe.workflowActorType,
+	}
+	if version := abe.versions.Current(); len(version) > 0 {
+		factory.Version = version
+		factory.VersionRouting = config.ActorVersionRoutingPinned
+	}
+	return factory
 }
 
 // RerunWorkflowFromEvent implements backend.Backend and reruns a workflow from
@@ -290,6 +423,19 @@ func (abe *Actors) CreateOrchestrationInstance(ctx context.Context, e *backend.H
 	return nil
 }
 
+// GetWorkflowHistory returns the history of the current execution of the
+// workflow with the given instance ID.
+func (abe *Actors) GetWorkflowHistory(ctx context.Context, id api.InstanceID) ([]*backend.HistoryEvent, error) {
+	state, err := abe.loadInternalState(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, api.ErrInstanceNotFound
+	}
+	return state.History, nil
+}
+
 // GetOrchestrationMetadata implements backend.Backend
 func (abe *Actors) GetOrchestrationMetadata(ctx context.Context, id api.InstanceID) (*backend.OrchestrationMetadata, error) {
 	state, err := abe.loadInternalState(ctx, id)
@@ -307,12 +453,16 @@ func (abe *Actors) GetOrchestrationMetadata(ctx context.Context, id api.Instance
 	lastUpdated, _ := runtimestate.LastUpdatedTime(rstate)
 	input, _ := runtimestate.Input(rstate)
 	output, _ := runtimestate.Output(rstate)
+	status := runtimestate.RuntimeStatus(rstate)
 	failureDetuils, _ := runtimestate.FailureDetails(rstate)
+	if failureDetuils == nil {
+		failureDetuils = state.StalledFailureDetails(status)
+	}
 
 	return &backend.OrchestrationMetadata{
 		InstanceId:     string(id),
 		Name:           name,
-		RuntimeStatus:  runtimestate.RuntimeStatus(rstate),
+		RuntimeStatus:  status,
 		CreatedAt:      timestamppb.New(createdAt),
 		LastUpdatedAt:  timestamppb.New(lastUpdated),
 		Input:          input,
@@ -379,6 +529,99 @@ func (abe *Actors) AddNewOrchestrationEvent(ctx context.Context, id api.Instance
 	return nil
 }
 
+// SignalWithStart sends the event e to the workflow actor of the start event,
+// which creates the workflow first if it does not exist or is completed.
+func (abe *Actors) SignalWithStart(ctx context.Context, startEvent *backend.HistoryEvent, e *backend.HistoryEvent) error {
+	es := startEvent.GetExecutionStarted()
+	if es == nil {
+		return errors.New("the history event must be an ExecutionStartedEvent")
+	}
+	if es.GetOrchestrationInstance().GetInstanceId() == "" {
+		return errors.New("the ExecutionStartedEvent did not contain orchestration instance information")
+	}
+	if e.GetEventRaised() == nil {
+		return errors.New("the signal must be an EventRaisedEvent")
+	}
+
+	data, err := proto.Marshal(&protos.HistoryChunk{Events: []*protos.HistoryEvent{startEvent, e}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal signal with start request: %w", err)
+	}
+
+	req := internalsv1pb.
+		NewInternalInvokeRequest(todo.SignalWithStartMethod).
+		WithActor(abe.workflowActorType, es.GetOrchestrationInstance().GetInstanceId()).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType)
+
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	_, err = router.Call(ctx, req)
+	elapsed := diag.ElapsedSince(start)
+	if err != nil {
+		diag.DefaultWorkflowMonitoring.WorkflowOperationEvent(ctx, diag.AddEvent, diag.StatusFailed, elapsed)
+		return err
+	}
+	diag.DefaultWorkflowMonitoring.WorkflowOperationEvent(ctx, diag.AddEvent, diag.StatusSuccess, elapsed)
+	return nil
+}
+
+// UpdateWorkflow sends the update event e to the running workflow identified
+// by id, and waits for the workflow to respond to it by sending an event with
+// the same name to its own instance.
+func (abe *Actors) UpdateWorkflow(ctx context.Context, id api.InstanceID, e *backend.HistoryEvent) (*protos.EventSentEvent, error) {
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	req := internalsv1pb.
+		NewInternalInvokeRequest(todo.UpdateWorkflowMethod).
+		WithActor(abe.workflowActorType, string(id)).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType)
+
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream := make(chan *internalsv1pb.InternalInvokeResponse, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- router.CallStream(ctx, req, stream)
+	}()
+
+	var val *internalsv1pb.InternalInvokeResponse
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case val = <-stream:
+	case err = <-errCh:
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case val = <-stream:
+		default:
+			return nil, errors.New("the workflow did not respond to the update")
+		}
+	}
+
+	var resp protos.EventSentEvent
+	if err = val.GetMessage().GetData().UnmarshalTo(&resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal update response: %w", err)
+	}
+	return &resp, nil
+}
+
 // CompleteActivityWorkItem implements backend.Backend
 func (*Actors) CompleteActivityWorkItem(ctx context.Context, wi *backend.ActivityWorkItem) error {
 	// Sending true signals the waiting activity actor to complete the execution normally.
@@ -521,6 +764,7 @@ func (abe *Actors) loadInternalState(ctx context.Context, id api.InstanceID) (*s
 		AppID:             abe.appID,
 		WorkflowActorType: abe.workflowActorType,
 		ActivityActorType: abe.activityActorType,
+		Payloads:          abe.payloads,
 	})
 	if err != nil {
 		return nil, err
@@ -566,6 +810,27 @@ func (abe *Actors) ActivityActorType() string {
 	return abe.activityActorType
 }
 
+// ActivityHeartbeat records the liveness of the running activity with the
+// given task ID of a workflow.
+func (abe *Actors) ActivityHeartbeat(ctx context.Context, instanceID string, taskID int32) error {
+	requestBytes, err := proto.Marshal(wrapperspb.Int32(taskID))
+	if err != nil {
+		return err
+	}
+
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = router.Call(ctx, internalsv1pb.NewInternalInvokeRequest(todo.ActivityHeartbeatMethod).
+		WithActor(abe.workflowActorType, instanceID).
+		WithData(requestBytes).
+		WithContentType(invokev1.ProtobufContentType),
+	)
+	return err
+}
+
 // CancelActivityTask implements backend.Backend.
 func (abe *Actors) CancelActivityTask(ctx context.Context, instanceID api.InstanceID, taskID int32) error {
 	return abe.pendingTasksBackend.CancelActivityTask(ctx, instanceID, taskID)
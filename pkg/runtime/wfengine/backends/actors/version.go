@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// WorkerVersionMetadataKey is the gRPC metadata key with which a worker
+// advertises the workflow versions it is able to execute when it connects to
+// the work item stream. The value is a comma separated list of versions, the
+// first of which is the version recorded for new workflows which are started
+// without an explicit version.
+const WorkerVersionMetadataKey = "dapr-workflow-version"
+
+// WorkerVersionsFromContext returns the workflow versions advertised by the
+// worker of the given work item stream context.
+func WorkerVersionsFromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	var versions []string
+	for _, val := range md.Get(WorkerVersionMetadataKey) {
+		for _, v := range strings.Split(val, ",") {
+			if v = strings.TrimSpace(v); len(v) > 0 {
+				versions = append(versions, v)
+			}
+		}
+	}
+
+	return versions
+}
+
+// workerVersions tracks the workflow versions advertised by every connected
+// worker. Work items are dispatched to any of the connected workers, so only
+// the versions which all versioned workers advertise are compatible. Workers
+// which don't advertise versions don't constrain compatibility.
+type workerVersions struct {
+	lock sync.RWMutex
+	seq  uint64
+
+	// workers is keyed by the comma separated versions of the workers.
+	workers map[string]*connectedWorkers
+}
+
+type connectedWorkers struct {
+	versions  []string
+	count     int
+	connected uint64
+}
+
+func newWorkerVersions() *workerVersions {
+	return &workerVersions{
+		workers: make(map[string]*connectedWorkers),
+	}
+}
+
+// connect records a worker advertising the given versions. It returns true if
+// the current version has changed.
+func (w *workerVersions) connect(versions []string) bool {
+	if len(versions) == 0 {
+		return false
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	before := w.current()
+
+	key := strings.Join(versions, ",")
+	c, ok := w.workers[key]
+	if !ok {
+		c = &connectedWorkers{versions: versions}
+		w.workers[key] = c
+	}
+	c.count++
+	w.seq++
+	c.connected = w.seq
+
+	return before != w.current()
+}
+
+// disconnect removes a worker advertising the given versions. It returns true
+// if the current version has changed.
+func (w *workerVersions) disconnect(versions []string) bool {
+	if len(versions) == 0 {
+		return false
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	key := strings.Join(versions, ",")
+	c, ok := w.workers[key]
+	if !ok {
+		return false
+	}
+
+	before := w.current()
+	if c.count--; c.count <= 0 {
+		delete(w.workers, key)
+	}
+
+	return before != w.current()
+}
+
+// Compatible implements orchestrator.Versions.
+func (w *workerVersions) Compatible() []string {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.compatible()
+}
+
+// Current implements orchestrator.Versions.
+func (w *workerVersions) Current() string {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.current()
+}
+
+// compatible returns nil if no versioned worker is connected, or the possibly
+// empty versions which all of them advertise.
+func (w *workerVersions) compatible() []string {
+	var compatible []string
+	for _, c := range w.workers {
+		if compatible == nil {
+			compatible = slices.Clone(c.versions)
+			continue
+		}
+		compatible = slices.DeleteFunc(compatible, func(v string) bool {
+			return !slices.Contains(c.versions, v)
+		})
+	}
+	slices.Sort(compatible)
+	return compatible
+}
+
+// current returns the preferred version of the most recently connected worker
+// which is compatible with all the connected workers.
+func (w *workerVersions) current() string {
+	compatible := w.compatible()
+
+	var latest *connectedWorkers
+	for _, c := range w.workers {
+		if latest == nil || c.connected > latest.connected {
+			latest = c
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+
+	for _, v := range latest.versions {
+		if slices.Contains(compatible, v) {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWorkerVersionsFromContext(t *testing.T) {
+	assert.Empty(t, WorkerVersionsFromContext(t.Context()))
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(
+		WorkerVersionMetadataKey, "v2, v1",
+		WorkerVersionMetadataKey, "v0,",
+	))
+	assert.Equal(t, []string{"v2", "v1", "v0"}, WorkerVersionsFromContext(ctx))
+}
+
+func TestWorkerVersions(t *testing.T) {
+	w := newWorkerVersions()
+	assert.Nil(t, w.Compatible())
+	assert.Empty(t, w.Current())
+
+	assert.False(t, w.connect(nil))
+	assert.Nil(t, w.Compatible())
+
+	assert.True(t, w.connect([]string{"v1"}))
+	assert.Equal(t, []string{"v1"}, w.Compatible())
+	assert.Equal(t, "v1", w.Current())
+
+	// A newer worker which is able to replay the old version becomes current
+	// once all the old workers are gone.
+	assert.False(t, w.connect([]string{"v2", "v1"}))
+	assert.Equal(t, []string{"v1"}, w.Compatible())
+	assert.Equal(t, "v1", w.Current())
+
+	assert.True(t, w.disconnect([]string{"v1"}))
+	assert.Equal(t, []string{"v1", "v2"}, w.Compatible())
+	assert.Equal(t, "v2", w.Current())
+
+	// Workers which don't share a version are not compatible with any.
+	assert.True(t, w.connect([]string{"v3"}))
+	assert.Equal(t, []string{}, w.Compatible())
+	assert.Empty(t, w.Current())
+
+	assert.True(t, w.disconnect([]string{"v3"}))
+	assert.False(t, w.disconnect([]string{"v3"}))
+	assert.Equal(t, "v2", w.Current())
+
+	assert.True(t, w.disconnect([]string{"v2", "v1"}))
+	assert.Nil(t, w.Compatible())
+}
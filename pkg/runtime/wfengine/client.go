@@ -20,11 +20,21 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/helpers"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 
 	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 // Status values are defined at: https://github.com/dapr/durabletask-go/blob/119b361079c45e368f83b223888d56a436ac59b9/internal/protos/orchestrator_service.pb.go#L42-L64
@@ -40,8 +50,9 @@ var statusMap = map[int32]string{
 }
 
 type client struct {
-	logger logger.Logger
-	client backend.TaskHubClient
+	logger  logger.Logger
+	client  backend.TaskHubClient
+	backend backend.Backend
 }
 
 func (c *client) Init(metadata workflows.Metadata) error {
@@ -54,34 +65,24 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 		return nil, errors.New("a workflow name is required")
 	}
 
-	// Init with capacity of 3 as worst-case scenario
-	opts := make([]api.NewOrchestrationOptions, 0, 3)
-
 	// Specifying the ID is optional - if not specified, a random ID will be generated by the client.
-	if req.InstanceID != nil {
-		opts = append(opts, api.WithInstanceID(api.InstanceID(*req.InstanceID)))
+	instanceID := req.InstanceID
+	if instanceID == nil || *instanceID == "" {
+		instanceID = ptr.Of(uuid.NewString())
 	}
 
-	// Input is also optional. However, inputs are expected to be unprocessed string values (e.g. JSON text)
-	if req.WorkflowInput != nil {
-		opts = append(opts, api.WithRawInput(req.WorkflowInput))
-	}
-
-	// Start time is also optional and must be in the RFC3339 format (e.g. 2009-11-10T23:00:00Z).
-	if req.Options != nil {
-		if startTimeRFC3339, ok := req.Options["dapr.workflow.start_time"]; ok {
-			if startTime, err := time.Parse(time.RFC3339, startTimeRFC3339); err != nil {
-				return nil, errors.New(`start times must be in RFC3339 format (e.g. "2009-11-10T23:00:00Z")`)
-			} else {
-				opts = append(opts, api.WithStartTime(startTime))
-			}
-		}
+	ctx, startEvent, span, err := newExecutionStartedEvent(ctx, req, *instanceID)
+	if err != nil {
+		return nil, err
 	}
+	defer span.End()
 
-	workflowID, err := c.client.ScheduleNewOrchestration(ctx, req.WorkflowName, opts...)
-	if err != nil {
+	if err = c.backend.CreateOrchestrationInstance(ctx, startEvent); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, fmt.Errorf("unable to start workflow: %w", err)
 	}
+	workflowID := *instanceID
 
 	c.logger.Debugf("Created new workflow '%s' instance with ID '%s'", req.WorkflowName, workflowID)
 	res := &workflows.StartResponse{
@@ -90,6 +91,81 @@ func (c *client) Start(ctx context.Context, req *workflows.StartRequest) (*workf
 	return res, nil
 }
 
+// newExecutionStartedEvent returns the event which starts the workflow of the
+// request, and the context of the span of its creation which must be ended by
+// the caller. Inputs are expected to be unprocessed string values (e.g. JSON
+// text).
+func newExecutionStartedEvent(ctx context.Context, req *workflows.StartRequest, instanceID string) (context.Context, *protos.HistoryEvent, trace.Span, error) {
+	// Start time is optional and must be in the RFC3339 format (e.g. 2009-11-10T23:00:00Z).
+	var scheduledStart *timestamppb.Timestamp
+	startTime, err := startTimeOption(req.Options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if startTime != nil {
+		scheduledStart = timestamppb.New(*startTime)
+	}
+
+	tags, err := startTags(req.Options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, span := helpers.StartNewCreateOrchestrationSpan(ctx, req.WorkflowName, "", instanceID)
+
+	return ctx, &protos.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.Now(),
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:  req.WorkflowName,
+				Input: req.WorkflowInput,
+				OrchestrationInstance: &protos.OrchestrationInstance{
+					InstanceId:  instanceID,
+					ExecutionId: wrapperspb.String(uuid.New().String()),
+				},
+				ParentTraceContext:      helpers.TraceContextFromSpan(span),
+				ScheduledStartTimestamp: scheduledStart,
+				Tags:                    tags,
+			},
+		},
+	}, span, nil
+}
+
+// startTimeOption returns the scheduled start time of the start workflow
+// options, if any.
+func startTimeOption(options map[string]string) (*time.Time, error) {
+	startTimeRFC3339, ok := options["dapr.workflow.start_time"]
+	if !ok {
+		return nil, nil
+	}
+	startTime, err := time.Parse(time.RFC3339, startTimeRFC3339)
+	if err != nil {
+		return nil, errors.New(`start times must be in RFC3339 format (e.g. "2009-11-10T23:00:00Z")`)
+	}
+	return &startTime, nil
+}
+
+// startTags returns the tags of the workflow for the start workflow options.
+// Timeouts enforced by the engine are recorded as tags of the workflow.
+func startTags(options map[string]string) (map[string]string, error) {
+	var tags map[string]string
+	for _, option := range orchestrator.TimeoutOptions {
+		v, ok := options[option]
+		if !ok {
+			continue
+		}
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return nil, fmt.Errorf("option '%s' must be a positive duration (e.g. \"30s\"): %s", option, v)
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[option] = v
+	}
+	return tags, nil
+}
+
 func (c *client) Terminate(ctx context.Context, req *workflows.TerminateRequest) error {
 	if req.InstanceID == "" {
 		return errors.New("a workflow instance ID is required")
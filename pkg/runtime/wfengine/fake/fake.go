@@ -19,8 +19,11 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
 )
 
 type Fake struct {
@@ -29,6 +32,12 @@ type Fake struct {
 	registerGrpcServerFn func(*grpc.Server)
 	waitForReadyFn       func(context.Context) error
 	clientFn             func() workflows.Workflow
+	activityHeartbeatFn  func(ctx context.Context, instanceID string, taskID int32) error
+	signalWithStartFn    func(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error
+	updateWorkflowFn     func(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+	listWorkflowsFn      func(ctx context.Context, query *search.Query) (*search.QueryResponse, error)
+	workflowHistoryFn    func(ctx context.Context, instanceID string) (*replay.History, error)
+	replayWorkflowFn     func(ctx context.Context, history *replay.History) (*replay.Result, error)
 }
 
 func New() *Fake {
@@ -38,6 +47,22 @@ func New() *Fake {
 		registerGrpcServerFn: func(*grpc.Server) {},
 		waitForReadyFn:       func(context.Context) error { return nil },
 		clientFn:             func() workflows.Workflow { return NewClient() },
+		activityHeartbeatFn:  func(context.Context, string, int32) error { return nil },
+		signalWithStartFn: func(context.Context, *workflows.StartRequest, string, *wrapperspb.StringValue) error {
+			return nil
+		},
+		updateWorkflowFn: func(context.Context, string, string, *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+			return nil, nil
+		},
+		listWorkflowsFn: func(context.Context, *search.Query) (*search.QueryResponse, error) {
+			return &search.QueryResponse{}, nil
+		},
+		workflowHistoryFn: func(_ context.Context, instanceID string) (*replay.History, error) {
+			return &replay.History{InstanceID: instanceID}, nil
+		},
+		replayWorkflowFn: func(context.Context, *replay.History) (*replay.Result, error) {
+			return &replay.Result{Deterministic: true}, nil
+		},
 	}
 }
 
@@ -66,6 +91,36 @@ func (f *Fake) WithClient(clientFn func() workflows.Workflow) *Fake {
 	return f
 }
 
+func (f *Fake) WithActivityHeartbeat(activityHeartbeatFn func(ctx context.Context, instanceID string, taskID int32) error) *Fake {
+	f.activityHeartbeatFn = activityHeartbeatFn
+	return f
+}
+
+func (f *Fake) WithSignalWithStart(signalWithStartFn func(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error) *Fake {
+	f.signalWithStartFn = signalWithStartFn
+	return f
+}
+
+func (f *Fake) WithUpdateWorkflow(updateWorkflowFn func(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error)) *Fake {
+	f.updateWorkflowFn = updateWorkflowFn
+	return f
+}
+
+func (f *Fake) WithListWorkflows(listWorkflowsFn func(ctx context.Context, query *search.Query) (*search.QueryResponse, error)) *Fake {
+	f.listWorkflowsFn = listWorkflowsFn
+	return f
+}
+
+func (f *Fake) WithWorkflowHistory(workflowHistoryFn func(ctx context.Context, instanceID string) (*replay.History, error)) *Fake {
+	f.workflowHistoryFn = workflowHistoryFn
+	return f
+}
+
+func (f *Fake) WithReplayWorkflow(replayWorkflowFn func(ctx context.Context, history *replay.History) (*replay.Result, error)) *Fake {
+	f.replayWorkflowFn = replayWorkflowFn
+	return f
+}
+
 func (f *Fake) Run(ctx context.Context) error {
 	return f.runFn(ctx)
 }
@@ -89,3 +144,27 @@ func (f *Fake) Client() workflows.Workflow {
 func (f *Fake) ActivityActorType() string {
 	return ""
 }
+
+func (f *Fake) ActivityHeartbeat(ctx context.Context, instanceID string, taskID int32) error {
+	return f.activityHeartbeatFn(ctx, instanceID, taskID)
+}
+
+func (f *Fake) SignalWithStart(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error {
+	return f.signalWithStartFn(ctx, req, eventName, eventData)
+}
+
+func (f *Fake) UpdateWorkflow(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	return f.updateWorkflowFn(ctx, instanceID, eventName, eventData)
+}
+
+func (f *Fake) ListWorkflows(ctx context.Context, query *search.Query) (*search.QueryResponse, error) {
+	return f.listWorkflowsFn(ctx, query)
+}
+
+func (f *Fake) WorkflowHistory(ctx context.Context, instanceID string) (*replay.History, error) {
+	return f.workflowHistoryFn(ctx, instanceID)
+}
+
+func (f *Fake) ReplayWorkflow(ctx context.Context, history *replay.History) (*replay.Result, error) {
+	return f.replayWorkflowFn(ctx, history)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package history implements the limits on the history of workflows, and the
+// carry-over state which workflows are continued as new from when they exceed
+// them.
+package history
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/logger"
+)
+
+// CarryOverEventName is the name of the event which a workflow sends to its
+// own instance to set its carry-over state. The input of the latest such event
+// is the input of the workflow when the engine continues it as new.
+const CarryOverEventName = "dapr.workflow.set_carryover_state"
+
+const defaultWarningThresholdPercent = 80
+
+var log = logger.NewLogger("dapr.runtime.wfengine.history")
+
+// Action is the action taken when a workflow exceeds a history limit.
+type Action string
+
+const (
+	// ActionFail fails the workflow.
+	ActionFail Action = "fail"
+	// ActionContinueAsNew continues the workflow as new, with its carry-over
+	// state as input.
+	ActionContinueAsNew Action = "continueAsNew"
+)
+
+// Limits are the limits on the history of workflows. A zero limit is not
+// enforced.
+type Limits struct {
+	MaxEvents               int
+	MaxSizeBytes            int64
+	WarningThresholdPercent int
+	OnLimitExceeded         Action
+}
+
+// Usage is the size of the history of a workflow.
+type Usage struct {
+	Events    int
+	SizeBytes int64
+}
+
+// LimitsFromSpec returns the history limits configured in the workflow spec,
+// or nil if no limit is configured.
+func LimitsFromSpec(spec *config.WorkflowHistoryLimitsSpec) *Limits {
+	if spec == nil || (spec.MaxEvents <= 0 && spec.MaxSizeBytes <= 0) {
+		return nil
+	}
+
+	l := &Limits{
+		MaxEvents:               max(int(spec.MaxEvents), 0),
+		MaxSizeBytes:            max(spec.MaxSizeBytes, 0),
+		WarningThresholdPercent: int(spec.WarningThresholdPercent),
+		OnLimitExceeded:         Action(spec.OnLimitExceeded),
+	}
+	if l.WarningThresholdPercent <= 0 || l.WarningThresholdPercent > 100 {
+		l.WarningThresholdPercent = defaultWarningThresholdPercent
+	}
+
+	switch l.OnLimitExceeded {
+	case ActionFail, ActionContinueAsNew:
+	case "":
+		l.OnLimitExceeded = ActionFail
+	default:
+		log.Warnf("Unknown workflow history onLimitExceeded action '%s', workflows exceeding a history limit will be failed", spec.OnLimitExceeded)
+		l.OnLimitExceeded = ActionFail
+	}
+
+	return l
+}
+
+// Measure returns the size of the history made of the given events.
+func Measure(events ...[]*backend.HistoryEvent) Usage {
+	var u Usage
+	for _, ee := range events {
+		u.Events += len(ee)
+		for _, e := range ee {
+			u.SizeBytes += int64(proto.Size(e))
+		}
+	}
+	return u
+}
+
+// Exceeded returns true if the usage exceeds a limit.
+func (l *Limits) Exceeded(u Usage) bool {
+	return (l.MaxEvents > 0 && u.Events > l.MaxEvents) ||
+		(l.MaxSizeBytes > 0 && u.SizeBytes > l.MaxSizeBytes)
+}
+
+// ApproachingLimit returns true if the usage went from below the warning
+// threshold of a limit to above it, so that the warning is logged once.
+func (l *Limits) ApproachingLimit(prev, cur Usage) bool {
+	return (l.MaxEvents > 0 && !l.aboveThreshold(int64(prev.Events), int64(l.MaxEvents)) && l.aboveThreshold(int64(cur.Events), int64(l.MaxEvents))) ||
+		(l.MaxSizeBytes > 0 && !l.aboveThreshold(prev.SizeBytes, l.MaxSizeBytes) && l.aboveThreshold(cur.SizeBytes, l.MaxSizeBytes))
+}
+
+func (l *Limits) aboveThreshold(v, limit int64) bool {
+	return v*100 >= limit*int64(l.WarningThresholdPercent)
+}
+
+// CarryOverState returns the latest carry-over state set by the workflow with
+// the given instance ID in its history, or false if none was set.
+func CarryOverState(instanceID string, events []*backend.HistoryEvent) (*wrapperspb.StringValue, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if e := events[i].GetEventSent(); isCarryOver(instanceID, e) {
+			return e.GetInput(), true
+		}
+	}
+	return nil, false
+}
+
+// IsCarryOver returns true if the message sets the carry-over state of the
+// workflow with the given instance ID.
+func IsCarryOver(instanceID string, msg *backend.OrchestrationRuntimeStateMessage) bool {
+	return msg.GetTargetInstanceID() == instanceID && isCarryOver(instanceID, msg.GetHistoryEvent().GetEventSent())
+}
+
+func isCarryOver(instanceID string, e *protos.EventSentEvent) bool {
+	return e != nil && e.GetName() == CarryOverEventName && e.GetInstanceId() == instanceID
+}
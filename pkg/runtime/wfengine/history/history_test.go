@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestLimitsFromSpec(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		assert.Nil(t, LimitsFromSpec(nil))
+		assert.Nil(t, LimitsFromSpec(&config.WorkflowHistoryLimitsSpec{OnLimitExceeded: "continueAsNew"}))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		l := LimitsFromSpec(&config.WorkflowHistoryLimitsSpec{MaxEvents: 100})
+		require.NotNil(t, l)
+		assert.Equal(t, &Limits{
+			MaxEvents:               100,
+			WarningThresholdPercent: defaultWarningThresholdPercent,
+			OnLimitExceeded:         ActionFail,
+		}, l)
+	})
+
+	t.Run("continue as new", func(t *testing.T) {
+		l := LimitsFromSpec(&config.WorkflowHistoryLimitsSpec{
+			MaxSizeBytes:            1024,
+			WarningThresholdPercent: 50,
+			OnLimitExceeded:         "continueAsNew",
+		})
+		require.NotNil(t, l)
+		assert.Equal(t, &Limits{
+			MaxSizeBytes:            1024,
+			WarningThresholdPercent: 50,
+			OnLimitExceeded:         ActionContinueAsNew,
+		}, l)
+	})
+
+	t.Run("unknown action fails workflows", func(t *testing.T) {
+		l := LimitsFromSpec(&config.WorkflowHistoryLimitsSpec{MaxEvents: 100, OnLimitExceeded: "compact"})
+		require.NotNil(t, l)
+		assert.Equal(t, ActionFail, l.OnLimitExceeded)
+	})
+}
+
+func TestLimits(t *testing.T) {
+	l := &Limits{MaxEvents: 10, MaxSizeBytes: 1000, WarningThresholdPercent: 80}
+
+	t.Run("exceeded", func(t *testing.T) {
+		assert.False(t, l.Exceeded(Usage{Events: 10, SizeBytes: 1000}))
+		assert.True(t, l.Exceeded(Usage{Events: 11, SizeBytes: 10}))
+		assert.True(t, l.Exceeded(Usage{Events: 1, SizeBytes: 1001}))
+		assert.False(t, (&Limits{MaxSizeBytes: 1000}).Exceeded(Usage{Events: 1_000_000}))
+	})
+
+	t.Run("approaching limit", func(t *testing.T) {
+		assert.False(t, l.ApproachingLimit(Usage{Events: 5}, Usage{Events: 7}))
+		assert.True(t, l.ApproachingLimit(Usage{Events: 7}, Usage{Events: 8}))
+		assert.False(t, l.ApproachingLimit(Usage{Events: 8}, Usage{Events: 9}))
+		assert.True(t, l.ApproachingLimit(Usage{SizeBytes: 100}, Usage{SizeBytes: 900}))
+	})
+}
+
+func TestMeasure(t *testing.T) {
+	events := []*backend.HistoryEvent{
+		{EventId: 1, EventType: &protos.HistoryEvent_OrchestratorStarted{OrchestratorStarted: &protos.OrchestratorStartedEvent{}}},
+		carryOver("abc", "state"),
+	}
+	u := Measure(events)
+	assert.Equal(t, 2, u.Events)
+	assert.Positive(t, u.SizeBytes)
+	assert.Equal(t, Usage{}, Measure(nil))
+	assert.Equal(t, Usage{Events: 2, SizeBytes: u.SizeBytes}, Measure(events[:1], events[1:]))
+}
+
+func carryOver(instanceID, input string) *backend.HistoryEvent {
+	return &protos.HistoryEvent{
+		EventType: &protos.HistoryEvent_EventSent{
+			EventSent: &protos.EventSentEvent{
+				InstanceId: instanceID,
+				Name:       CarryOverEventName,
+				Input:      wrapperspb.String(input),
+			},
+		},
+	}
+}
+
+func TestCarryOverState(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		_, ok := CarryOverState("abc", []*backend.HistoryEvent{carryOver("other", "1")})
+		assert.False(t, ok)
+	})
+
+	t.Run("latest state wins", func(t *testing.T) {
+		input, ok := CarryOverState("abc", []*backend.HistoryEvent{
+			carryOver("abc", "1"),
+			carryOver("abc", "2"),
+			carryOver("other", "3"),
+		})
+		require.True(t, ok)
+		assert.Equal(t, "2", input.GetValue())
+	})
+}
+
+func TestIsCarryOver(t *testing.T) {
+	assert.True(t, IsCarryOver("abc", &backend.OrchestrationRuntimeStateMessage{
+		HistoryEvent:     carryOver("abc", "1"),
+		TargetInstanceID: "abc",
+	}))
+	assert.False(t, IsCarryOver("abc", &backend.OrchestrationRuntimeStateMessage{
+		HistoryEvent:     carryOver("other", "1"),
+		TargetInstanceID: "other",
+	}))
+}
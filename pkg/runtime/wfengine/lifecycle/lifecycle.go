@@ -0,0 +1,279 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lifecycle publishes the lifecycle events of workflows and their
+// activities to a pub/sub topic, so that external observers can react to
+// workflows without polling them.
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	contribcontenttype "github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/config"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+var log = logger.NewLogger("dapr.runtime.wfengine.lifecycle")
+
+// EventType is the type of workflow lifecycle event.
+type EventType string
+
+const (
+	EventTypeStarted           EventType = "started"
+	EventTypeCompleted         EventType = "completed"
+	EventTypeFailed            EventType = "failed"
+	EventTypeTerminated        EventType = "terminated"
+	EventTypeSuspended         EventType = "suspended"
+	EventTypeResumed           EventType = "resumed"
+	EventTypeActivityScheduled EventType = "activityScheduled"
+	EventTypeActivityCompleted EventType = "activityCompleted"
+	EventTypeActivityFailed    EventType = "activityFailed"
+)
+
+// Event is the payload of a workflow lifecycle event.
+type Event struct {
+	Type         EventType `json:"type"`
+	AppID        string    `json:"appID"`
+	InstanceID   string    `json:"instanceID"`
+	WorkflowName string    `json:"workflowName"`
+	// RuntimeStatus is the runtime status of the workflow after the event,
+	// e.g. "RUNNING". Only set on workflow events.
+	RuntimeStatus string `json:"runtimeStatus,omitempty"`
+	// ActivityName and TaskID identify the activity. Only set on activity
+	// events.
+	ActivityName   string          `json:"activityName,omitempty"`
+	TaskID         *int32          `json:"taskID,omitempty"`
+	FailureDetails *FailureDetails `json:"failureDetails,omitempty"`
+	Time           time.Time       `json:"time"`
+}
+
+// FailureDetails are the details of the failure of a workflow or activity.
+type FailureDetails struct {
+	ErrorType    string `json:"errorType"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+type Options struct {
+	AppID     string
+	Spec      *config.WorkflowLifecycleEventsSpec
+	Publisher rtpubsub.Adapter
+}
+
+// Lifecycle publishes workflow lifecycle events to a pub/sub topic. A nil
+// Lifecycle is valid and discards all events.
+type Lifecycle struct {
+	appID         string
+	pubsubName    string
+	topic         string
+	workflowNames map[string]struct{}
+	publisher     rtpubsub.Adapter
+}
+
+// New returns a new Lifecycle. Returns nil if lifecycle events are not
+// enabled.
+func New(opts Options) *Lifecycle {
+	if !opts.Spec.Enabled() || opts.Publisher == nil {
+		return nil
+	}
+
+	var workflowNames map[string]struct{}
+	if len(opts.Spec.WorkflowNames) > 0 {
+		workflowNames = make(map[string]struct{}, len(opts.Spec.WorkflowNames))
+		for _, name := range opts.Spec.WorkflowNames {
+			workflowNames[name] = struct{}{}
+		}
+	}
+
+	return &Lifecycle{
+		appID:         opts.AppID,
+		pubsubName:    opts.Spec.PubsubName,
+		topic:         opts.Spec.Topic,
+		workflowNames: workflowNames,
+		publisher:     opts.Publisher,
+	}
+}
+
+// Events returns the serialized lifecycle events of the history events which
+// were added to the history of the given workflow instance. The history is
+// used to look up the names of the workflow and of its activities. Events are
+// saved with the state of the workflow, and published with Publish once it is
+// saved, so that they are published at least once.
+func (l *Lifecycle) Events(instanceID string, history []*backend.HistoryEvent, added []*backend.HistoryEvent) ([]json.RawMessage, error) {
+	if l == nil || len(added) == 0 {
+		return nil, nil
+	}
+
+	workflowName := workflowName(history)
+	if l.workflowNames != nil {
+		if _, ok := l.workflowNames[workflowName]; !ok {
+			return nil, nil
+		}
+	}
+
+	evs := events(instanceID, workflowName, history, added)
+	data := make([]json.RawMessage, len(evs))
+	for i, ev := range evs {
+		ev.AppID = l.appID
+		b, err := json.Marshal(ev)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = b
+	}
+	return data, nil
+}
+
+// Publish publishes the serialized lifecycle events in order, and returns the
+// number of events published before an error, if any. Events are discarded
+// if the Lifecycle is nil, as lifecycle events are not enabled anymore.
+func (l *Lifecycle) Publish(ctx context.Context, events []json.RawMessage) (int, error) {
+	if l == nil {
+		return len(events), nil
+	}
+
+	for i, data := range events {
+		var ev Event
+		if err := json.Unmarshal(data, &ev); err != nil {
+			// An event which can't be decoded can never be published.
+			log.Errorf("Dropping invalid workflow lifecycle event: %v", err)
+			continue
+		}
+		if err := l.publish(ctx, &ev, data); err != nil {
+			return i, fmt.Errorf("failed to publish workflow %s event for workflow instance '%s': %w", ev.Type, ev.InstanceID, err)
+		}
+	}
+	return len(events), nil
+}
+
+func (l *Lifecycle) publish(ctx context.Context, ev *Event, data []byte) error {
+	ce := contribpubsub.NewCloudEventsEnvelope("", l.appID, "dapr.io.workflows."+string(ev.Type),
+		ev.InstanceID, l.topic, l.pubsubName,
+		contribcontenttype.JSONContentType, data, "", "",
+	)
+
+	b, err := json.Marshal(ce)
+	if err != nil {
+		return err
+	}
+
+	return l.publisher.Publish(ctx, &contribpubsub.PublishRequest{
+		PubsubName:  l.pubsubName,
+		Topic:       l.topic,
+		Data:        b,
+		ContentType: ptr.Of(contribcontenttype.CloudEventContentType),
+	})
+}
+
+// events returns the lifecycle events of the added history events.
+func events(instanceID, workflowName string, history []*backend.HistoryEvent, added []*backend.HistoryEvent) []*Event {
+	var activityNames map[int32]string
+
+	evs := make([]*Event, 0, len(added))
+	for _, e := range added {
+		ev := &Event{
+			InstanceID:   instanceID,
+			WorkflowName: workflowName,
+			Time:         e.GetTimestamp().AsTime(),
+		}
+
+		switch {
+		case e.GetExecutionStarted() != nil:
+			ev.Type = EventTypeStarted
+			ev.RuntimeStatus = search.RuntimeStatus(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING)
+		case e.GetExecutionCompleted() != nil:
+			ec := e.GetExecutionCompleted()
+			switch ec.GetOrchestrationStatus() {
+			case protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED:
+				ev.Type = EventTypeCompleted
+			case protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED:
+				ev.Type = EventTypeFailed
+			case protos.OrchestrationStatus_ORCHESTRATION_STATUS_TERMINATED:
+				ev.Type = EventTypeTerminated
+			default:
+				continue
+			}
+			ev.RuntimeStatus = search.RuntimeStatus(ec.GetOrchestrationStatus())
+			ev.FailureDetails = failureDetails(ec.GetFailureDetails())
+		case e.GetExecutionSuspended() != nil:
+			ev.Type = EventTypeSuspended
+			ev.RuntimeStatus = search.RuntimeStatus(protos.OrchestrationStatus_ORCHESTRATION_STATUS_SUSPENDED)
+		case e.GetExecutionResumed() != nil:
+			ev.Type = EventTypeResumed
+			ev.RuntimeStatus = search.RuntimeStatus(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING)
+		case e.GetTaskScheduled() != nil:
+			ev.Type = EventTypeActivityScheduled
+			ev.ActivityName = e.GetTaskScheduled().GetName()
+			ev.TaskID = ptr.Of(e.GetEventId())
+		case e.GetTaskCompleted() != nil, e.GetTaskFailed() != nil:
+			if activityNames == nil {
+				activityNames = scheduledActivities(history)
+			}
+			if tc := e.GetTaskCompleted(); tc != nil {
+				ev.Type = EventTypeActivityCompleted
+				ev.TaskID = ptr.Of(tc.GetTaskScheduledId())
+			} else {
+				ev.Type = EventTypeActivityFailed
+				ev.TaskID = ptr.Of(e.GetTaskFailed().GetTaskScheduledId())
+				ev.FailureDetails = failureDetails(e.GetTaskFailed().GetFailureDetails())
+			}
+			ev.ActivityName = activityNames[*ev.TaskID]
+		default:
+			continue
+		}
+
+		evs = append(evs, ev)
+	}
+
+	return evs
+}
+
+func workflowName(history []*backend.HistoryEvent) string {
+	for _, e := range history {
+		if es := e.GetExecutionStarted(); es != nil {
+			return es.GetName()
+		}
+	}
+	return ""
+}
+
+// scheduledActivities returns the names of the activities scheduled in the
+// history, by task ID.
+func scheduledActivities(history []*backend.HistoryEvent) map[int32]string {
+	names := make(map[int32]string)
+	for _, e := range history {
+		if ts := e.GetTaskScheduled(); ts != nil {
+			names[e.GetEventId()] = ts.GetName()
+		}
+	}
+	return names
+}
+
+func failureDetails(fd *protos.TaskFailureDetails) *FailureDetails {
+	if fd == nil {
+		return nil
+	}
+	return &FailureDetails{
+		ErrorType:    fd.GetErrorType(),
+		ErrorMessage: fd.GetErrorMessage(),
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/pubsub/publisher/fake"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("nil spec returns nil", func(t *testing.T) {
+		assert.Nil(t, New(Options{Publisher: fake.New()}))
+	})
+
+	t.Run("missing topic returns nil", func(t *testing.T) {
+		assert.Nil(t, New(Options{
+			Spec:      &config.WorkflowLifecycleEventsSpec{PubsubName: "mypubsub"},
+			Publisher: fake.New(),
+		}))
+	})
+
+	t.Run("nil lifecycle discards events", func(t *testing.T) {
+		var l *Lifecycle
+		events, err := l.Events("id", nil, []*backend.HistoryEvent{{}})
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		n, err := l.Publish(t.Context(), []json.RawMessage{[]byte("{}")})
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestEvents(t *testing.T) {
+	now := timestamppb.Now()
+	history := []*backend.HistoryEvent{
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{Name: "wf"},
+		}},
+		{EventId: 0, Timestamp: now, EventType: &protos.HistoryEvent_TaskScheduled{
+			TaskScheduled: &protos.TaskScheduledEvent{Name: "act1"},
+		}},
+		{EventId: 1, Timestamp: now, EventType: &protos.HistoryEvent_TaskScheduled{
+			TaskScheduled: &protos.TaskScheduledEvent{Name: "act2"},
+		}},
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_TaskCompleted{
+			TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: 0},
+		}},
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_TaskFailed{
+			TaskFailed: &protos.TaskFailedEvent{TaskScheduledId: 1, FailureDetails: &protos.TaskFailureDetails{
+				ErrorType: "MyError", ErrorMessage: "boom",
+			}},
+		}},
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_ExecutionSuspended{
+			ExecutionSuspended: &protos.ExecutionSuspendedEvent{},
+		}},
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_OrchestratorStarted{
+			OrchestratorStarted: &protos.OrchestratorStartedEvent{},
+		}},
+		{EventId: -1, Timestamp: now, EventType: &protos.HistoryEvent_ExecutionCompleted{
+			ExecutionCompleted: &protos.ExecutionCompletedEvent{
+				OrchestrationStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED,
+				FailureDetails:      &protos.TaskFailureDetails{ErrorType: "MyError", ErrorMessage: "boom"},
+			},
+		}},
+	}
+
+	// Events are derived from the added events only, and the names of the
+	// activities are looked up in the history.
+	evs := events("id", "wf", history, history[2:])
+	for _, ev := range evs {
+		assert.Equal(t, "id", ev.InstanceID)
+		assert.Equal(t, "wf", ev.WorkflowName)
+		assert.Equal(t, now.AsTime(), ev.Time)
+	}
+	types := make([]EventType, len(evs))
+	for i, ev := range evs {
+		types[i] = ev.Type
+	}
+	assert.Equal(t, []EventType{
+		EventTypeActivityScheduled,
+		EventTypeActivityCompleted,
+		EventTypeActivityFailed,
+		EventTypeSuspended,
+		EventTypeFailed,
+	}, types)
+
+	assert.Equal(t, "act2", evs[0].ActivityName)
+	assert.Equal(t, ptr.Of(int32(1)), evs[0].TaskID)
+	assert.Equal(t, "act1", evs[1].ActivityName)
+	assert.Equal(t, ptr.Of(int32(0)), evs[1].TaskID)
+	assert.Empty(t, evs[1].RuntimeStatus)
+	assert.Equal(t, "act2", evs[2].ActivityName)
+	assert.Equal(t, &FailureDetails{ErrorType: "MyError", ErrorMessage: "boom"}, evs[2].FailureDetails)
+	assert.Equal(t, "SUSPENDED", evs[3].RuntimeStatus)
+	assert.Equal(t, "FAILED", evs[4].RuntimeStatus)
+	assert.Equal(t, &FailureDetails{ErrorType: "MyError", ErrorMessage: "boom"}, evs[4].FailureDetails)
+}
+
+func TestLifecycle(t *testing.T) {
+	reqCh := make(chan *contribpubsub.PublishRequest, 10)
+	var fail bool
+	l := New(Options{
+		AppID: "myapp",
+		Spec: &config.WorkflowLifecycleEventsSpec{
+			PubsubName:    "mypubsub",
+			Topic:         "workflow-events",
+			WorkflowNames: []string{"wf"},
+		},
+		Publisher: fake.New().WithPublishFn(func(_ context.Context, req *contribpubsub.PublishRequest) error {
+			if fail {
+				return errors.New("pubsub unavailable")
+			}
+			reqCh <- req
+			return nil
+		}),
+	})
+	require.NotNil(t, l)
+
+	started := func(name string) []*backend.HistoryEvent {
+		return []*backend.HistoryEvent{{
+			EventId:   -1,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionStarted{
+				ExecutionStarted: &protos.ExecutionStartedEvent{Name: name},
+			},
+		}}
+	}
+
+	other := started("other")
+	events, err := l.Events("otherid", other, other)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	wf := started("wf")
+	events, err = l.Events("myid", wf, wf)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	t.Run("failed events are not published", func(t *testing.T) {
+		fail = true
+		defer func() { fail = false }()
+		n, err := l.Publish(t.Context(), events)
+		require.Error(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	n, err := l.Publish(t.Context(), events)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	require.Len(t, reqCh, 1)
+	req := <-reqCh
+	assert.Equal(t, "mypubsub", req.PubsubName)
+	assert.Equal(t, "workflow-events", req.Topic)
+	var ce map[string]any
+	require.NoError(t, json.Unmarshal(req.Data, &ce))
+	assert.Equal(t, "myapp", ce[contribpubsub.SourceField])
+	assert.Equal(t, "myid", ce[contribpubsub.SubjectField])
+	assert.Equal(t, "dapr.io.workflows.started", ce[contribpubsub.TypeField])
+	b, err := json.Marshal(ce[contribpubsub.DataField])
+	require.NoError(t, err)
+	var ev Event
+	require.NoError(t, json.Unmarshal(b, &ev))
+	assert.Equal(t, EventTypeStarted, ev.Type)
+	assert.Equal(t, "myapp", ev.AppID)
+	assert.Equal(t, "myid", ev.InstanceID)
+	assert.Equal(t, "wf", ev.WorkflowName)
+	assert.Equal(t, "RUNNING", ev.RuntimeStatus)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/actors/router"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+const releaseTimeout = time.Second * 5
+
+// cluster enforces a limit across all the Dapr instances of the app, by
+// acquiring a lease from the limiter actor of the limit. Leases are renewed
+// while the invocation runs.
+type cluster struct {
+	key           string
+	router        router.Interface
+	actorType     string
+	renewInterval time.Duration
+
+	// admitted holds the function which releases the lease of each admitted
+	// instance, by instance ID.
+	admitted map[string]func()
+	// lost holds the IDs of the admitted instances whose lease was lost. No
+	// new instance is admitted until their leases are acquired again.
+	lost map[string]struct{}
+	lock sync.Mutex
+}
+
+func newCluster(limit Limit, router router.Interface, actorType string) *cluster {
+	return &cluster{
+		key:           limit.Key(),
+		router:        router,
+		actorType:     actorType,
+		renewInterval: LeaseTTL / 3,
+		admitted:      make(map[string]func()),
+		lost:          make(map[string]struct{}),
+	}
+}
+
+func (c *cluster) acquire(ctx context.Context) (func(), error) {
+	lease := uuid.New().String()
+	for {
+		retryAfter, err := c.call(ctx, AcquireMethod, lease)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lease of limit '%s': %w", c.key, err)
+		}
+		if retryAfter <= 0 {
+			break
+		}
+
+		select {
+		case <-time.After(retryAfter):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return c.hold(lease, nil), nil
+}
+
+// admit acquires a lease for the instance with the given ID, which is held
+// until the instance is released. It returns false if the lease can't be
+// granted yet, or if the lease of another admitted instance was lost and
+// hasn't been acquired again.
+func (c *cluster) admit(ctx context.Context, id string) (bool, error) {
+	c.lock.Lock()
+	_, ok := c.admitted[id]
+	_, lost := c.lost[id]
+	blocked := !lost && len(c.lost) > 0
+	c.lock.Unlock()
+	if ok {
+		return true, nil
+	}
+	if blocked {
+		return false, nil
+	}
+
+	// Acquiring a granted lease again renews it, so the lease is granted once
+	// if the instance is admitted concurrently.
+	retryAfter, err := c.call(ctx, AcquireMethod, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lease of limit '%s': %w", c.key, err)
+	}
+	if retryAfter > 0 {
+		return false, nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.lost, id)
+	if _, ok = c.admitted[id]; !ok {
+		c.admitted[id] = c.hold(id, func(ctx context.Context) {
+			c.lose(ctx, id)
+		})
+	}
+	return true, nil
+}
+
+func (c *cluster) release(id string) {
+	c.lock.Lock()
+	release, ok := c.admitted[id]
+	delete(c.admitted, id)
+	delete(c.lost, id)
+	c.lock.Unlock()
+
+	if ok {
+		release()
+	}
+}
+
+// lose forgets the admitted instance with the given ID, whose lease was lost.
+// The instance must be admitted again, which acquires the lease again.
+func (c *cluster) lose(ctx context.Context, id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// The instance was released meanwhile.
+	if ctx.Err() != nil {
+		return
+	}
+	if _, ok := c.admitted[id]; ok {
+		log.Warnf("Lost lease of limit '%s' for instance '%s'", c.key, id)
+		delete(c.admitted, id)
+		c.lost[id] = struct{}{}
+	}
+}
+
+// hold renews the granted lease until the returned function is called, which
+// releases it. If onLost is not nil, it is called when the lease is lost,
+// and the lease is no longer renewed.
+func (c *cluster) hold(lease string, onLost func(context.Context)) func() {
+	renewCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.renew(renewCtx, lease, onLost)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+
+		rctx, rcancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer rcancel()
+		if _, err := c.call(rctx, ReleaseMethod, lease); err != nil {
+			// The lease expires if it is not renewed.
+			log.Warnf("Failed to release lease of limit '%s': %v", c.key, err)
+		}
+	}
+}
+
+// renew renews the lease until the context is canceled. A lease which can't
+// be renewed because it expired and was granted to others is lost: onLost is
+// called if set, otherwise renewing keeps trying to acquire the lease again.
+func (c *cluster) renew(ctx context.Context, lease string, onLost func(context.Context)) {
+	ticker := time.NewTicker(c.renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			retryAfter, err := c.call(ctx, AcquireMethod, lease)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warnf("Failed to renew lease of limit '%s': %v", c.key, err)
+				}
+				continue
+			}
+			if retryAfter <= 0 {
+				continue
+			}
+			if onLost != nil {
+				onLost(ctx)
+				return
+			}
+			log.Warnf("Lost lease of limit '%s'; acquiring it again", c.key)
+		}
+	}
+}
+
+func (c *cluster) call(ctx context.Context, method string, lease string) (time.Duration, error) {
+	data, err := proto.Marshal(wrapperspb.String(lease))
+	if err != nil {
+		return 0, err
+	}
+
+	req := internalsv1pb.
+		NewInternalInvokeRequest(method).
+		WithActor(c.actorType, c.key).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType)
+
+	resp, err := c.router.Call(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	if method != AcquireMethod {
+		return 0, nil
+	}
+
+	var retryAfter durationpb.Duration
+	if err = resp.GetMessage().GetData().UnmarshalTo(&retryAfter); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return retryAfter.AsDuration(), nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package limiter implements the concurrency and rate limits of the
+// invocations of workflows and activities with a given name. Limits are
+// enforced either in every Dapr instance, or across all the Dapr instances of
+// the app by a limiter actor.
+package limiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/dapr/dapr/pkg/actors/router"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/kit/logger"
+)
+
+const (
+	// AcquireMethod is the method of the limiter actor which acquires or
+	// renews a lease. The response is the duration after which the caller
+	// should retry, or 0 if the lease was granted.
+	AcquireMethod = "Acquire"
+	// ReleaseMethod is the method of the limiter actor which releases a lease.
+	ReleaseMethod = "Release"
+
+	// LeaseTTL is the duration after which a lease of a limiter actor expires
+	// if it is not renewed, so that the leases of failed Dapr instances are
+	// eventually released.
+	LeaseTTL = time.Minute
+)
+
+var log = logger.NewLogger("dapr.runtime.wfengine.limiter")
+
+// Kind is the kind of the invocations to which a limit applies.
+type Kind string
+
+const (
+	KindWorkflow Kind = "workflow"
+	KindActivity Kind = "activity"
+)
+
+// Scope is the scope in which a limit is enforced.
+type Scope string
+
+const (
+	ScopeSidecar Scope = "sidecar"
+	ScopeCluster Scope = "cluster"
+)
+
+// Limit is the limit of the invocations of the workflows or activities with a
+// given name. A zero limit is not enforced.
+type Limit struct {
+	Kind          Kind
+	Name          string
+	MaxConcurrent int
+	MaxPerSecond  int
+	Scope         Scope
+}
+
+// Key returns the key of the limit, which is the ID of its limiter actor.
+func (l Limit) Key() string {
+	return Key(l.Kind, l.Name)
+}
+
+// Key returns the key of the limit of the given kind and name.
+func Key(kind Kind, name string) string {
+	return string(kind) + "||" + name
+}
+
+// LimitsFromSpec returns the limits configured in the workflow spec. Invalid
+// limits are ignored with a warning.
+func LimitsFromSpec(specs []config.WorkflowLimitSpec) []Limit {
+	limits := make([]Limit, 0, len(specs))
+	for _, spec := range specs {
+		l := Limit{
+			MaxConcurrent: max(int(spec.MaxConcurrentInvocations), 0),
+			MaxPerSecond:  max(int(spec.MaxInvocationsPerSecond), 0),
+			Scope:         Scope(spec.Scope),
+		}
+
+		switch {
+		case len(spec.WorkflowName) > 0 && len(spec.ActivityName) == 0:
+			l.Kind, l.Name = KindWorkflow, spec.WorkflowName
+		case len(spec.ActivityName) > 0 && len(spec.WorkflowName) == 0:
+			l.Kind, l.Name = KindActivity, spec.ActivityName
+		default:
+			log.Warnf("Ignoring workflow limit: exactly one of workflowName and activityName must be set")
+			continue
+		}
+
+		switch l.Scope {
+		case ScopeSidecar, ScopeCluster:
+		case "":
+			l.Scope = ScopeSidecar
+		default:
+			log.Warnf("Ignoring %s limit '%s': unknown scope '%s'", l.Kind, l.Name, spec.Scope)
+			continue
+		}
+
+		if l.MaxConcurrent == 0 && l.MaxPerSecond == 0 {
+			log.Warnf("Ignoring %s limit '%s': neither maxConcurrentInvocations nor maxInvocationsPerSecond is set", l.Kind, l.Name)
+			continue
+		}
+
+		limits = append(limits, l)
+	}
+	return limits
+}
+
+// HasClusterLimits returns true if any of the limits is enforced across all
+// the Dapr instances of the app, which requires the limiter actor type to be
+// registered.
+func HasClusterLimits(limits []Limit) bool {
+	for _, l := range limits {
+		if l.Scope == ScopeCluster {
+			return true
+		}
+	}
+	return false
+}
+
+type Options struct {
+	Limits []Limit
+
+	// Router and ActorType are used to call the limiter actors of the limits
+	// enforced across all the Dapr instances of the app.
+	Router    router.Interface
+	ActorType string
+}
+
+// Limiter enforces the limits of the invocations of workflows and activities.
+// A nil Limiter doesn't limit invocations.
+type Limiter struct {
+	local   map[string]*local
+	cluster map[string]*cluster
+}
+
+func New(opts Options) *Limiter {
+	if len(opts.Limits) == 0 {
+		return nil
+	}
+
+	l := &Limiter{
+		local:   make(map[string]*local),
+		cluster: make(map[string]*cluster),
+	}
+	for _, limit := range opts.Limits {
+		if limit.Scope == ScopeCluster {
+			l.cluster[limit.Key()] = newCluster(limit, opts.Router, opts.ActorType)
+		} else {
+			l.local[limit.Key()] = newLocal(limit)
+		}
+	}
+	return l
+}
+
+// Acquire blocks until the invocation of the workflow or activity with the
+// given name is allowed by its limit, or the context is done. The returned
+// function must be called once the invocation is complete.
+func (l *Limiter) Acquire(ctx context.Context, kind Kind, name string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	key := Key(kind, name)
+	if c, ok := l.cluster[key]; ok {
+		return c.acquire(ctx)
+	}
+	if lo, ok := l.local[key]; ok {
+		return lo.acquire(ctx)
+	}
+	return func() {}, nil
+}
+
+// Admit admits the instance with the given ID of the workflow or activity with
+// the given name, if its limit allows one more running instance. It returns
+// false if the instance isn't admitted yet. An instance counts against the
+// limit until it is released, and admitting an admitted instance is a no-op.
+func (l *Limiter) Admit(ctx context.Context, kind Kind, name string, id string) (bool, error) {
+	if l == nil {
+		return true, nil
+	}
+
+	key := Key(kind, name)
+	if c, ok := l.cluster[key]; ok {
+		return c.admit(ctx, id)
+	}
+	if lo, ok := l.local[key]; ok {
+		return lo.admit(id), nil
+	}
+	return true, nil
+}
+
+// Release releases the instance with the given ID admitted by Admit. It is a
+// no-op if the instance isn't admitted.
+func (l *Limiter) Release(kind Kind, name string, id string) {
+	if l == nil {
+		return
+	}
+
+	key := Key(kind, name)
+	if c, ok := l.cluster[key]; ok {
+		c.release(id)
+	}
+	if lo, ok := l.local[key]; ok {
+		lo.releaseInstance(id)
+	}
+}
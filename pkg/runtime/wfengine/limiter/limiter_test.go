@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limiter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	"github.com/dapr/dapr/pkg/config"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func TestLimitsFromSpec(t *testing.T) {
+	limits := LimitsFromSpec([]config.WorkflowLimitSpec{
+		{ActivityName: "ChargeCard", MaxInvocationsPerSecond: 10, Scope: "cluster"},
+		{WorkflowName: "Order", MaxConcurrentInvocations: 5},
+		{WorkflowName: "Both", ActivityName: "Both", MaxConcurrentInvocations: 1},
+		{ActivityName: "NoLimit"},
+		{ActivityName: "BadScope", MaxConcurrentInvocations: 1, Scope: "global"},
+	})
+
+	assert.Equal(t, []Limit{
+		{Kind: KindActivity, Name: "ChargeCard", MaxPerSecond: 10, Scope: ScopeCluster},
+		{Kind: KindWorkflow, Name: "Order", MaxConcurrent: 5, Scope: ScopeSidecar},
+	}, limits)
+	assert.True(t, HasClusterLimits(limits))
+	assert.False(t, HasClusterLimits(limits[1:]))
+}
+
+func TestLimiter(t *testing.T) {
+	t.Run("nil limiter doesn't limit invocations", func(t *testing.T) {
+		var l *Limiter
+		release, err := l.Acquire(t.Context(), KindActivity, "a")
+		require.NoError(t, err)
+		release()
+		assert.Nil(t, New(Options{}))
+	})
+
+	l := New(Options{Limits: []Limit{
+		{Kind: KindActivity, Name: "a", MaxConcurrent: 2, Scope: ScopeSidecar},
+	}})
+
+	t.Run("invocations without a limit are not limited", func(t *testing.T) {
+		for range 5 {
+			_, err := l.Acquire(t.Context(), KindWorkflow, "a")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("concurrent invocations are queued", func(t *testing.T) {
+		release1, err := l.Acquire(t.Context(), KindActivity, "a")
+		require.NoError(t, err)
+		release2, err := l.Acquire(t.Context(), KindActivity, "a")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*50)
+		defer cancel()
+		_, err = l.Acquire(ctx, KindActivity, "a")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		acquired := make(chan struct{})
+		go func() {
+			release3, err := l.Acquire(t.Context(), KindActivity, "a")
+			assert.NoError(t, err)
+			release3()
+			close(acquired)
+		}()
+
+		release1()
+		select {
+		case <-acquired:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "queued invocation was not allowed after release")
+		}
+		release2()
+	})
+}
+
+func TestLimiterAdmit(t *testing.T) {
+	t.Run("nil limiter admits every instance", func(t *testing.T) {
+		var l *Limiter
+		admitted, err := l.Admit(t.Context(), KindWorkflow, "w", "i1")
+		require.NoError(t, err)
+		assert.True(t, admitted)
+		l.Release(KindWorkflow, "w", "i1")
+	})
+
+	l := New(Options{Limits: []Limit{
+		{Kind: KindWorkflow, Name: "w", MaxConcurrent: 2, Scope: ScopeSidecar},
+	}})
+
+	admit := func(id string) bool {
+		admitted, err := l.Admit(t.Context(), KindWorkflow, "w", id)
+		require.NoError(t, err)
+		return admitted
+	}
+
+	assert.True(t, admit("i1"))
+	assert.True(t, admit("i2"))
+	assert.False(t, admit("i3"))
+
+	// Admitted instances count once, however many times they run.
+	assert.True(t, admit("i1"))
+	assert.True(t, admit("i2"))
+	assert.False(t, admit("i3"))
+
+	l.Release(KindWorkflow, "w", "i1")
+	l.Release(KindWorkflow, "w", "i1")
+	assert.True(t, admit("i3"))
+	assert.False(t, admit("i1"))
+}
+
+func TestLocalRate(t *testing.T) {
+	l := newLocal(Limit{MaxPerSecond: 2})
+
+	// The burst allows one second worth of invocations.
+	for range 2 {
+		release, err := l.acquire(t.Context())
+		require.NoError(t, err)
+		release()
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond*100)
+	defer cancel()
+	_, err := l.acquire(ctx)
+	require.Error(t, err)
+}
+
+func TestClusterLostLease(t *testing.T) {
+	// The limiter actor grants the leases in granted, and asks every other
+	// lease to retry.
+	var lock sync.Mutex
+	granted := map[string]bool{"i1": true, "i2": true}
+	router := routerfake.New().WithCallFn(func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+		var lease wrapperspb.StringValue
+		if err := proto.Unmarshal(req.GetMessage().GetData().GetValue(), &lease); err != nil {
+			return nil, err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		var retryAfter time.Duration
+		if !granted[lease.GetValue()] {
+			retryAfter = time.Second
+		}
+		data, err := anypb.New(durationpb.New(retryAfter))
+		if err != nil {
+			return nil, err
+		}
+		return &internalsv1pb.InternalInvokeResponse{
+			Message: &commonv1pb.InvokeResponse{Data: data},
+		}, nil
+	})
+	grant := func(id string, ok bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		granted[id] = ok
+	}
+
+	c := newCluster(Limit{Kind: KindWorkflow, Name: "w", MaxConcurrent: 2, Scope: ScopeCluster}, router, "limiter")
+	c.renewInterval = time.Millisecond * 10
+
+	admit := func(id string) bool {
+		admitted, err := c.admit(t.Context(), id)
+		require.NoError(t, err)
+		return admitted
+	}
+
+	assert.True(t, admit("i1"))
+	assert.True(t, admit("i2"))
+
+	// The lease of i1 is lost when renewing it.
+	grant("i1", false)
+	assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		assert.NotContains(ct, c.admitted, "i1")
+		assert.Contains(ct, c.lost, "i1")
+	}, time.Second*5, time.Millisecond*10)
+
+	// No new instance is admitted until the lost lease is acquired again.
+	grant("i3", true)
+	assert.False(t, admit("i3"))
+	assert.False(t, admit("i1"))
+	assert.True(t, admit("i2"))
+
+	grant("i1", true)
+	assert.True(t, admit("i1"))
+	assert.True(t, admit("i3"))
+
+	c.release("i1")
+	c.release("i2")
+	c.release("i3")
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limiter
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// local enforces a limit in the Dapr instance.
+type local struct {
+	sem  chan struct{}
+	rate *rate.Limiter
+
+	maxConcurrent int
+	admitted      map[string]struct{}
+	lock          sync.Mutex
+}
+
+func newLocal(limit Limit) *local {
+	l := &local{
+		maxConcurrent: limit.MaxConcurrent,
+		admitted:      make(map[string]struct{}),
+	}
+	if limit.MaxConcurrent > 0 {
+		l.sem = make(chan struct{}, limit.MaxConcurrent)
+	}
+	if limit.MaxPerSecond > 0 {
+		l.rate = NewRate(limit.MaxPerSecond)
+	}
+	return l
+}
+
+func (l *local) acquire(ctx context.Context) (func(), error) {
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			l.release()
+			return nil, err
+		}
+	}
+
+	return l.release, nil
+}
+
+func (l *local) release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+func (l *local) admit(id string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, ok := l.admitted[id]; ok {
+		return true
+	}
+	if l.maxConcurrent > 0 && len(l.admitted) >= l.maxConcurrent {
+		return false
+	}
+	if l.rate != nil {
+		r := l.rate.Reserve()
+		if r.Delay() > 0 {
+			r.Cancel()
+			return false
+		}
+	}
+
+	l.admitted[id] = struct{}{}
+	return true
+}
+
+func (l *local) releaseInstance(id string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.admitted, id)
+}
+
+// NewRate returns a rate limiter which allows the given number of events per
+// second, with a burst of one second worth of events.
+func NewRate(perSecond int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(perSecond), perSecond)
+}
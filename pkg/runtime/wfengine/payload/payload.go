@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package payload implements the offloading of large workflow payloads, such
+// as the inputs and outputs of workflows and activities, to a state store or
+// an output binding. Offloaded payloads are replaced by a reference in the
+// history events saved in the workflow state, and resolved when the workflow
+// state is loaded.
+package payload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+const (
+	keySeparator = "||"
+	keyType      = "dapr.workflow.payload"
+
+	// referencePrefix prefixes the references which replace offloaded
+	// payloads. The leading NUL character can't start a JSON payload, so
+	// references can't be mistaken for payloads.
+	referencePrefix = "\x00" + keyType + ":"
+
+	defaultBindingKeyMetadata = "key"
+)
+
+type Options struct {
+	AppID string
+
+	// ThresholdBytes is the size above which payloads are offloaded.
+	ThresholdBytes int64
+
+	// StateStore is the name of the state store in which payloads are
+	// offloaded.
+	StateStore string
+
+	// Binding is the name of the output binding to which payloads are
+	// offloaded, if StateStore is empty.
+	Binding string
+
+	// BindingKeyMetadata is the metadata property with which the key of a
+	// payload is passed to the binding.
+	BindingKeyMetadata string
+
+	CompStore *compstore.ComponentStore
+}
+
+// Store offloads the payloads of history events which exceed a size
+// threshold. A nil Store doesn't offload payloads.
+type Store struct {
+	appID     string
+	threshold int
+	backend   storage
+}
+
+// storage is where offloaded payloads are kept.
+type storage interface {
+	set(ctx context.Context, key string, data []byte) error
+	get(ctx context.Context, key string) ([]byte, error)
+	// getBulk returns the payloads with the given keys which exist, by key.
+	getBulk(ctx context.Context, keys []string) (map[string][]byte, error)
+	delete(ctx context.Context, key string) error
+}
+
+// New returns a Store, or nil if payload offloading is not configured.
+func New(opts Options) *Store {
+	if opts.ThresholdBytes <= 0 || (opts.StateStore == "" && opts.Binding == "") {
+		return nil
+	}
+
+	s := &Store{
+		appID:     opts.AppID,
+		threshold: int(opts.ThresholdBytes),
+	}
+	if opts.StateStore != "" {
+		s.backend = &stateStore{name: opts.StateStore, compStore: opts.CompStore}
+	} else {
+		keyMetadata := opts.BindingKeyMetadata
+		if keyMetadata == "" {
+			keyMetadata = defaultBindingKeyMetadata
+		}
+		s.backend = &binding{name: opts.Binding, keyMetadata: keyMetadata, compStore: opts.CompStore}
+	}
+	return s
+}
+
+// FromSpec returns a Store configured by the workflow configuration, or nil if
+// payload offloading is not configured.
+func FromSpec(appID string, spec *config.WorkflowPayloadOffloadingSpec, compStore *compstore.ComponentStore) *Store {
+	if spec == nil {
+		return nil
+	}
+	return New(Options{
+		AppID:              appID,
+		ThresholdBytes:     spec.ThresholdBytes,
+		StateStore:         spec.StateStore,
+		Binding:            spec.Binding,
+		BindingKeyMetadata: spec.BindingKeyMetadata,
+		CompStore:          compStore,
+	})
+}
+
+// Offload writes the payload of the event to storage if it exceeds the
+// threshold, and returns a copy of the event in which the payload is replaced
+// by a reference. Otherwise, the event itself is returned.
+func (s *Store) Offload(ctx context.Context, actorID string, e *backend.HistoryEvent) (*backend.HistoryEvent, error) {
+	if s == nil || !s.exceeds(e) {
+		return e, nil
+	}
+
+	offloaded := proto.Clone(e).(*backend.HistoryEvent)
+	field := payloadOf(offloaded)
+	key := s.key(actorID, field.GetValue())
+	if err := s.backend.set(ctx, key, []byte(field.GetValue())); err != nil {
+		return nil, fmt.Errorf("failed to offload workflow payload '%s': %w", key, err)
+	}
+	field.Value = referencePrefix + key
+	return offloaded, nil
+}
+
+// Resolve replaces the reference in the event, if any, with the offloaded
+// payload.
+func (s *Store) Resolve(ctx context.Context, e *backend.HistoryEvent) error {
+	field := payloadOf(e)
+	key, ok := strings.CutPrefix(field.GetValue(), referencePrefix)
+	if !ok {
+		return nil
+	}
+	if s == nil {
+		return fmt.Errorf("failed to resolve workflow payload '%s': payload offloading is not configured", key)
+	}
+	data, err := s.backend.get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to resolve workflow payload '%s': %w", key, err)
+	}
+	field.Value = string(data)
+	return nil
+}
+
+// ResolveAll replaces the references in the events, if any, with the
+// offloaded payloads, which are fetched from storage in one batch.
+func (s *Store) ResolveAll(ctx context.Context, events []*backend.HistoryEvent) error {
+	fields := make(map[string][]*wrapperspb.StringValue)
+	for _, e := range events {
+		field := payloadOf(e)
+		if key, ok := strings.CutPrefix(field.GetValue(), referencePrefix); ok {
+			fields[key] = append(fields[key], field)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if s == nil {
+		return errors.New("failed to resolve workflow payloads: payload offloading is not configured")
+	}
+
+	data, err := s.backend.getBulk(ctx, slices.Collect(maps.Keys(fields)))
+	if err != nil {
+		return fmt.Errorf("failed to resolve workflow payloads: %w", err)
+	}
+	for key, ff := range fields {
+		d, ok := data[key]
+		if !ok {
+			return fmt.Errorf("failed to resolve workflow payload '%s': %w", key, ErrNotFound)
+		}
+		for _, field := range ff {
+			field.Value = string(d)
+		}
+	}
+	return nil
+}
+
+// Key returns the key under which the payload of the event is offloaded, or
+// false if the payload of the event is not offloaded.
+func (s *Store) Key(actorID string, e *backend.HistoryEvent) (string, bool) {
+	if s == nil || !s.exceeds(e) {
+		return "", false
+	}
+	return s.key(actorID, payloadOf(e).GetValue()), true
+}
+
+// Delete deletes the offloaded payloads with the given keys.
+func (s *Store) Delete(ctx context.Context, keys []string) error {
+	if s == nil {
+		return nil
+	}
+	errs := make([]error, 0, len(keys))
+	for _, key := range keys {
+		if err := s.backend.delete(ctx, key); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete workflow payload '%s': %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// exceeds returns true if the event has a payload which exceeds the
+// threshold and is not a reference already.
+func (s *Store) exceeds(e *backend.HistoryEvent) bool {
+	value := payloadOf(e).GetValue()
+	return len(value) > s.threshold && !strings.HasPrefix(value, referencePrefix)
+}
+
+// key returns the key of a payload of a workflow. Keys are derived from the
+// content of the payload, so that an event which moves from the inbox to the
+// history of the workflow references the same payload.
+func (s *Store) key(actorID string, payload string) string {
+	sum := sha256.Sum256([]byte(payload))
+	return s.appID + keySeparator + keyType + keySeparator + actorID + keySeparator + hex.EncodeToString(sum[:])
+}
+
+// payloadOf returns the payload of the event which can be offloaded, or nil.
+func payloadOf(e *backend.HistoryEvent) *wrapperspb.StringValue {
+	switch {
+	case e.GetExecutionStarted() != nil:
+		return e.GetExecutionStarted().GetInput()
+	case e.GetExecutionCompleted() != nil:
+		return e.GetExecutionCompleted().GetResult()
+	case e.GetExecutionTerminated() != nil:
+		return e.GetExecutionTerminated().GetInput()
+	case e.GetTaskScheduled() != nil:
+		return e.GetTaskScheduled().GetInput()
+	case e.GetTaskCompleted() != nil:
+		return e.GetTaskCompleted().GetResult()
+	case e.GetSubOrchestrationInstanceCreated() != nil:
+		return e.GetSubOrchestrationInstanceCreated().GetInput()
+	case e.GetSubOrchestrationInstanceCompleted() != nil:
+		return e.GetSubOrchestrationInstanceCompleted().GetResult()
+	case e.GetEventSent() != nil:
+		return e.GetEventSent().GetInput()
+	case e.GetEventRaised() != nil:
+		return e.GetEventRaised().GetInput()
+	case e.GetContinueAsNew() != nil:
+		return e.GetContinueAsNew().GetInput()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package payload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// fakeStateStore stores values set with Set as is, like state stores do for
+// byte values.
+type fakeStateStore struct {
+	*daprt.FakeStateStore
+}
+
+func (f fakeStateStore) Set(ctx context.Context, req *state.SetRequest) error {
+	return f.Multi(ctx, &state.TransactionalStateRequest{
+		Operations: []state.TransactionalStateOperation{*req},
+	})
+}
+
+// fakeBinding keeps objects in memory, like an object storage binding.
+type fakeBinding struct {
+	daprt.MockBinding
+	objects map[string][]byte
+}
+
+func (f *fakeBinding) Invoke(_ context.Context, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+	key := req.Metadata["blobName"]
+	switch req.Operation {
+	case bindings.CreateOperation:
+		f.objects[key] = req.Data
+		return &bindings.InvokeResponse{}, nil
+	case bindings.GetOperation:
+		return &bindings.InvokeResponse{Data: f.objects[key]}, nil
+	case bindings.DeleteOperation:
+		delete(f.objects, key)
+		return &bindings.InvokeResponse{}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func taskCompleted(result string) *backend.HistoryEvent {
+	return &protos.HistoryEvent{
+		EventId: 1,
+		EventType: &protos.HistoryEvent_TaskCompleted{
+			TaskCompleted: &protos.TaskCompletedEvent{
+				TaskScheduledId: 1,
+				Result:          wrapperspb.String(result),
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	assert.Nil(t, New(Options{StateStore: "store"}))
+	assert.Nil(t, New(Options{ThresholdBytes: 10}))
+	assert.Nil(t, FromSpec("app", nil, nil))
+	assert.NotNil(t, FromSpec("app", &config.WorkflowPayloadOffloadingSpec{ThresholdBytes: 10, Binding: "blobs"}, nil))
+}
+
+func TestNilStore(t *testing.T) {
+	var s *Store
+	e := taskCompleted(strings.Repeat("a", 100))
+
+	offloaded, err := s.Offload(t.Context(), "wf", e)
+	require.NoError(t, err)
+	assert.Same(t, e, offloaded)
+	require.NoError(t, s.Resolve(t.Context(), e))
+	_, ok := s.Key("wf", e)
+	assert.False(t, ok)
+	require.NoError(t, s.Delete(t.Context(), []string{"key"}))
+
+	// References can't be resolved without a store.
+	require.Error(t, s.Resolve(t.Context(), taskCompleted(referencePrefix+"key")))
+	require.NoError(t, s.ResolveAll(t.Context(), []*backend.HistoryEvent{e}))
+	require.Error(t, s.ResolveAll(t.Context(), []*backend.HistoryEvent{taskCompleted(referencePrefix + "key")}))
+}
+
+func TestStateStore(t *testing.T) {
+	store := fakeStateStore{daprt.NewFakeStateStore()}
+	compStore := compstore.New()
+	compStore.AddStateStore("payloads", store)
+	s := New(Options{
+		AppID:          "app",
+		ThresholdBytes: 10,
+		StateStore:     "payloads",
+		CompStore:      compStore,
+	})
+
+	t.Run("small payloads are not offloaded", func(t *testing.T) {
+		e := taskCompleted(`"small"`)
+		offloaded, err := s.Offload(t.Context(), "wf", e)
+		require.NoError(t, err)
+		assert.Same(t, e, offloaded)
+		_, ok := s.Key("wf", e)
+		assert.False(t, ok)
+	})
+
+	t.Run("large payloads are offloaded and resolved", func(t *testing.T) {
+		payload := `"` + strings.Repeat("a", 100) + `"`
+		e := taskCompleted(payload)
+		offloaded, err := s.Offload(t.Context(), "wf", e)
+		require.NoError(t, err)
+
+		// The event itself is not modified.
+		assert.Equal(t, payload, e.GetTaskCompleted().GetResult().GetValue())
+
+		key, ok := s.Key("wf", e)
+		require.True(t, ok)
+		assert.True(t, strings.HasPrefix(key, "app||dapr.workflow.payload||wf||"))
+		assert.Equal(t, referencePrefix+key, offloaded.GetTaskCompleted().GetResult().GetValue())
+		assert.Contains(t, store.GetItems(), key)
+
+		// An offloaded event is not offloaded again.
+		again, err := s.Offload(t.Context(), "wf", offloaded)
+		require.NoError(t, err)
+		assert.Same(t, offloaded, again)
+
+		resolved := proto.Clone(offloaded).(*backend.HistoryEvent)
+		require.NoError(t, s.Resolve(t.Context(), resolved))
+		assert.True(t, proto.Equal(e, resolved))
+
+		require.NoError(t, s.Delete(t.Context(), []string{key}))
+		assert.NotContains(t, store.GetItems(), key)
+		require.Error(t, s.Resolve(t.Context(), offloaded))
+	})
+
+	t.Run("references are resolved in one batch", func(t *testing.T) {
+		payload1 := `"` + strings.Repeat("c", 100) + `"`
+		payload2 := `"` + strings.Repeat("d", 100) + `"`
+		events := []*backend.HistoryEvent{taskCompleted(payload1), taskCompleted(`"small"`), taskCompleted(payload2), taskCompleted(payload1)}
+		offloaded := make([]*backend.HistoryEvent, len(events))
+		for i, e := range events {
+			var err error
+			offloaded[i], err = s.Offload(t.Context(), "wf", e)
+			require.NoError(t, err)
+			offloaded[i] = proto.Clone(offloaded[i]).(*backend.HistoryEvent)
+		}
+
+		require.NoError(t, s.ResolveAll(t.Context(), offloaded))
+		for i := range events {
+			assert.True(t, proto.Equal(events[i], offloaded[i]))
+		}
+
+		missing := taskCompleted(referencePrefix + "missing")
+		require.ErrorIs(t, s.ResolveAll(t.Context(), []*backend.HistoryEvent{missing}), ErrNotFound)
+	})
+
+	t.Run("keys are scoped to the workflow", func(t *testing.T) {
+		e := taskCompleted(strings.Repeat("a", 100))
+		key1, _ := s.Key("wf1", e)
+		key2, _ := s.Key("wf2", e)
+		assert.NotEqual(t, key1, key2)
+	})
+
+	t.Run("missing state store", func(t *testing.T) {
+		missing := New(Options{ThresholdBytes: 10, StateStore: "missing", CompStore: compStore})
+		_, err := missing.Offload(t.Context(), "wf", taskCompleted(strings.Repeat("a", 100)))
+		require.Error(t, err)
+	})
+}
+
+func TestBinding(t *testing.T) {
+	binding := &fakeBinding{objects: make(map[string][]byte)}
+	compStore := compstore.New()
+	compStore.AddOutputBinding("blobs", binding)
+	s := New(Options{
+		AppID:              "app",
+		ThresholdBytes:     10,
+		Binding:            "blobs",
+		BindingKeyMetadata: "blobName",
+		CompStore:          compStore,
+	})
+
+	e := &protos.HistoryEvent{
+		EventId: -1,
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{
+				Name:  "wf",
+				Input: wrapperspb.String(strings.Repeat("b", 100)),
+			},
+		},
+	}
+	offloaded, err := s.Offload(t.Context(), "wf", e)
+	require.NoError(t, err)
+	key, ok := s.Key("wf", e)
+	require.True(t, ok)
+	assert.Equal(t, []byte(strings.Repeat("b", 100)), binding.objects[key])
+	assert.Equal(t, referencePrefix+key, offloaded.GetExecutionStarted().GetInput().GetValue())
+
+	resolved := proto.Clone(offloaded).(*backend.HistoryEvent)
+	require.NoError(t, s.ResolveAll(t.Context(), []*backend.HistoryEvent{resolved}))
+	assert.True(t, proto.Equal(e, resolved))
+
+	require.NoError(t, s.Resolve(t.Context(), offloaded))
+	assert.True(t, proto.Equal(e, offloaded))
+
+	require.NoError(t, s.Delete(t.Context(), []string{key}))
+	assert.Empty(t, binding.objects)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package payload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+// ErrNotFound is returned when an offloaded payload doesn't exist.
+var ErrNotFound = errors.New("payload not found")
+
+// bindingGetConcurrency is the maximum number of payloads fetched concurrently
+// from an output binding.
+const bindingGetConcurrency = 10
+
+// stateStore keeps offloaded payloads in a state store. The component is
+// looked up on every call, since components may be loaded after the workflow
+// engine is created.
+type stateStore struct {
+	name      string
+	compStore *compstore.ComponentStore
+}
+
+func (s *stateStore) store() (state.Store, error) {
+	store, ok := s.compStore.GetStateStore(s.name)
+	if !ok {
+		return nil, fmt.Errorf("state store '%s' not found", s.name)
+	}
+	return store, nil
+}
+
+func (s *stateStore) set(ctx context.Context, key string, data []byte) error {
+	store, err := s.store()
+	if err != nil {
+		return err
+	}
+	return store.Set(ctx, &state.SetRequest{Key: key, Value: data})
+}
+
+func (s *stateStore) get(ctx context.Context, key string) ([]byte, error) {
+	store, err := s.store()
+	if err != nil {
+		return nil, err
+	}
+	res, err := store.Get(ctx, &state.GetRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.Data == nil {
+		return nil, ErrNotFound
+	}
+	return res.Data, nil
+}
+
+func (s *stateStore) getBulk(ctx context.Context, keys []string) (map[string][]byte, error) {
+	store, err := s.store()
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]state.GetRequest, len(keys))
+	for i, key := range keys {
+		reqs[i] = state.GetRequest{Key: key}
+	}
+	res, err := store.BulkGet(ctx, reqs, state.BulkGetOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte, len(res))
+	for _, r := range res {
+		if r.Error != "" {
+			return nil, fmt.Errorf("failed to get payload '%s': %s", r.Key, r.Error)
+		}
+		if r.Data != nil {
+			data[r.Key] = r.Data
+		}
+	}
+	return data, nil
+}
+
+func (s *stateStore) delete(ctx context.Context, key string) error {
+	store, err := s.store()
+	if err != nil {
+		return err
+	}
+	return store.Delete(ctx, &state.DeleteRequest{Key: key})
+}
+
+// binding keeps offloaded payloads in an output binding, such as an object
+// storage binding, which supports the create, get and delete operations.
+type binding struct {
+	name        string
+	keyMetadata string
+	compStore   *compstore.ComponentStore
+}
+
+func (b *binding) invoke(ctx context.Context, op bindings.OperationKind, key string, data []byte) (*bindings.InvokeResponse, error) {
+	binding, ok := b.compStore.GetOutputBinding(b.name)
+	if !ok {
+		return nil, fmt.Errorf("output binding '%s' not found", b.name)
+	}
+	return binding.Invoke(ctx, &bindings.InvokeRequest{
+		Data:      data,
+		Metadata:  map[string]string{b.keyMetadata: key},
+		Operation: op,
+	})
+}
+
+func (b *binding) set(ctx context.Context, key string, data []byte) error {
+	_, err := b.invoke(ctx, bindings.CreateOperation, key, data)
+	return err
+}
+
+func (b *binding) get(ctx context.Context, key string) ([]byte, error) {
+	res, err := b.invoke(ctx, bindings.GetOperation, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.Data == nil {
+		return nil, ErrNotFound
+	}
+	return res.Data, nil
+}
+
+// getBulk fetches the payloads concurrently, since bindings have no bulk get
+// operation.
+func (b *binding) getBulk(ctx context.Context, keys []string) (map[string][]byte, error) {
+	var lock sync.Mutex
+	data := make(map[string][]byte, len(keys))
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(bindingGetConcurrency)
+	for _, key := range keys {
+		eg.Go(func() error {
+			d, err := b.get(ctx, key)
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get payload '%s': %w", key, err)
+			}
+			lock.Lock()
+			data[key] = d
+			lock.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (b *binding) delete(ctx context.Context, key string) error {
+	_, err := b.invoke(ctx, bindings.DeleteOperation, key, nil)
+	return err
+}
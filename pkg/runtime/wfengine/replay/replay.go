@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay replays the recorded history of a workflow against the
+// workflow code of a connected worker, to check that the workflow code is
+// deterministic with regard to the history before it is deployed.
+//
+// The recorded history is sent to the worker as past events, so the worker
+// only replays the workflow without executing anything. A deterministic
+// worker matches every action of the workflow code with the recorded history
+// and returns no action other than the completion recorded in the history.
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// Timeout is the maximum duration of a replay, including the time waiting for
+// a worker to be connected.
+const Timeout = time.Minute
+
+// idSeparator separates the exported instance ID from the random suffix of
+// the instance ID a history is replayed under.
+const idSeparator = "||dapr.replay||"
+
+// ErrEmptyHistory is returned when the history to replay has no events.
+var ErrEmptyHistory = errors.New("history has no events")
+
+// History is the exported history of a workflow.
+type History struct {
+	InstanceID string
+	Events     []*backend.HistoryEvent
+}
+
+// historyJSON is the JSON format of an exported history. Events are encoded
+// with the protobuf JSON mapping.
+type historyJSON struct {
+	InstanceID string            `json:"instanceID"`
+	Events     []json.RawMessage `json:"events"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *History) MarshalJSON() ([]byte, error) {
+	out := historyJSON{
+		InstanceID: h.InstanceID,
+		Events:     make([]json.RawMessage, len(h.Events)),
+	}
+	for i, e := range h.Events {
+		data, err := protojson.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal history event %d: %w", i, err)
+		}
+		out.Events[i] = data
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (h *History) UnmarshalJSON(data []byte) error {
+	var in historyJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	h.InstanceID = in.InstanceID
+	h.Events = make([]*backend.HistoryEvent, len(in.Events))
+	for i, raw := range in.Events {
+		h.Events[i] = new(backend.HistoryEvent)
+		if err := protojson.Unmarshal(raw, h.Events[i]); err != nil {
+			return fmt.Errorf("failed to unmarshal history event %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// EncodeEvents serializes history events with the protobuf wire format.
+func EncodeEvents(events []*backend.HistoryEvent) ([][]byte, error) {
+	out := make([][]byte, len(events))
+	for i, e := range events {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal history event %d: %w", i, err)
+		}
+		out[i] = data
+	}
+	return out, nil
+}
+
+// DecodeEvents deserializes history events encoded with EncodeEvents.
+func DecodeEvents(data [][]byte) ([]*backend.HistoryEvent, error) {
+	out := make([]*backend.HistoryEvent, len(data))
+	for i, d := range data {
+		out[i] = new(backend.HistoryEvent)
+		if err := proto.Unmarshal(d, out[i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal history event %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// Result is the result of a replay.
+type Result struct {
+	// Deterministic is true if the replay didn't diverge from the history.
+	Deterministic bool `json:"deterministic"`
+	// Divergences describe how the replay diverged from the history.
+	Divergences []string `json:"divergences,omitempty"`
+}
+
+// Executor executes workflows on a connected worker.
+type Executor interface {
+	ExecuteOrchestrator(ctx context.Context, iid api.InstanceID, oldEvents []*protos.HistoryEvent, newEvents []*protos.HistoryEvent) (*protos.OrchestratorResponse, error)
+}
+
+// Replay replays the history against the worker connected to the executor,
+// and reports the divergences of the replay from the history.
+// The history is replayed under a unique instance ID derived from the
+// exported one, so that the replay can't be mistaken for an execution of the
+// live workflow instance, which may run on the same worker at the same time.
+func Replay(ctx context.Context, executor Executor, h *History) (*Result, error) {
+	if len(h.Events) == 0 {
+		return nil, ErrEmptyHistory
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	// Workers expect at least one new event, so a new episode is started,
+	// at the time of the last recorded event, without any other event.
+	newEvents := []*backend.HistoryEvent{{
+		EventId:   -1,
+		Timestamp: h.Events[len(h.Events)-1].GetTimestamp(),
+		EventType: &protos.HistoryEvent_OrchestratorStarted{
+			OrchestratorStarted: &protos.OrchestratorStartedEvent{},
+		},
+	}}
+
+	iid := api.InstanceID(h.InstanceID + idSeparator + uuid.NewString())
+	resp, err := executor.ExecuteOrchestrator(ctx, iid, h.Events, newEvents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay workflow history: %w", err)
+	}
+
+	divergences := Compare(h.Events, resp.GetActions())
+	return &Result{
+		Deterministic: len(divergences) == 0,
+		Divergences:   divergences,
+	}, nil
+}
+
+// Compare returns the divergences of the actions returned by a worker which
+// replayed the history from the history.
+func Compare(history []*backend.HistoryEvent, actions []*protos.OrchestratorAction) []string {
+	var recorded *protos.ExecutionCompletedEvent
+	for _, e := range history {
+		if ec := e.GetExecutionCompleted(); ec != nil {
+			recorded = ec
+		}
+	}
+
+	var divergences []string
+	var completed bool
+	for _, a := range actions {
+		co := a.GetCompleteOrchestration()
+		if co == nil {
+			divergences = append(divergences, fmt.Sprintf("replay scheduled %s which is not in the history", describe(a)))
+			continue
+		}
+
+		completed = true
+		status := search.RuntimeStatus(co.GetOrchestrationStatus())
+		switch {
+		case recorded == nil:
+			divergence := "replay completed the workflow with status " + status + ", but the workflow is not completed in the history"
+			if fd := co.GetFailureDetails(); fd != nil {
+				divergence += fmt.Sprintf(": %s: %s", fd.GetErrorType(), fd.GetErrorMessage())
+			}
+			divergences = append(divergences, divergence)
+		case co.GetOrchestrationStatus() != recorded.GetOrchestrationStatus():
+			divergence := fmt.Sprintf("replay completed the workflow with status %s, but the workflow completed with status %s in the history", status, search.RuntimeStatus(recorded.GetOrchestrationStatus()))
+			if fd := co.GetFailureDetails(); fd != nil {
+				divergence += fmt.Sprintf(": %s: %s", fd.GetErrorType(), fd.GetErrorMessage())
+			}
+			divergences = append(divergences, divergence)
+		case co.GetResult().GetValue() != recorded.GetResult().GetValue():
+			divergences = append(divergences, fmt.Sprintf("replay completed the workflow with result %q, but the workflow completed with result %q in the history", co.GetResult().GetValue(), recorded.GetResult().GetValue()))
+		}
+	}
+
+	if recorded != nil && !completed {
+		divergences = append(divergences, fmt.Sprintf("workflow completed with status %s in the history, but replay didn't complete the workflow", search.RuntimeStatus(recorded.GetOrchestrationStatus())))
+	}
+
+	return divergences
+}
+
+// describe returns a description of an action, e.g. "activity 'Charge'".
+func describe(a *protos.OrchestratorAction) string {
+	switch {
+	case a.GetScheduleTask() != nil:
+		return fmt.Sprintf("activity '%s'", a.GetScheduleTask().GetName())
+	case a.GetCreateSubOrchestration() != nil:
+		return fmt.Sprintf("child workflow '%s'", a.GetCreateSubOrchestration().GetName())
+	case a.GetCreateTimer() != nil:
+		return "timer firing at " + a.GetCreateTimer().GetFireAt().AsTime().Format(time.RFC3339)
+	case a.GetSendEvent() != nil:
+		return fmt.Sprintf("event '%s' to workflow '%s'", a.GetSendEvent().GetName(), a.GetSendEvent().GetInstance().GetInstanceId())
+	case a.GetTerminateOrchestration() != nil:
+		return fmt.Sprintf("termination of workflow '%s'", a.GetTerminateOrchestration().GetInstanceId())
+	default:
+		return fmt.Sprintf("action %d", a.GetId())
+	}
+}
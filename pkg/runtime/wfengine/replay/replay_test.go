@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+type fakeExecutor struct {
+	actions   []*protos.OrchestratorAction
+	err       error
+	iid       api.InstanceID
+	oldEvents []*protos.HistoryEvent
+	newEvents []*protos.HistoryEvent
+}
+
+func (f *fakeExecutor) ExecuteOrchestrator(_ context.Context, iid api.InstanceID, oldEvents []*protos.HistoryEvent, newEvents []*protos.HistoryEvent) (*protos.OrchestratorResponse, error) {
+	f.iid, f.oldEvents, f.newEvents = iid, oldEvents, newEvents
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &protos.OrchestratorResponse{Actions: f.actions}, nil
+}
+
+func completedHistory(status protos.OrchestrationStatus, result string) []*backend.HistoryEvent {
+	return []*backend.HistoryEvent{
+		{
+			EventId:   -1,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionStarted{
+				ExecutionStarted: &protos.ExecutionStartedEvent{Name: "wf"},
+			},
+		},
+		{
+			EventId:   0,
+			Timestamp: timestamppb.Now(),
+			EventType: &protos.HistoryEvent_ExecutionCompleted{
+				ExecutionCompleted: &protos.ExecutionCompletedEvent{
+					OrchestrationStatus: status,
+					Result:              wrapperspb.String(result),
+				},
+			},
+		},
+	}
+}
+
+func completeAction(status protos.OrchestrationStatus, result string) *protos.OrchestratorAction {
+	return &protos.OrchestratorAction{
+		OrchestratorActionType: &protos.OrchestratorAction_CompleteOrchestration{
+			CompleteOrchestration: &protos.CompleteOrchestrationAction{
+				OrchestrationStatus: status,
+				Result:              wrapperspb.String(result),
+			},
+		},
+	}
+}
+
+func scheduleTaskAction(name string) *protos.OrchestratorAction {
+	return &protos.OrchestratorAction{
+		OrchestratorActionType: &protos.OrchestratorAction_ScheduleTask{
+			ScheduleTask: &protos.ScheduleTaskAction{Name: name},
+		},
+	}
+}
+
+func TestHistoryJSON(t *testing.T) {
+	h := &History{
+		InstanceID: "abc",
+		Events:     completedHistory(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED, `"ok"`),
+	}
+
+	data, err := json.Marshal(h)
+	require.NoError(t, err)
+
+	var got History
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, "abc", got.InstanceID)
+	require.Len(t, got.Events, 2)
+	for i := range h.Events {
+		assert.True(t, proto.Equal(h.Events[i], got.Events[i]))
+	}
+
+	require.Error(t, json.Unmarshal([]byte(`{"events":[{"eventId":"x"}]}`), &got))
+}
+
+func TestEncodeEvents(t *testing.T) {
+	events := completedHistory(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED, `"ok"`)
+
+	data, err := EncodeEvents(events)
+	require.NoError(t, err)
+	require.Len(t, data, 2)
+
+	got, err := DecodeEvents(data)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i := range events {
+		assert.True(t, proto.Equal(events[i], got[i]))
+	}
+
+	_, err = DecodeEvents([][]byte{{0xff}})
+	require.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	completed := protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED
+	failed := protos.OrchestrationStatus_ORCHESTRATION_STATUS_FAILED
+
+	t.Run("matching completion", func(t *testing.T) {
+		assert.Empty(t, Compare(completedHistory(completed, "1"), []*protos.OrchestratorAction{completeAction(completed, "1")}))
+	})
+
+	t.Run("running workflow without actions", func(t *testing.T) {
+		assert.Empty(t, Compare(completedHistory(completed, "1")[:1], nil))
+	})
+
+	t.Run("unexpected activity", func(t *testing.T) {
+		divergences := Compare(completedHistory(completed, "1")[:1], []*protos.OrchestratorAction{scheduleTaskAction("Charge")})
+		require.Len(t, divergences, 1)
+		assert.Contains(t, divergences[0], "activity 'Charge'")
+	})
+
+	t.Run("different status", func(t *testing.T) {
+		divergences := Compare(completedHistory(completed, "1"), []*protos.OrchestratorAction{completeAction(failed, "")})
+		require.Len(t, divergences, 1)
+		assert.Contains(t, divergences[0], "status FAILED")
+	})
+
+	t.Run("different result", func(t *testing.T) {
+		divergences := Compare(completedHistory(completed, "1"), []*protos.OrchestratorAction{completeAction(completed, "2")})
+		require.Len(t, divergences, 1)
+		assert.Contains(t, divergences[0], `result "2"`)
+	})
+
+	t.Run("completed only in replay", func(t *testing.T) {
+		divergences := Compare(completedHistory(completed, "1")[:1], []*protos.OrchestratorAction{completeAction(completed, "1")})
+		require.Len(t, divergences, 1)
+		assert.Contains(t, divergences[0], "not completed in the history")
+	})
+
+	t.Run("completed only in history", func(t *testing.T) {
+		divergences := Compare(completedHistory(completed, "1"), nil)
+		require.Len(t, divergences, 1)
+		assert.Contains(t, divergences[0], "didn't complete the workflow")
+	})
+}
+
+func TestReplay(t *testing.T) {
+	completed := protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED
+
+	t.Run("empty history", func(t *testing.T) {
+		_, err := Replay(t.Context(), &fakeExecutor{}, &History{InstanceID: "abc"})
+		require.ErrorIs(t, err, ErrEmptyHistory)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		h := &History{InstanceID: "abc", Events: completedHistory(completed, "1")}
+		executor := &fakeExecutor{actions: []*protos.OrchestratorAction{completeAction(completed, "1")}}
+
+		res, err := Replay(t.Context(), executor, h)
+		require.NoError(t, err)
+		assert.True(t, res.Deterministic)
+		assert.Empty(t, res.Divergences)
+		assert.True(t, strings.HasPrefix(string(executor.iid), "abc"+idSeparator))
+		assert.NotEqual(t, api.InstanceID("abc"), executor.iid)
+		assert.Equal(t, h.Events, executor.oldEvents)
+		require.Len(t, executor.newEvents, 1)
+		assert.NotNil(t, executor.newEvents[0].GetOrchestratorStarted())
+		assert.True(t, proto.Equal(h.Events[1].GetTimestamp(), executor.newEvents[0].GetTimestamp()))
+	})
+
+	t.Run("not deterministic", func(t *testing.T) {
+		h := &History{InstanceID: "abc", Events: completedHistory(completed, "1")}
+		executor := &fakeExecutor{actions: []*protos.OrchestratorAction{scheduleTaskAction("Charge")}}
+
+		res, err := Replay(t.Context(), executor, h)
+		require.NoError(t, err)
+		assert.False(t, res.Deterministic)
+		assert.Len(t, res.Divergences, 2)
+	})
+
+	t.Run("executor error", func(t *testing.T) {
+		h := &History{InstanceID: "abc", Events: completedHistory(completed, "1")}
+		_, err := Replay(t.Context(), &fakeExecutor{err: errors.New("no worker")}, h)
+		require.ErrorContains(t, err, "no worker")
+	})
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	keySeparator = "||"
+	keyType      = "dapr.workflow.search"
+
+	defaultPageSize = 100
+)
+
+// ErrNoStateStore is returned when no state store is available to index the
+// search attributes of workflows.
+var ErrNoStateStore = errors.New("no state store is configured to index workflow search attributes")
+
+type Options struct {
+	AppID string
+
+	// StoreName is the name of the state store in which search attributes are
+	// indexed. The actor state store is used if empty.
+	StoreName string
+
+	CompStore  *compstore.ComponentStore
+	Resiliency resiliency.Provider
+}
+
+// Index indexes the search attributes of the workflows of an app in a state
+// store, and lists the workflows matching a query. Listing requires the state
+// store to support queries.
+type Index struct {
+	appID      string
+	storeName  string
+	compStore  *compstore.ComponentStore
+	resiliency resiliency.Provider
+}
+
+// Query selects the workflows to list. All conditions must match.
+type Query struct {
+	// RuntimeStatus is the runtime status of the workflows, e.g. "RUNNING".
+	RuntimeStatus string
+	// SearchAttributes are the values which the search attributes of the
+	// workflows must be equal to.
+	SearchAttributes Attributes
+
+	PageSize int
+	Token    string
+}
+
+type QueryResponse struct {
+	Workflows []*Record `json:"workflows"`
+	Token     string    `json:"token,omitempty"`
+}
+
+func New(opts Options) *Index {
+	return &Index{
+		appID:      opts.AppID,
+		storeName:  opts.StoreName,
+		compStore:  opts.CompStore,
+		resiliency: opts.Resiliency,
+	}
+}
+
+// Save indexes the record of a workflow, replacing its previous record.
+func (i *Index) Save(ctx context.Context, r *Record) error {
+	store, storeName, err := i.store()
+	if err != nil {
+		return err
+	}
+
+	r.AppID = i.appID
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	req := &state.SetRequest{
+		Key:         i.key(r.InstanceID),
+		Value:       data,
+		ContentType: ptr.Of("application/json"),
+	}
+	_, err = i.policy(ctx, storeName)(func(ctx context.Context) (any, error) {
+		return nil, store.Set(ctx, req)
+	})
+	return err
+}
+
+// Delete removes the record of a workflow from the index.
+func (i *Index) Delete(ctx context.Context, instanceID string) error {
+	store, storeName, err := i.store()
+	if err != nil {
+		return err
+	}
+
+	req := &state.DeleteRequest{Key: i.key(instanceID)}
+	_, err = i.policy(ctx, storeName)(func(ctx context.Context) (any, error) {
+		return nil, store.Delete(ctx, req)
+	})
+	return err
+}
+
+// Query lists the indexed workflows of the app matching the query.
+func (i *Index) Query(ctx context.Context, q *Query) (*QueryResponse, error) {
+	store, storeName, err := i.store()
+	if err != nil {
+		return nil, err
+	}
+
+	querier, ok := store.(state.Querier)
+	if !ok {
+		return nil, errors.New("the state store used to index workflow search attributes does not support queries")
+	}
+
+	req, err := i.buildQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	policyRunner := resiliency.NewRunner[*state.QueryResponse](ctx,
+		i.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*state.QueryResponse, error) {
+		return querier.Query(ctx, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query workflow search attributes: %w", err)
+	}
+
+	res := &QueryResponse{
+		Workflows: make([]*Record, 0, len(resp.Results)),
+		Token:     resp.Token,
+	}
+	for _, item := range resp.Results {
+		if len(item.Error) > 0 {
+			continue
+		}
+		var r Record
+		if err = json.Unmarshal(item.Data, &r); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workflow search record '%s': %w", item.Key, err)
+		}
+		res.Workflows = append(res.Workflows, &r)
+	}
+	return res, nil
+}
+
+// buildQuery returns the state store query of the workflows of the app
+// matching the query.
+func (i *Index) buildQuery(q *Query) (*state.QueryRequest, error) {
+	filters := []map[string]any{
+		{"EQ": map[string]any{"appID": i.appID}},
+	}
+	if len(q.RuntimeStatus) > 0 {
+		filters = append(filters, map[string]any{"EQ": map[string]any{"runtimeStatus": q.RuntimeStatus}})
+	}
+
+	// Sort keys for a deterministic query.
+	keys := make([]string, 0, len(q.SearchAttributes))
+	for k := range q.SearchAttributes {
+		if err := ValidateKey(k); err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		filters = append(filters, map[string]any{"EQ": map[string]any{"searchAttributes." + k: q.SearchAttributes[k]}})
+	}
+
+	var filter map[string]any
+	if len(filters) == 1 {
+		filter = filters[0]
+	} else {
+		filter = map[string]any{"AND": filters}
+	}
+
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"filter": filter,
+		"page":   query.Pagination{Limit: pageSize, Token: q.Token},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var req state.QueryRequest
+	if err = json.Unmarshal(data, &req.Query); err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+	return &req, nil
+}
+
+// policy returns the runner of the resiliency policy of writes to the state
+// store with the given name.
+func (i *Index) policy(ctx context.Context, storeName string) resiliency.Runner[any] {
+	return resiliency.NewRunner[any](ctx,
+		i.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+}
+
+func (i *Index) store() (state.Store, string, error) {
+	if len(i.storeName) > 0 {
+		store, ok := i.compStore.GetStateStore(i.storeName)
+		if !ok {
+			return nil, "", fmt.Errorf("state store '%s' configured to index workflow search attributes not found", i.storeName)
+		}
+		return store, i.storeName, nil
+	}
+
+	store, storeName, ok := i.compStore.GetStateStoreActor()
+	if !ok {
+		return nil, "", ErrNoStateStore
+	}
+	return store, storeName, nil
+}
+
+func (i *Index) key(instanceID string) string {
+	return i.appID + keySeparator + keyType + keySeparator + instanceID
+}
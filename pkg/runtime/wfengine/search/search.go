@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package search implements the search attributes of workflows, which are
+// typed key/value pairs set by workflow code and indexed in a query-capable
+// state store so that workflows can be listed by business key.
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// EventName is the name of the event which a workflow sends to its own
+// instance to upsert its search attributes. The input of the event is a JSON
+// object of the attributes to set; attributes set to null are removed.
+const EventName = "dapr.workflow.upsert_search_attributes"
+
+// CarryOverTag is the tag of the execution started event of a workflow which
+// the engine continued as new, holding the search attributes of the previous
+// execution as a JSON object.
+const CarryOverTag = "dapr.workflow.search_attributes"
+
+var attributeKeyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// Attributes are the search attributes of a workflow. Values are strings,
+// numbers (float64) or booleans.
+type Attributes map[string]any
+
+// Record is the document indexed for a workflow with search attributes.
+type Record struct {
+	AppID            string     `json:"appID"`
+	InstanceID       string     `json:"instanceID"`
+	WorkflowName     string     `json:"workflowName"`
+	RuntimeStatus    string     `json:"runtimeStatus"`
+	SearchAttributes Attributes `json:"searchAttributes"`
+	LastUpdatedAt    time.Time  `json:"lastUpdatedAt"`
+}
+
+// ParseUpsert parses the input of an upsert event. A nil value in the
+// returned attributes marks the attribute for removal.
+func ParseUpsert(input string) (Attributes, error) {
+	var attrs map[string]any
+	if err := json.Unmarshal([]byte(input), &attrs); err != nil {
+		return nil, fmt.Errorf("search attributes must be a JSON object: %w", err)
+	}
+
+	for k, v := range attrs {
+		if err := ValidateKey(k); err != nil {
+			return nil, err
+		}
+		switch v.(type) {
+		case nil, string, float64, bool:
+		default:
+			return nil, fmt.Errorf("search attribute '%s' must be a string, number, boolean or null", k)
+		}
+	}
+	return attrs, nil
+}
+
+// ValidateKey returns an error if the key is not a valid search attribute key.
+// Keys are used as paths of state store queries, so they are restricted to
+// letters, digits and underscores.
+func ValidateKey(key string) error {
+	if !attributeKeyRegexp.MatchString(key) {
+		return fmt.Errorf("search attribute key '%s' is invalid: only letters, digits and underscores are allowed", key)
+	}
+	return nil
+}
+
+// FromHistory returns the search attributes of the workflow with the given
+// instance ID, by applying the upserts recorded in its history in order.
+// Invalid upserts are skipped. Search attributes are reset when the workflow
+// continues as new, along with its history, unless they were carried over by
+// the engine.
+func FromHistory(instanceID string, events []*backend.HistoryEvent) Attributes {
+	var attrs Attributes
+	for _, e := range events {
+		var input string
+		if es := e.GetExecutionStarted(); es != nil {
+			carried, ok := es.GetTags()[CarryOverTag]
+			if !ok {
+				continue
+			}
+			input = carried
+		} else {
+			upsert, ok := upsertEvent(instanceID, e.GetEventSent())
+			if !ok {
+				continue
+			}
+			input = upsert.GetInput().GetValue()
+		}
+
+		updates, err := ParseUpsert(input)
+		if err != nil {
+			continue
+		}
+		if attrs == nil {
+			attrs = make(Attributes, len(updates))
+		}
+		for k, v := range updates {
+			if v == nil {
+				delete(attrs, k)
+			} else {
+				attrs[k] = v
+			}
+		}
+	}
+	return attrs
+}
+
+// CarryOver records the search attributes in the execution started event of
+// a workflow which is continued as new by the engine.
+func CarryOver(attrs Attributes, es *protos.ExecutionStartedEvent) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+	if es.Tags == nil {
+		es.Tags = make(map[string]string, 1)
+	}
+	es.Tags[CarryOverTag] = string(data)
+	return nil
+}
+
+// IsUpsert returns true if the message is an upsert of the search attributes
+// of the workflow with the given instance ID.
+func IsUpsert(instanceID string, msg *backend.OrchestrationRuntimeStateMessage) bool {
+	if msg.GetTargetInstanceID() != instanceID {
+		return false
+	}
+	_, ok := upsertEvent(instanceID, msg.GetHistoryEvent().GetEventSent())
+	return ok
+}
+
+func upsertEvent(instanceID string, e *protos.EventSentEvent) (*protos.EventSentEvent, bool) {
+	if e == nil || e.GetName() != EventName || e.GetInstanceId() != instanceID {
+		return nil, false
+	}
+	return e, true
+}
+
+// RuntimeStatus returns the name of the runtime status as used by the
+// workflow API, e.g. "RUNNING".
+func RuntimeStatus(status protos.OrchestrationStatus) string {
+	return strings.TrimPrefix(status.String(), "ORCHESTRATION_STATUS_")
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/components-contrib/state/query"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func TestParseUpsert(t *testing.T) {
+	t.Run("valid attributes", func(t *testing.T) {
+		attrs, err := ParseUpsert(`{"customerId":42,"region":"eu","vip":true,"old":null}`)
+		require.NoError(t, err)
+		assert.Equal(t, Attributes{"customerId": float64(42), "region": "eu", "vip": true, "old": nil}, attrs)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		_, err := ParseUpsert(`[1,2]`)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := ParseUpsert(`{"customer.id":42}`)
+		require.ErrorContains(t, err, "customer.id")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := ParseUpsert(`{"items":[1,2]}`)
+		require.ErrorContains(t, err, "items")
+	})
+}
+
+func upsert(instanceID, input string) *backend.HistoryEvent {
+	return &protos.HistoryEvent{
+		EventType: &protos.HistoryEvent_EventSent{
+			EventSent: &protos.EventSentEvent{
+				InstanceId: instanceID,
+				Name:       EventName,
+				Input:      wrapperspb.String(input),
+			},
+		},
+	}
+}
+
+func TestFromHistory(t *testing.T) {
+	t.Run("no upserts", func(t *testing.T) {
+		assert.Nil(t, FromHistory("abc", []*backend.HistoryEvent{
+			{EventType: &protos.HistoryEvent_OrchestratorStarted{OrchestratorStarted: &protos.OrchestratorStartedEvent{}}},
+		}))
+	})
+
+	t.Run("upserts are applied in order", func(t *testing.T) {
+		attrs := FromHistory("abc", []*backend.HistoryEvent{
+			upsert("abc", `{"customerId":42,"stage":"new"}`),
+			upsert("abc", `{"stage":"paid","region":"eu"}`),
+			upsert("abc", `{"region":null}`),
+			upsert("abc", `not json`),
+			upsert("other", `{"stage":"shipped"}`),
+		})
+		assert.Equal(t, Attributes{"customerId": float64(42), "stage": "paid"}, attrs)
+	})
+
+	t.Run("carried over attributes", func(t *testing.T) {
+		es := &protos.ExecutionStartedEvent{Name: "wf"}
+		require.NoError(t, CarryOver(Attributes{"customerId": float64(42), "stage": "paid"}, es))
+		attrs := FromHistory("abc", []*backend.HistoryEvent{
+			{EventType: &protos.HistoryEvent_ExecutionStarted{ExecutionStarted: es}},
+			upsert("abc", `{"stage":"shipped"}`),
+		})
+		assert.Equal(t, Attributes{"customerId": float64(42), "stage": "shipped"}, attrs)
+	})
+}
+
+func TestCarryOver(t *testing.T) {
+	es := &protos.ExecutionStartedEvent{}
+	require.NoError(t, CarryOver(nil, es))
+	assert.Empty(t, es.GetTags())
+
+	require.NoError(t, CarryOver(Attributes{"region": "eu"}, es))
+	assert.JSONEq(t, `{"region":"eu"}`, es.GetTags()[CarryOverTag])
+}
+
+func TestIsUpsert(t *testing.T) {
+	assert.True(t, IsUpsert("abc", &backend.OrchestrationRuntimeStateMessage{
+		HistoryEvent:     upsert("abc", `{}`),
+		TargetInstanceID: "abc",
+	}))
+	assert.False(t, IsUpsert("abc", &backend.OrchestrationRuntimeStateMessage{
+		HistoryEvent:     upsert("other", `{}`),
+		TargetInstanceID: "other",
+	}))
+	assert.False(t, IsUpsert("abc", &backend.OrchestrationRuntimeStateMessage{
+		HistoryEvent: &protos.HistoryEvent{
+			EventType: &protos.HistoryEvent_EventSent{
+				EventSent: &protos.EventSentEvent{InstanceId: "abc", Name: "update"},
+			},
+		},
+		TargetInstanceID: "abc",
+	}))
+}
+
+func TestRuntimeStatus(t *testing.T) {
+	assert.Equal(t, "RUNNING", RuntimeStatus(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING))
+	assert.Equal(t, "COMPLETED", RuntimeStatus(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED))
+}
+
+func TestBuildQuery(t *testing.T) {
+	i := New(Options{AppID: "myapp"})
+
+	t.Run("app only", func(t *testing.T) {
+		req, err := i.buildQuery(&Query{})
+		require.NoError(t, err)
+		assert.Equal(t, &query.EQ{Key: "appID", Val: "myapp"}, req.Query.Filter)
+		assert.Equal(t, defaultPageSize, req.Query.Page.Limit)
+	})
+
+	t.Run("status and search attributes", func(t *testing.T) {
+		req, err := i.buildQuery(&Query{
+			RuntimeStatus:    "RUNNING",
+			SearchAttributes: Attributes{"region": "eu", "customerId": float64(42)},
+			PageSize:         10,
+			Token:            "next",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &query.AND{Filters: []query.Filter{
+			&query.EQ{Key: "appID", Val: "myapp"},
+			&query.EQ{Key: "runtimeStatus", Val: "RUNNING"},
+			&query.EQ{Key: "searchAttributes.customerId", Val: float64(42)},
+			&query.EQ{Key: "searchAttributes.region", Val: "eu"},
+		}}, req.Query.Filter)
+		assert.Equal(t, 10, req.Query.Page.Limit)
+		assert.Equal(t, "next", req.Query.Page.Token)
+	})
+
+	t.Run("invalid search attribute key", func(t *testing.T) {
+		_, err := i.buildQuery(&Query{SearchAttributes: Attributes{"a.b": "c"}})
+		require.Error(t, err)
+	})
+}
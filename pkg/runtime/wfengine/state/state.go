@@ -17,6 +17,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,6 +27,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	"github.com/dapr/dapr/pkg/actors/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
 	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
@@ -35,6 +38,13 @@ const (
 	historyKeyPrefix = "history"
 	customStatusKey  = "customStatus"
 	metadataKey      = "metadata"
+	stalledKey       = "stalled"
+	outboxKey        = "lifecycleOutbox"
+
+	// deleteBatchSize is the maximum number of history keys deleted in a
+	// transaction by the requests returned by ResetHistory, to stay within the
+	// operation limits of state stores.
+	deleteBatchSize = 100
 )
 
 var wfLogger = logger.NewLogger("dapr.runtime.actor.target.workflow.state")
@@ -43,23 +53,45 @@ type Options struct {
 	AppID             string
 	WorkflowActorType string
 	ActivityActorType string
+
+	// Payloads offloads the large payloads of the events of the workflow.
+	// Payloads are not offloaded if nil.
+	Payloads *payload.Store
 }
 
 type State struct {
 	appID             string
 	workflowActorType string
 	activityActorType string
+	payloads          *payload.Store
 
 	Inbox        []*backend.HistoryEvent
 	History      []*backend.HistoryEvent
 	CustomStatus *wrapperspb.StringValue
 	Generation   uint64
 
+	// Stalled holds the reason the workflow is not being executed, such as no
+	// connected worker being compatible with its version. It is reported as
+	// the failure details of the running workflow.
+	Stalled *protos.TaskFailureDetails
+
+	// Outbox holds the serialized lifecycle events of the workflow which are
+	// yet to be published. They are saved along with the history events they
+	// are derived from, so that they are published at least once.
+	Outbox []json.RawMessage
+
 	// change tracking
 	inboxAddedCount     int
 	inboxRemovedCount   int
 	historyAddedCount   int
 	historyRemovedCount int
+	stalledChanged      bool
+	outboxAddedCount    int
+	outboxChanged       bool
+
+	// payloadKeys are the keys of the offloaded payloads referenced by the
+	// saved state.
+	payloadKeys map[string]struct{}
 }
 
 // TODO: @joshvanl: remove in v1.16
@@ -75,6 +107,7 @@ func NewState(opts Options) *State {
 		appID:             opts.AppID,
 		workflowActorType: opts.WorkflowActorType,
 		activityActorType: opts.ActivityActorType,
+		payloads:          opts.Payloads,
 	}
 }
 
@@ -89,12 +122,65 @@ func (s *State) Reset() {
 	s.Generation++
 }
 
+// ResetHistory resets the state like Reset, except that the keys of the
+// previous history are not deleted by the next save request. Instead, it
+// returns the requests which delete them in batches, to be run once the reset
+// state is saved: a single transaction deleting a long history can exceed the
+// operation limit of the state store. Keys left behind if they fail are beyond
+// the saved history length, so they are never loaded.
+func (s *State) ResetHistory(actorID string) []*api.TransactionalRequest {
+	s.Reset()
+	stale := s.historyRemovedCount
+	s.historyRemovedCount = 0
+
+	reqs := make([]*api.TransactionalRequest, 0, (stale+deleteBatchSize-1)/deleteBatchSize)
+	for start := 0; start < stale; start += deleteBatchSize {
+		req := &api.TransactionalRequest{
+			ActorType:  s.workflowActorType,
+			ActorID:    actorID,
+			Operations: make([]api.TransactionalOperation, 0, min(deleteBatchSize, stale-start)),
+		}
+		for i := start; i < min(start+deleteBatchSize, stale); i++ {
+			req.Operations = append(req.Operations, api.TransactionalOperation{
+				Operation: api.Delete,
+				//nolint:gosec
+				Request: api.TransactionalDelete{Key: getMultiEntryKeyName(historyKeyPrefix, uint64(i))},
+			})
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
 // ResetChangeTracking resets the change tracking counters. This should be called after a save request.
 func (s *State) ResetChangeTracking() {
 	s.inboxAddedCount = 0
 	s.inboxRemovedCount = 0
 	s.historyAddedCount = 0
 	s.historyRemovedCount = 0
+	s.stalledChanged = false
+	s.outboxAddedCount = 0
+	s.outboxChanged = false
+}
+
+// AddToOutbox adds the lifecycle events of the history events added since
+// the last save to the outbox, replacing the events added by a previous call
+// since then, as they are derived from the same history events.
+func (s *State) AddToOutbox(events []json.RawMessage) {
+	s.Outbox = append(s.Outbox[:len(s.Outbox)-s.outboxAddedCount], events...)
+	s.outboxAddedCount = len(events)
+	s.outboxChanged = true
+}
+
+// RemoveFromOutbox removes the first n lifecycle events of the outbox, once
+// they are published.
+func (s *State) RemoveFromOutbox(n int) {
+	if n <= 0 {
+		return
+	}
+	s.Outbox = slices.Clone(s.Outbox[n:])
+	s.outboxAddedCount = min(s.outboxAddedCount, len(s.Outbox))
+	s.outboxChanged = true
 }
 
 func (s *State) ApplyRuntimeStateChanges(rs *backend.OrchestrationRuntimeState) {
@@ -111,6 +197,35 @@ func (s *State) ApplyRuntimeStateChanges(rs *backend.OrchestrationRuntimeState)
 	s.CustomStatus = rs.GetCustomStatus()
 }
 
+// SetStalled sets the reason the workflow is not being executed, or clears it
+// if nil. It returns true if the reason changed and needs to be saved.
+func (s *State) SetStalled(details *protos.TaskFailureDetails) bool {
+	if proto.Equal(s.Stalled, details) {
+		return false
+	}
+	s.Stalled = details
+	s.stalledChanged = true
+	return true
+}
+
+// StalledFailureDetails returns the reason the workflow is not being executed
+// as the failure details of a workflow with the given runtime status. It
+// returns nil once the workflow has completed.
+func (s *State) StalledFailureDetails(status protos.OrchestrationStatus) *protos.TaskFailureDetails {
+	if s == nil {
+		return nil
+	}
+
+	switch status {
+	case protos.OrchestrationStatus_ORCHESTRATION_STATUS_PENDING,
+		protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING,
+		protos.OrchestrationStatus_ORCHESTRATION_STATUS_SUSPENDED:
+		return s.Stalled
+	default:
+		return nil
+	}
+}
+
 func (s *State) AddToInbox(e *backend.HistoryEvent) {
 	s.Inbox = append(s.Inbox, e)
 	s.inboxAddedCount++
@@ -121,6 +236,12 @@ func (s *State) AddToHistory(e *backend.HistoryEvent) {
 	s.historyAddedCount++
 }
 
+// AddedHistory returns the events added to the history since the last save
+// request.
+func (s *State) AddedHistory() []*backend.HistoryEvent {
+	return s.History[len(s.History)-s.historyAddedCount:]
+}
+
 func (s *State) ClearInbox() {
 	for _, e := range s.Inbox {
 		if e.GetTimerFired() != nil {
@@ -133,18 +254,18 @@ func (s *State) ClearInbox() {
 	s.inboxAddedCount = 0
 }
 
-func (s *State) GetSaveRequest(actorID string) (*api.TransactionalRequest, error) {
+func (s *State) GetSaveRequest(ctx context.Context, actorID string) (*api.TransactionalRequest, error) {
 	// TODO: Batching up the save requests into smaller chunks to avoid batch size limits in Dapr state stores.
 	req := &api.TransactionalRequest{
 		ActorType: s.workflowActorType,
 		ActorID:   actorID,
 	}
 
-	if err := addStateOperations(req, inboxKeyPrefix, s.Inbox, s.inboxAddedCount, s.inboxRemovedCount); err != nil {
+	if err := s.addStateOperations(ctx, req, inboxKeyPrefix, s.Inbox, s.inboxAddedCount, s.inboxRemovedCount); err != nil {
 		return nil, err
 	}
 
-	if err := addStateOperations(req, historyKeyPrefix, s.History, s.historyAddedCount, s.historyRemovedCount); err != nil {
+	if err := s.addStateOperations(ctx, req, historyKeyPrefix, s.History, s.historyAddedCount, s.historyRemovedCount); err != nil {
 		return nil, err
 	}
 
@@ -166,6 +287,42 @@ func (s *State) GetSaveRequest(actorID string) (*api.TransactionalRequest, error
 		})
 	}
 
+	if s.stalledChanged {
+		if s.Stalled == nil {
+			req.Operations = append(req.Operations, api.TransactionalOperation{
+				Operation: api.Delete,
+				Request:   api.TransactionalDelete{Key: stalledKey},
+			})
+		} else {
+			stalledProto, err := proto.Marshal(s.Stalled)
+			if err != nil {
+				return nil, err
+			}
+			req.Operations = append(req.Operations, api.TransactionalOperation{
+				Operation: api.Upsert,
+				Request:   api.TransactionalUpsert{Key: stalledKey, Value: stalledProto},
+			})
+		}
+	}
+
+	if s.outboxChanged {
+		if len(s.Outbox) == 0 {
+			req.Operations = append(req.Operations, api.TransactionalOperation{
+				Operation: api.Delete,
+				Request:   api.TransactionalDelete{Key: outboxKey},
+			})
+		} else {
+			outboxJSON, err := json.Marshal(s.Outbox)
+			if err != nil {
+				return nil, err
+			}
+			req.Operations = append(req.Operations, api.TransactionalOperation{
+				Operation: api.Upsert,
+				Request:   api.TransactionalUpsert{Key: outboxKey, Value: outboxJSON},
+			})
+		}
+	}
+
 	metaProto, err := proto.Marshal(&backend.WorkflowStateMetadata{
 		InboxLength:   uint64(len(s.Inbox)),
 		HistoryLength: uint64(len(s.History)),
@@ -216,12 +373,16 @@ func (s *State) String() string {
 	)
 }
 
-func addStateOperations(req *api.TransactionalRequest, keyPrefix string, events []*backend.HistoryEvent, addedCount int, removedCount int) error {
+func (s *State) addStateOperations(ctx context.Context, req *api.TransactionalRequest, keyPrefix string, events []*backend.HistoryEvent, addedCount int, removedCount int) error {
 	// TODO: Investigate whether Dapr state stores put limits on batch sizes. It seems some storage
 	//       providers have limits and we need to know if that impacts this algorithm:
 	//       https://learn.microsoft.com/azure/cosmos-db/nosql/transactional-batch#limitations
 	for i := len(events) - addedCount; i < len(events); i++ {
-		data, err := proto.Marshal(events[i])
+		e, err := s.payloads.Offload(ctx, req.ActorID, events[i])
+		if err != nil {
+			return err
+		}
+		data, err := proto.Marshal(e)
 		if err != nil {
 			return err
 		}
@@ -295,13 +456,18 @@ func LoadWorkflowState(ctx context.Context, state state.Interface, actorID strin
 	bulkReq := &api.GetBulkStateRequest{
 		ActorType: opts.WorkflowActorType,
 		ActorID:   actorID,
-		// Initializing with size for all the inbox, history, and custom status
-		Keys: make([]string, metadata.GetInboxLength()+metadata.GetHistoryLength()+1),
+		// Initializing with size for all the inbox, history, custom status,
+		// stalled reason and outbox
+		Keys: make([]string, metadata.GetInboxLength()+metadata.GetHistoryLength()+3),
 	}
 
 	var n int
 	bulkReq.Keys[n] = customStatusKey
 	n++
+	bulkReq.Keys[n] = stalledKey
+	n++
+	bulkReq.Keys[n] = outboxKey
+	n++
 	for i := range metadata.GetInboxLength() {
 		bulkReq.Keys[n] = getMultiEntryKeyName(inboxKeyPrefix, i)
 		n++
@@ -350,6 +516,11 @@ func LoadWorkflowState(ctx context.Context, state state.Interface, actorID strin
 		}
 	}
 
+	// Offloaded payloads are fetched in one batch.
+	if err = opts.Payloads.ResolveAll(ctx, slices.Concat(wState.Inbox, wState.History)); err != nil {
+		return nil, fmt.Errorf("failed to load workflow state: %w", err)
+	}
+
 	if len(bulkRes[customStatusKey]) > 0 {
 		wState.CustomStatus = &wrapperspb.StringValue{}
 		err = proto.Unmarshal(bulkRes[customStatusKey], wState.CustomStatus)
@@ -364,16 +535,77 @@ func LoadWorkflowState(ctx context.Context, state state.Interface, actorID strin
 		}
 	}
 
+	if len(bulkRes[stalledKey]) > 0 {
+		wState.Stalled = &protos.TaskFailureDetails{}
+		if err = proto.Unmarshal(bulkRes[stalledKey], wState.Stalled); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stalled state key entry: %w", err)
+		}
+	}
+
+	if len(bulkRes[outboxKey]) > 0 {
+		if err = json.Unmarshal(bulkRes[outboxKey], &wState.Outbox); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal lifecycle outbox state key entry: %w", err)
+		}
+	}
+
+	wState.payloadKeys = wState.referencedPayloadKeys(actorID)
+
 	wfLogger.Infof("%s: loaded %d state records in %v", actorID, 1+len(bulkRes), time.Since(loadStartTime))
 	return wState, nil
 }
 
+// DeleteUnreferencedPayloads deletes the offloaded payloads which were
+// referenced by the previously saved state, but are no longer referenced by
+// the state. This should be called after a save request succeeds.
+func (s *State) DeleteUnreferencedPayloads(ctx context.Context, actorID string) error {
+	if s.payloads == nil {
+		return nil
+	}
+
+	referenced := s.referencedPayloadKeys(actorID)
+	var unreferenced []string
+	for key := range s.payloadKeys {
+		if _, ok := referenced[key]; !ok {
+			unreferenced = append(unreferenced, key)
+		}
+	}
+	s.payloadKeys = referenced
+	return s.payloads.Delete(ctx, unreferenced)
+}
+
+// DeletePayloads deletes all the offloaded payloads of the state. This should
+// be called after a purge request succeeds.
+func (s *State) DeletePayloads(ctx context.Context, actorID string) error {
+	if s.payloads == nil {
+		return nil
+	}
+
+	keys := s.referencedPayloadKeys(actorID)
+	for key := range s.payloadKeys {
+		keys[key] = struct{}{}
+	}
+	s.payloadKeys = nil
+	return s.payloads.Delete(ctx, slices.Collect(maps.Keys(keys)))
+}
+
+func (s *State) referencedPayloadKeys(actorID string) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, events := range [][]*backend.HistoryEvent{s.Inbox, s.History} {
+		for _, e := range events {
+			if key, ok := s.payloads.Key(actorID, e); ok {
+				keys[key] = struct{}{}
+			}
+		}
+	}
+	return keys
+}
+
 func (s *State) GetPurgeRequest(actorID string) (*api.TransactionalRequest, error) {
 	req := &api.TransactionalRequest{
 		ActorType: s.workflowActorType,
 		ActorID:   actorID,
-		// Initial capacity should be enough to contain the entire inbox, history, and custom status + metadata
-		Operations: make([]api.TransactionalOperation, 0, len(s.Inbox)+len(s.History)+2),
+		// Initial capacity should be enough to contain the entire inbox, history, and custom status + stalled reason + outbox + metadata
+		Operations: make([]api.TransactionalOperation, 0, len(s.Inbox)+len(s.History)+4),
 	}
 
 	// Inbox Purging
@@ -391,6 +623,14 @@ func (s *State) GetPurgeRequest(actorID string) (*api.TransactionalRequest, erro
 			Operation: api.Delete,
 			Request:   api.TransactionalDelete{Key: customStatusKey},
 		},
+		api.TransactionalOperation{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: stalledKey},
+		},
+		api.TransactionalOperation{
+			Operation: api.Delete,
+			Request:   api.TransactionalDelete{Key: outboxKey},
+		},
 		api.TransactionalOperation{
 			Operation: api.Delete,
 			Request:   api.TransactionalDelete{Key: metadataKey},
@@ -16,6 +16,7 @@ package todo
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/dapr/durabletask-go/backend"
 )
@@ -30,6 +31,15 @@ const (
 	WaitForRuntimeStatus         = "WaitForRuntimeStatus"
 	ForkWorkflowHistory          = "ForkWorkflowHistory"
 	RerunWorkflowInstance        = "RerunWorkflowInstance"
+	ActivityHeartbeatMethod      = "ActivityHeartbeat"
+	SignalWithStartMethod        = "SignalWithStart"
+	UpdateWorkflowMethod         = "UpdateWorkflow"
+
+	// ActivityStartToCloseTimeoutMetadata and ActivityHeartbeatTimeoutMetadata
+	// are the metadata keys of the invocation of an activity actor with the
+	// timeouts which the activity actor enforces.
+	ActivityStartToCloseTimeoutMetadata = "dapr-activity-start-to-close-timeout"
+	ActivityHeartbeatTimeoutMetadata    = "dapr-activity-heartbeat-timeout"
 )
 
 var (
@@ -49,3 +59,19 @@ const (
 	RunCompletedFalse RunCompleted = false
 	RunCompletedTrue  RunCompleted = true
 )
+
+// ActorTypeForApp returns the workflow or activity actor type of the given
+// app, derived from the actor type of the same kind of the local app. Actor
+// types have the form `dapr.internal.<namespace>.<appID>.<workflow|activity>`
+// and app IDs may not contain dots.
+func ActorTypeForApp(actorType, appID string) string {
+	i := strings.LastIndexByte(actorType, '.')
+	if i <= 0 {
+		return actorType
+	}
+	j := strings.LastIndexByte(actorType[:i], '.')
+	if j < 0 {
+		return actorType
+	}
+	return actorType[:j+1] + appID + actorType[i:]
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorTypeForApp(t *testing.T) {
+	assert.Equal(t, "dapr.internal.default.app2.workflow", ActorTypeForApp("dapr.internal.default.app1.workflow", "app2"))
+	assert.Equal(t, "dapr.internal.ns1.app2.activity", ActorTypeForApp("dapr.internal.ns1.app1.activity", "app2"))
+	assert.Equal(t, "workflow", ActorTypeForApp("workflow", "app2"))
+}
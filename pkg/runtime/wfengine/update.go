@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wfengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/components-contrib/workflows"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func (wfe *engine) SignalWithStart(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error {
+	if req.WorkflowName == "" {
+		return errors.New("a workflow name is required")
+	}
+	if req.InstanceID == nil || *req.InstanceID == "" {
+		return errors.New("a workflow instance ID is required")
+	}
+
+	ctx, startEvent, span, err := newExecutionStartedEvent(ctx, req, *req.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer span.End()
+
+	if err = wfe.backend.SignalWithStart(ctx, startEvent, newEventRaised(eventName, eventData)); err != nil {
+		return fmt.Errorf("failed to signal or start workflow: %w", err)
+	}
+	return nil
+}
+
+func (wfe *engine) UpdateWorkflow(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	if eventName == "" {
+		return nil, errors.New("an update name is required")
+	}
+
+	resp, err := wfe.backend.UpdateWorkflow(ctx, api.InstanceID(instanceID), newEventRaised(eventName, eventData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	}
+	return resp.GetInput(), nil
+}
+
+func newEventRaised(name string, data *wrapperspb.StringValue) *protos.HistoryEvent {
+	return &protos.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.New(time.Now()),
+		EventType: &protos.HistoryEvent_EventRaised{
+			EventRaised: &protos.EventRaisedEvent{Name: name, Input: data},
+		},
+	}
+}
@@ -22,14 +22,24 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/components-contrib/workflows"
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/processor"
+	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/history"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/lifecycle"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/limiter"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/payload"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/replay"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/search"
+	"github.com/dapr/durabletask-go/api"
 	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
 )
@@ -45,6 +55,32 @@ type Interface interface {
 	Client() workflows.Workflow
 
 	ActivityActorType() string
+
+	// ActivityHeartbeat records the liveness of the running activity with the
+	// given task ID of a workflow.
+	ActivityHeartbeat(ctx context.Context, instanceID string, taskID int32) error
+
+	// SignalWithStart raises an event to a workflow, atomically starting the
+	// workflow first if it does not exist or is completed.
+	SignalWithStart(ctx context.Context, req *workflows.StartRequest, eventName string, eventData *wrapperspb.StringValue) error
+
+	// UpdateWorkflow raises an event to a running workflow and blocks until the
+	// workflow responds to it by sending an event with the same name to its own
+	// instance, returning the data of the response.
+	UpdateWorkflow(ctx context.Context, instanceID string, eventName string, eventData *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+
+	// ListWorkflows lists the workflows whose search attributes and runtime
+	// status match the query.
+	ListWorkflows(ctx context.Context, query *search.Query) (*search.QueryResponse, error)
+
+	// WorkflowHistory exports the history of the current execution of a
+	// workflow.
+	WorkflowHistory(ctx context.Context, instanceID string) (*replay.History, error)
+
+	// ReplayWorkflow replays an exported history against the workflow code of
+	// the connected worker, and reports whether the replay diverged from the
+	// history. Nothing is executed and no workflow state is changed.
+	ReplayWorkflow(ctx context.Context, history *replay.History) (*replay.Result, error)
 }
 
 type Options struct {
@@ -56,6 +92,8 @@ type Options struct {
 	Resiliency         resiliency.Provider
 	SchedulerReminders bool
 	EventSink          orchestrator.EventSink
+	CompStore          *compstore.ComponentStore
+	Publisher          rtpubsub.Adapter
 }
 
 type engine struct {
@@ -63,14 +101,29 @@ type engine struct {
 	namespace string
 	actors    actors.Interface
 
-	worker  backend.TaskHubWorker
-	backend *backendactors.Actors
-	client  workflows.Workflow
+	worker      backend.TaskHubWorker
+	executor    backend.Executor
+	backend     *backendactors.Actors
+	client      workflows.Workflow
+	searchIndex *search.Index
 
 	registerGrpcServerFn func(grpcServer grpc.ServiceRegistrar)
 }
 
 func New(opts Options) Interface {
+	searchIndex := search.New(search.Options{
+		AppID:      opts.AppID,
+		StoreName:  opts.Spec.SearchAttributesStateStore,
+		CompStore:  opts.CompStore,
+		Resiliency: opts.Resiliency,
+	})
+
+	wfLifecycle := lifecycle.New(lifecycle.Options{
+		AppID:     opts.AppID,
+		Spec:      opts.Spec.LifecycleEvents,
+		Publisher: opts.Publisher,
+	})
+
 	// If no backend was initialized by the manager, create a backend backed by actors
 	abackend := backendactors.New(backendactors.Options{
 		AppID:              opts.AppID,
@@ -79,6 +132,11 @@ func New(opts Options) Interface {
 		Resiliency:         opts.Resiliency,
 		SchedulerReminders: opts.SchedulerReminders,
 		EventSink:          opts.EventSink,
+		SearchIndex:        searchIndex,
+		HistoryLimits:      history.LimitsFromSpec(opts.Spec.HistoryLimits),
+		Limits:             limiter.LimitsFromSpec(opts.Spec.Limits),
+		Payloads:           payload.FromSpec(opts.AppID, opts.Spec.PayloadOffloading, opts.CompStore),
+		Lifecycle:          wfLifecycle,
 	})
 
 	var activeConns uint64
@@ -91,15 +149,21 @@ func New(opts Options) Interface {
 			activeConns++
 			if activeConns == 1 {
 				log.Debug("Registering workflow actors")
-				return abackend.RegisterActors(ctx)
+				if err := abackend.RegisterActors(ctx); err != nil {
+					return err
+				}
 			}
 
-			return nil
+			return abackend.WorkerConnected(ctx)
 		}),
 		backend.WithOnGetWorkItemsDisconnectCallback(func(ctx context.Context) error {
 			lock.Lock()
 			defer lock.Unlock()
 
+			if err := abackend.WorkerDisconnected(ctx); err != nil {
+				log.Warnf("Failed to update workflow versions of disconnected worker: %s", err)
+			}
+
 			if ctx.Err() != nil {
 				ctx = context.Background()
 			}
@@ -134,10 +198,13 @@ func New(opts Options) Interface {
 		actors:               opts.Actors,
 		worker:               worker,
 		backend:              abackend,
+		executor:             executor,
+		searchIndex:          searchIndex,
 		registerGrpcServerFn: registerGrpcServerFn,
 		client: &client{
-			logger: wfBackendLogger,
-			client: backend.NewTaskHubClient(abackend),
+			logger:  wfBackendLogger,
+			client:  backend.NewTaskHubClient(abackend),
+			backend: abackend,
 		},
 	}
 }
@@ -159,6 +226,7 @@ func (wfe *engine) Run(ctx context.Context) error {
 	}
 
 	log.Info("Workflow engine started")
+
 	<-ctx.Done()
 
 	if err := wfe.worker.Shutdown(context.Background()); err != nil {
@@ -176,3 +244,23 @@ func (wfe *engine) Client() workflows.Workflow {
 func (wfe *engine) ActivityActorType() string {
 	return wfe.backend.ActivityActorType()
 }
+
+func (wfe *engine) ActivityHeartbeat(ctx context.Context, instanceID string, taskID int32) error {
+	return wfe.backend.ActivityHeartbeat(ctx, instanceID, taskID)
+}
+
+func (wfe *engine) ListWorkflows(ctx context.Context, query *search.Query) (*search.QueryResponse, error) {
+	return wfe.searchIndex.Query(ctx, query)
+}
+
+func (wfe *engine) WorkflowHistory(ctx context.Context, instanceID string) (*replay.History, error) {
+	events, err := wfe.backend.GetWorkflowHistory(ctx, api.InstanceID(instanceID))
+	if err != nil {
+		return nil, err
+	}
+	return &replay.History{InstanceID: instanceID, Events: events}, nil
+}
+
+func (wfe *engine) ReplayWorkflow(ctx context.Context, history *replay.History) (*replay.Result, error) {
+	return replay.Replay(ctx, wfe.executor, history)
+}
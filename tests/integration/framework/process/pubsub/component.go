@@ -18,6 +18,9 @@ import (
 	"io"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/dapr/components-contrib/metadata"
 	"github.com/dapr/components-contrib/pubsub"
 	compv1pb "github.com/dapr/dapr/pkg/proto/components/v1"
@@ -118,6 +121,10 @@ func (c *component) Ping(ctx context.Context, req *compv1pb.PingRequest) (*compv
 	return new(compv1pb.PingResponse), nil
 }
 
+func (c *component) Seek(context.Context, *compv1pb.SeekRequest) (*compv1pb.SeekResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "seek is not supported")
+}
+
 func (c *component) Close() error {
 	return c.impl.(io.Closer).Close()
 }